@@ -0,0 +1,32 @@
+package simba
+
+import "github.com/miretskiy/simba/pkg/algo"
+
+// Checksum algorithm tags stored in the top byte of a QuickChecksum value.
+const (
+	// ChecksumCRC32C marks a CRC32C (Castagnoli) sum in the low 32 bits.
+	ChecksumCRC32C uint64 = 1 << 56
+
+	checksumTagMask = 0xff << 56
+)
+
+// QuickChecksum returns a "good enough, fast" integrity checksum of data for
+// internal storage use.  The top byte of the result tags the algorithm that
+// produced it and the remaining bits hold the sum, so callers comparing two
+// values never confuse sums from different algorithms.
+//
+// Today every size is hashed with CRC32C, widened to uint64 and tagged with
+// ChecksumCRC32C.  A faster 64-bit algorithm for large inputs may be added
+// under a new tag, but the value produced for a given tag is stable across
+// versions: data checksummed today will verify with any later release.
+//
+// QuickChecksum is not a cryptographic hash and must not be used where an
+// adversary controls the data.
+func QuickChecksum(data []byte) uint64 {
+	return ChecksumCRC32C | uint64(algo.CRC32(data))
+}
+
+// ChecksumAlgorithm returns the algorithm tag of a QuickChecksum value.
+func ChecksumAlgorithm(sum uint64) uint64 {
+	return sum & checksumTagMask
+}
@@ -0,0 +1,30 @@
+package simba
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Golden values must never change: stored checksums rely on them.
+func TestQuickChecksumGolden(t *testing.T) {
+	large := make([]byte, 4096)
+	for i := range large {
+		large[i] = byte(i % 251)
+	}
+
+	for _, tc := range []struct {
+		name string
+		data []byte
+		want uint64
+	}{
+		{"empty", nil, 0x0100000000000000},
+		{"hello", []byte("hello"), 0x010000009a71bb4c},
+		{"check", []byte("123456789"), 0x01000000e3069283},
+		{"large", large, 0x01000000719077fc},
+	} {
+		got := QuickChecksum(tc.data)
+		require.Equal(t, tc.want, got, tc.name)
+		require.Equal(t, ChecksumCRC32C, ChecksumAlgorithm(got), tc.name)
+	}
+}
@@ -0,0 +1,74 @@
+package simba
+
+import (
+	"runtime"
+
+	"golang.org/x/sys/cpu"
+
+	"github.com/miretskiy/simba/internal/ffi"
+)
+
+// Features reports the SIMD extensions of the CPU the binary is running on.
+// The Rust kernels are compiled for the baseline of their target and let
+// LLVM pick registers, so these flags describe the host, not which code path
+// a kernel took; log them next to Backend to explain threshold behaviour
+// across a fleet.
+//
+// The x86 flags form a chain: each one implies the ones before it, and the
+// AVX flags are only set when the OS saves the wider registers on a context
+// switch.  Every flag is false on architectures other than amd64 and arm64.
+type Features struct {
+	// Arch is runtime.GOARCH.
+	Arch string
+
+	SSE2     bool
+	SSE41    bool
+	SSE42    bool
+	AVX      bool
+	AVX2     bool
+	AVX512F  bool
+	AVX512BW bool
+
+	// NEON is Advanced SIMD, which every arm64 CPU Go supports implements.
+	NEON bool
+}
+
+var cpuFeatures = func() Features {
+	f := detectFeatures()
+	f.Arch = runtime.GOARCH
+	return f
+}()
+
+// detectFeatures reads the flags golang.org/x/sys/cpu probed at startup.
+// x/sys already clears the AVX flags when the OS does not save the wider
+// registers; the x86 flags are chained on top so that each one implies the
+// ones before it, as Features documents.
+func detectFeatures() (f Features) {
+	switch runtime.GOARCH {
+	case "amd64":
+		f.SSE2 = cpu.X86.HasSSE2
+		f.SSE41 = f.SSE2 && cpu.X86.HasSSE41
+		f.SSE42 = f.SSE41 && cpu.X86.HasSSE42
+		f.AVX = f.SSE42 && cpu.X86.HasAVX
+		f.AVX2 = f.AVX && cpu.X86.HasAVX2
+		f.AVX512F = f.AVX2 && cpu.X86.HasAVX512F
+		f.AVX512BW = f.AVX512F && cpu.X86.HasAVX512BW
+	case "arm64":
+		f.NEON = cpu.ARM64.HasASIMD
+	}
+	return f
+}
+
+// CPUFeatures returns the SIMD extensions detected at startup.
+func CPUFeatures() Features {
+	return cpuFeatures
+}
+
+// Backend names the mechanism the kernels are called through.  By default it
+// is "syso": the Rust archive is linked into the binary and entered through
+// assembly trampolines, without cgo or purego.  Builds with the simba_noffi
+// (or simba_reftramp) tag report "go": every kernel is a scalar Go loop and
+// no Rust is linked.
+func Backend() string {
+	return ffi.Backend
+}
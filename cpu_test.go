@@ -0,0 +1,43 @@
+package simba
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCPUFeaturesConsistent(t *testing.T) {
+	f := CPUFeatures()
+	require.Equal(t, runtime.GOARCH, f.Arch)
+	require.Equal(t, f, CPUFeatures(), "detection runs once")
+
+	// Each x86 extension implies the ones it builds on.
+	implies := []struct {
+		name       string
+		have, need bool
+	}{
+		{"SSE4.1 => SSE2", f.SSE41, f.SSE2},
+		{"SSE4.2 => SSE4.1", f.SSE42, f.SSE41},
+		{"AVX => SSE4.2", f.AVX, f.SSE42},
+		{"AVX2 => AVX", f.AVX2, f.AVX},
+		{"AVX-512F => AVX2", f.AVX512F, f.AVX2},
+		{"AVX-512BW => AVX-512F", f.AVX512BW, f.AVX512F},
+	}
+	for _, c := range implies {
+		require.True(t, !c.have || c.need, c.name)
+	}
+
+	switch runtime.GOARCH {
+	case "amd64":
+		require.True(t, f.SSE2, "SSE2 is part of the amd64 baseline")
+		require.False(t, f.NEON)
+	case "arm64":
+		require.True(t, f.NEON)
+		require.False(t, f.SSE2 || f.AVX || f.AVX512F)
+	}
+}
+
+func TestBackend(t *testing.T) {
+	require.Contains(t, []string{"syso", "go"}, Backend())
+}
@@ -11,15 +11,27 @@ package tagvalidate
 //      underscore "__").
 //   4. Last byte cannot be an underscore.
 //
-// The function accelerates the expensive "all bytes < 0x80?" check by calling
-// algo.IsASCII once the tag length crosses the 128-byte SIMD threshold; for
-// shorter tags the pure scalar path remains faster.
-
-import (
-	"github.com/miretskiy/simba/pkg/intrinsics"
-)
-
-const maxTagLength = 200
+// ValidateTagASCII is algo's Datadog-configured TagValidator, whose character
+// checks run on the SIMD AllBytesInSet and IndexByte kernels; the scalar
+// implementation below is kept as its reference.
+
+import "github.com/miretskiy/simba/pkg/algo"
+
+// TagLength bounds the accepted tag length (rule #1).  Override it to enforce
+// a stricter limit; it defaults to algo.TagLengthPolicy (1..200 bytes).
+var TagLength = algo.TagLengthPolicy
+
+// datadogTags is algo.DatadogTagValidator without a length bound:
+// ValidateTagASCII checks TagLength itself, so overrides of it take effect.
+var datadogTags = func() *algo.TagValidator {
+	dd := algo.DatadogTagValidator()
+	return algo.NewTagValidator(algo.TagSpec{
+		StartSet:           dd.StartSet(),
+		MidSet:             dd.MidSet(),
+		ForbidDoubleRune:   '_',
+		ForbidTrailingRune: '_',
+	})
+}()
 
 // lookup tables – one load per byte, zero branches --------------------------------
 var validASCIIStartChar = [256]bool{
@@ -42,7 +54,7 @@ var validASCIITagChar = [256]bool{
 // Datadog’s tag validator. It is fully bounds-check–free and avoids per-iteration
 // boolean state.
 func validateTagASCIIScalar(tag string) bool {
-	if len(tag) == 0 || len(tag) > maxTagLength {
+	if len(tag) == 0 || !TagLength.Check(len(tag)) {
 		return false
 	}
 
@@ -72,138 +84,8 @@ func validateTagASCIIScalar(tag string) bool {
 	return validASCIITagChar[last]
 }
 
-// tagFlags is a 256-byte table where:
-//
-//	bit0 = byte allowed (rule #3)
-//	bit1 = byte is '_'
-//
-// This lets one SIMD gather serve both the allowed-byte test and
-// double-underscore detection.
-var tagFlags = func() *[256]byte {
-	var t [256]byte
-	for i, ok := range validASCIITagChar {
-		if ok {
-			t[i] = 1 // allowed bit
-		}
-	}
-	t['_'] = 3 // allowed + underscore
-	return &t
-}()
-
-// fastMiddleValid returns true if all bytes in data[1:] are valid tag chars
-// *and* there is no double underscore. It uses a single SIMD LUT gather per
-// 32-byte chunk and a scalar tail.
-func fastMiddleValid(data []byte) bool {
-	if len(data) <= 1 {
-		return true
-	}
-
-	// Skip first byte (already checked by start-char rule).
-	body := data[1:]
-	n := len(body)
-
-	if n < 32 {
-		// Scalar fall-back: validate allowed chars & no "__".
-		prev := false
-		for _, c := range body {
-			if tagFlags[c]&1 == 0 { // disallowed
-				return false
-			}
-			if c == '_' {
-				if prev {
-					return false
-				}
-				prev = true
-			} else {
-				prev = false
-			}
-		}
-		return true
-	}
-
-	blocks := n / 32
-	var flags [32]byte
-	prevLastBit := false // underscore flag from previous block
-
-	for i := 0; i < blocks; i++ {
-		start := i * 32
-		intrinsics.MapBytes(flags[:], body[start:start+32], tagFlags)
-
-		var allowedOr byte
-		var underscoreMask uint32
-		for idx, f := range flags {
-			allowedOr |= f & 1
-			underscoreMask |= uint32(f>>1) << idx
-		}
-		if allowedOr == 0 { // found disallowed char
-			return false
-		}
-		if prevLastBit && (underscoreMask&1) != 0 {
-			return false // boundary "__"
-		}
-		if (underscoreMask & (underscoreMask << 1)) != 0 {
-			return false // "__" within block
-		}
-		prevLastBit = (underscoreMask>>31)&1 == 1
-	}
-
-	// Scalar tail
-	prev := prevLastBit
-	for _, c := range body[blocks*32:] {
-		if tagFlags[c]&1 == 0 {
-			return false
-		}
-		if c == '_' {
-			if prev {
-				return false
-			}
-			prev = true
-		} else {
-			prev = false
-		}
-	}
-	return true
-}
-
-// ValidateTagASCII accelerates three heavy checks for long tags:
-//  1. All bytes are ASCII (algo.IsASCII → SIMD).
-//  2. No double underscores "__" (equality-mask SIMD scan).
-//  3. All bytes after the first are in the allowed set (algo.AllBytesInSet → SIMD).
-//
-// For shorter inputs the original scalar validator is fastest.
+// ValidateTagASCII reports whether tag satisfies rules 1-4, with the length
+// bound taken from TagLength.
 func ValidateTagASCII(tag string) bool {
-	n := len(tag)
-	if n == 0 || n > maxTagLength {
-		return false
-	}
-
-	// Fast ASCII rejection for long tags; scalar loop is cheaper for very short ones.
-	if n >= 64 && !intrinsics.IsASCII([]byte(tag)) {
-		return false
-	}
-
-	// First-character rule is still scalar (single byte).
-	if !validASCIIStartChar[tag[0]] {
-		return false
-	}
-
-	if n == 1 {
-		return true
-	}
-
-	// Trailing underscore rule (cheap scalar check).
-	if tag[n-1] == '_' {
-		return false
-	}
-
-	data := []byte(tag)
-
-	// Combined SIMD validation for body when beneficial.
-	if n >= 32 && !fastMiddleValid(data) {
-		return false
-	}
-
-	// Fall back to scalar validator for remaining nuanced checks on short tags
-	// or for edge cases the SIMD path didn’t cover (small length).
-	return validateTagASCIIScalar(tag)
+	return TagLength.Check(len(tag)) && datadogTags.Validate(tag)
 }
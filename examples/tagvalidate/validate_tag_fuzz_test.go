@@ -0,0 +1,29 @@
+package tagvalidate
+
+import (
+	"strings"
+	"testing"
+)
+
+// FuzzValidateTagASCII is the tag-validator counterpart of
+// algo.FuzzAlgoDifferential: the SIMD validator must agree with its scalar
+// twin on every input, in particular around the kernels' SIMD cutovers.
+func FuzzValidateTagASCII(f *testing.F) {
+	for _, n := range []int{1, 15, 16, 31, 32, 63, 64, 65, 200, 201} {
+		tag := strings.Repeat("env:prod-", n/9+1)[:n]
+		f.Add(tag)
+		f.Add(tag[:n-1] + "_")
+		f.Add("a" + strings.Repeat("_", n-1))
+		f.Add(tag[:n-1] + "\x80")
+	}
+	for _, c := range []string{"", "env:prod", "bad__double", "BadUpper", "☃snow"} {
+		f.Add(c)
+	}
+
+	f.Fuzz(func(t *testing.T, tag string) {
+		got, want := ValidateTagASCII(tag), validateTagASCIIScalar(tag)
+		if got != want {
+			t.Fatalf("ValidateTagASCII(%q) = %v, scalar = %v", tag, got, want)
+		}
+	})
+}
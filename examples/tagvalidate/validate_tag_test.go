@@ -2,6 +2,7 @@ package tagvalidate
 
 import (
 	"math/rand"
+	"strings"
 	"testing"
 	"unicode"
 	"unicode/utf16"
@@ -28,6 +29,16 @@ func TestValidateTagASCII(t *testing.T) {
 	}
 }
 
+func TestValidateTagASCIIDoesNotAllocate(t *testing.T) {
+	// Both sides of the 32- and 64-byte SIMD cutovers.
+	for _, n := range []int{8, 31, 32, 63, 64, 150} {
+		tag := strings.Repeat("env:prod-", n/9+1)[:n]
+		if allocs := testing.AllocsPerRun(100, func() { benchResult = ValidateTagASCII(tag) }); allocs != 0 {
+			t.Errorf("ValidateTagASCII(len %d): %v allocs per call", n, allocs)
+		}
+	}
+}
+
 var benchResult bool
 
 func BenchmarkValidateTagASCII(b *testing.B) {
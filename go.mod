@@ -3,12 +3,10 @@ module github.com/miretskiy/simba
 go 1.24.4
 
 require (
-	github.com/DataDog/dd-go v0.0.0
 	github.com/stretchr/testify v1.10.0
+	golang.org/x/sys v0.41.0
 )
 
-replace github.com/DataDog/dd-go => ../dd-go
-
 require (
 	github.com/davecgh/go-spew v1.1.2-0.20180830191138-d8f796af33cc // indirect
 	github.com/pmezard/go-difflib v1.0.1-0.20181226105442-5d4384ee4fb2 // indirect
@@ -0,0 +1,48 @@
+package ffi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// allocCases are wrappers that pass Go pointers (&data[0], LUTs, out
+// parameters) to the trampolines.  //go:noescape on the raw prototypes lets
+// escape analysis keep those arguments where they are, so a call must never
+// allocate.
+func allocCases() map[string]func() {
+	data := make([]byte, 256)
+	dst := make([]byte, 256)
+	var lut [256]byte
+	var hist [256]uint64
+	slices := [][]byte{data[:10], data[10:]}
+	out := make([]bool, len(slices))
+	return map[string]func(){
+		"Noop":           func() { Noop() },
+		"SumU8_64":       func() { SumU8_64(data) },
+		"IsASCII32":      func() { IsASCII32(data) },
+		"AllBytesInSet":  func() { AllBytesInSet64(data, &lut) },
+		"MapBytes64":     func() { MapBytes64(dst, data, &lut) },
+		"Crc32AndSum":    func() { Crc32AndSum(data, 0) },
+		"HistogramU8_64": func() { HistogramU8_64(data, &hist) },
+		"IsASCIIBatch":   func() { IsASCIIBatch(slices, out) },
+	}
+}
+
+func TestWrappersDoNotAllocate(t *testing.T) {
+	for name, call := range allocCases() {
+		require.Zero(t, testing.AllocsPerRun(100, call), name)
+	}
+}
+
+// BenchmarkWrapperAllocs reports allocations per call; run with -benchmem.
+func BenchmarkWrapperAllocs(b *testing.B) {
+	for name, call := range allocCases() {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				call()
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+//go:build !simba_noffi && !simba_reftramp
+
+package ffi
+
+// Backend names the FFI mechanism this package uses: the Rust static archive
+// is linked as a .syso object and called through assembly trampolines.
+const Backend = "syso"
+
+// Kernels returns the sorted names of the Rust symbols reachable through the
+// trampolines.  The archive is linked statically, so a missing symbol fails
+// the link rather than showing up as absent here.
+func Kernels() []string {
+	return append([]string(nil), kernels...)
+}
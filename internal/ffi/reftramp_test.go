@@ -0,0 +1,28 @@
+package ffi
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestReferenceTrampolines runs this package's tests again with
+// -tags simba_reftramp, where the trampolines call the Go reference
+// implementation instead of Rust, so the suite pins the reference and the
+// Rust kernels to the same answers.
+func TestReferenceTrampolines(t *testing.T) {
+	if testing.Short() {
+		t.Skip("rebuilds the package with -tags simba_reftramp")
+	}
+	if Backend == "go" {
+		t.Skip("already running the reference build")
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+
+	out, err := exec.Command(goBin, "test", "-count=1", "-tags", "simba_reftramp", ".").CombinedOutput()
+	require.NoError(t, err, "%s", out)
+}
@@ -0,0 +1,9 @@
+//go:build simba_reftramp && !simba_noffi && !(arm64 || amd64 && !windows)
+
+package ffi
+
+// The reference trampolines exist only as syso_reftramp_amd64.s (not on
+// windows) and syso_reftramp_arm64.s.  Elsewhere simba_reftramp would leave
+// every prototype in syso_raw.go without a body; this undefined name makes
+// the build fail with the reason instead.
+var _ = simba_reftramp_requires_amd64_or_arm64
@@ -0,0 +1,26 @@
+package ffi
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestTrampolineStubs checks that every generated stub file calls every
+// kernel, so a missing architecture in a //simba:trampoline tag is caught on
+// any host rather than only when linking on that architecture.
+func TestTrampolineStubs(t *testing.T) {
+	for _, arch := range []string{"amd64", "arm64", "riscv64", "windows_amd64", "386"} {
+		for _, prefix := range []string{"syso_", "syso_trace_"} {
+			path := prefix + arch + ".s"
+			src, err := os.ReadFile(path)
+			require.NoError(t, err)
+			for _, k := range kernels {
+				require.Contains(t, string(src), "CALL "+k+"(SB)\n", "%s: %s", path, k)
+			}
+			require.Equal(t, len(kernels), strings.Count(string(src), "CALL "), path)
+		}
+	}
+}
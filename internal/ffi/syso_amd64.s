@@ -1,6 +1,6 @@
 // Code generated by gen_trampolines; DO NOT EDIT.
-//go:build amd64
-// +build amd64
+//go:build amd64 && !windows && !simba_trace && !simba_noffi && !simba_reftramp
+// +build amd64,!windows,!simba_trace,!simba_noffi,!simba_reftramp
 
 #include "textflag.h"
 
@@ -126,6 +126,16 @@ TEXT ·eq_u8_masks64_raw(SB), NOSPLIT, $0-40
     MOVQ AX, ret+32(FP)
     RET
 
+// func eq_any_masks64_raw() uintptr
+TEXT ·eq_any_masks64_raw(SB), NOSPLIT, $0-40
+    MOVQ src+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ needles+16(FP), DX
+    MOVQ out+24(FP), CX
+    CALL eq_any_masks64(SB)
+    MOVQ AX, ret+32(FP)
+    RET
+
 // func eq_u8_masks16_raw() uintptr
 TEXT ·eq_u8_masks16_raw(SB), NOSPLIT, $0-40
     MOVQ src+0(FP), DI
@@ -136,13 +146,43 @@ TEXT ·eq_u8_masks16_raw(SB), NOSPLIT, $0-40
     MOVQ AX, ret+32(FP)
     RET
 
+// func lut_masks16_raw() uintptr
+TEXT ·lut_masks16_raw(SB), NOSPLIT, $0-40
+    MOVQ src+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ table+16(FP), DX
+    MOVQ out+24(FP), CX
+    CALL lut_masks16(SB)
+    MOVQ AX, ret+32(FP)
+    RET
+
+// func lut_masks32_raw() uintptr
+TEXT ·lut_masks32_raw(SB), NOSPLIT, $0-40
+    MOVQ src+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ table+16(FP), DX
+    MOVQ out+24(FP), CX
+    CALL lut_masks32(SB)
+    MOVQ AX, ret+32(FP)
+    RET
+
+// func lut_masks64_raw() uintptr
+TEXT ·lut_masks64_raw(SB), NOSPLIT, $0-40
+    MOVQ src+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ table+16(FP), DX
+    MOVQ out+24(FP), CX
+    CALL lut_masks64(SB)
+    MOVQ AX, ret+32(FP)
+    RET
+
 // func noop_raw()
 TEXT ·noop_raw(SB), NOSPLIT, $0-0
     CALL noop(SB)
     RET
 
 // func crc32_update_32_raw() uint32
-TEXT ·crc32_update_32_raw(SB), NOSPLIT, $0-24
+TEXT ·crc32_update_32_raw(SB), NOSPLIT, $0-28
     MOVQ ptr+0(FP), DI
     MOVQ n+8(FP), SI
     MOVL init+16(FP), DX
@@ -151,7 +191,7 @@ TEXT ·crc32_update_32_raw(SB), NOSPLIT, $0-24
     RET
 
 // func crc32_update_64_raw() uint32
-TEXT ·crc32_update_64_raw(SB), NOSPLIT, $0-24
+TEXT ·crc32_update_64_raw(SB), NOSPLIT, $0-28
     MOVQ ptr+0(FP), DI
     MOVQ n+8(FP), SI
     MOVL init+16(FP), DX
@@ -168,36 +208,1115 @@ TEXT ·crc32_combine_raw(SB), NOSPLIT, $0-20
     MOVL AX, ret+16(FP)
     RET
 
+// func index_lt_u8_16_raw() uintptr
+TEXT ·index_lt_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVBLZX threshold+16(FP), DX
+    CALL index_lt_u8_16(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func index_lt_u8_32_raw() uintptr
+TEXT ·index_lt_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVBLZX threshold+16(FP), DX
+    CALL index_lt_u8_32(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func index_lt_u8_64_raw() uintptr
+TEXT ·index_lt_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVBLZX threshold+16(FP), DX
+    CALL index_lt_u8_64(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func last_index_lut16_raw() uintptr
+TEXT ·last_index_lut16_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ lut+16(FP), DX
+    CALL last_index_lut16(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func last_index_lut32_raw() uintptr
+TEXT ·last_index_lut32_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ lut+16(FP), DX
+    CALL last_index_lut32(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func last_index_lut64_raw() uintptr
+TEXT ·last_index_lut64_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ lut+16(FP), DX
+    CALL last_index_lut64(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func min_f32_16_raw() uint32
+TEXT ·min_f32_16_raw(SB), NOSPLIT, $0-20
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL min_f32_16(SB)
+    MOVL AX, ret+16(FP)
+    RET
+
+// func min_f32_32_raw() uint32
+TEXT ·min_f32_32_raw(SB), NOSPLIT, $0-20
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL min_f32_32(SB)
+    MOVL AX, ret+16(FP)
+    RET
+
+// func min_f32_64_raw() uint32
+TEXT ·min_f32_64_raw(SB), NOSPLIT, $0-20
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL min_f32_64(SB)
+    MOVL AX, ret+16(FP)
+    RET
+
+// func max_f32_16_raw() uint32
+TEXT ·max_f32_16_raw(SB), NOSPLIT, $0-20
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL max_f32_16(SB)
+    MOVL AX, ret+16(FP)
+    RET
+
+// func max_f32_32_raw() uint32
+TEXT ·max_f32_32_raw(SB), NOSPLIT, $0-20
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL max_f32_32(SB)
+    MOVL AX, ret+16(FP)
+    RET
+
+// func max_f32_64_raw() uint32
+TEXT ·max_f32_64_raw(SB), NOSPLIT, $0-20
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL max_f32_64(SB)
+    MOVL AX, ret+16(FP)
+    RET
+
+// func count_outside_u8_16_raw() uintptr
+TEXT ·count_outside_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVBLZX lo+16(FP), DX
+    MOVBLZX hi+17(FP), CX
+    CALL count_outside_u8_16(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func count_outside_u8_32_raw() uintptr
+TEXT ·count_outside_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVBLZX lo+16(FP), DX
+    MOVBLZX hi+17(FP), CX
+    CALL count_outside_u8_32(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func count_outside_u8_64_raw() uintptr
+TEXT ·count_outside_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVBLZX lo+16(FP), DX
+    MOVBLZX hi+17(FP), CX
+    CALL count_outside_u8_64(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func filter_u8_lut32_raw() uintptr
+TEXT ·filter_u8_lut32_raw(SB), NOSPLIT, $0-40
+    MOVQ src+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ dst+16(FP), DX
+    MOVQ lut+24(FP), CX
+    CALL filter_u8_lut32(SB)
+    MOVQ AX, ret+32(FP)
+    RET
+
+// func filter_u8_lut64_raw() uintptr
+TEXT ·filter_u8_lut64_raw(SB), NOSPLIT, $0-40
+    MOVQ src+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ dst+16(FP), DX
+    MOVQ lut+24(FP), CX
+    CALL filter_u8_lut64(SB)
+    MOVQ AX, ret+32(FP)
+    RET
+
+// func filter_u8_lut16_raw() uintptr
+TEXT ·filter_u8_lut16_raw(SB), NOSPLIT, $0-40
+    MOVQ src+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ dst+16(FP), DX
+    MOVQ lut+24(FP), CX
+    CALL filter_u8_lut16(SB)
+    MOVQ AX, ret+32(FP)
+    RET
+
+// func validate_map_u8_lut32_raw() uintptr
+TEXT ·validate_map_u8_lut32_raw(SB), NOSPLIT, $0-48
+    MOVQ src+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ dst+16(FP), DX
+    MOVQ allowed+24(FP), CX
+    MOVQ lut+32(FP), R8
+    CALL validate_map_u8_lut32(SB)
+    MOVQ AX, ret+40(FP)
+    RET
+
+// func validate_map_u8_lut64_raw() uintptr
+TEXT ·validate_map_u8_lut64_raw(SB), NOSPLIT, $0-48
+    MOVQ src+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ dst+16(FP), DX
+    MOVQ allowed+24(FP), CX
+    MOVQ lut+32(FP), R8
+    CALL validate_map_u8_lut64(SB)
+    MOVQ AX, ret+40(FP)
+    RET
+
+// func validate_map_u8_lut16_raw() uintptr
+TEXT ·validate_map_u8_lut16_raw(SB), NOSPLIT, $0-48
+    MOVQ src+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ dst+16(FP), DX
+    MOVQ allowed+24(FP), CX
+    MOVQ lut+32(FP), R8
+    CALL validate_map_u8_lut16(SB)
+    MOVQ AX, ret+40(FP)
+    RET
+
+// func index_ne_u8_16_raw() uintptr
+TEXT ·index_ne_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVBLZX val+16(FP), DX
+    CALL index_ne_u8_16(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func index_ne_u8_32_raw() uintptr
+TEXT ·index_ne_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVBLZX val+16(FP), DX
+    CALL index_ne_u8_32(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func index_ne_u8_64_raw() uintptr
+TEXT ·index_ne_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVBLZX val+16(FP), DX
+    CALL index_ne_u8_64(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func index_pair_u8_16_raw() uintptr
+TEXT ·index_pair_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVBLZX b0+16(FP), DX
+    MOVBLZX b1+17(FP), CX
+    CALL index_pair_u8_16(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func index_pair_u8_32_raw() uintptr
+TEXT ·index_pair_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVBLZX b0+16(FP), DX
+    MOVBLZX b1+17(FP), CX
+    CALL index_pair_u8_32(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func index_pair_u8_64_raw() uintptr
+TEXT ·index_pair_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVBLZX b0+16(FP), DX
+    MOVBLZX b1+17(FP), CX
+    CALL index_pair_u8_64(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func xor_reduce_u8_16_raw() uint8
+TEXT ·xor_reduce_u8_16_raw(SB), NOSPLIT, $0-17
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL xor_reduce_u8_16(SB)
+    MOVB AL, ret+16(FP)
+    RET
+
+// func xor_reduce_u8_32_raw() uint8
+TEXT ·xor_reduce_u8_32_raw(SB), NOSPLIT, $0-17
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL xor_reduce_u8_32(SB)
+    MOVB AL, ret+16(FP)
+    RET
+
+// func xor_reduce_u8_64_raw() uint8
+TEXT ·xor_reduce_u8_64_raw(SB), NOSPLIT, $0-17
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL xor_reduce_u8_64(SB)
+    MOVB AL, ret+16(FP)
+    RET
+
+// func count_class_transitions_u8_16_raw() uintptr
+TEXT ·count_class_transitions_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ table+16(FP), DX
+    CALL count_class_transitions_u8_16(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func count_class_transitions_u8_32_raw() uintptr
+TEXT ·count_class_transitions_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ table+16(FP), DX
+    CALL count_class_transitions_u8_32(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func count_class_transitions_u8_64_raw() uintptr
+TEXT ·count_class_transitions_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ table+16(FP), DX
+    CALL count_class_transitions_u8_64(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func is_sorted_u8_16_raw() uint8
+TEXT ·is_sorted_u8_16_raw(SB), NOSPLIT, $0-17
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL is_sorted_u8_16(SB)
+    MOVB AL, ret+16(FP)
+    RET
+
+// func is_sorted_u8_32_raw() uint8
+TEXT ·is_sorted_u8_32_raw(SB), NOSPLIT, $0-17
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL is_sorted_u8_32(SB)
+    MOVB AL, ret+16(FP)
+    RET
+
+// func is_sorted_u8_64_raw() uint8
+TEXT ·is_sorted_u8_64_raw(SB), NOSPLIT, $0-17
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL is_sorted_u8_64(SB)
+    MOVB AL, ret+16(FP)
+    RET
+
+// func crc32_sum_u8_raw() uint32
+TEXT ·crc32_sum_u8_raw(SB), NOSPLIT, $0-36
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVL init+16(FP), DX
+    MOVQ sum+24(FP), CX
+    CALL crc32_sum_u8(SB)
+    MOVL AX, ret+32(FP)
+    RET
+
+// func base32_encode_raw() uintptr
+TEXT ·base32_encode_raw(SB), NOSPLIT, $0-32
+    MOVQ src+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ dst+16(FP), DX
+    CALL base32_encode(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func base32_decode_raw() uintptr
+TEXT ·base32_decode_raw(SB), NOSPLIT, $0-32
+    MOVQ src+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ dst+16(FP), DX
+    CALL base32_decode(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func luhn_u8_16_raw() uint8
+TEXT ·luhn_u8_16_raw(SB), NOSPLIT, $0-17
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL luhn_u8_16(SB)
+    MOVB AL, ret+16(FP)
+    RET
+
+// func luhn_u8_32_raw() uint8
+TEXT ·luhn_u8_32_raw(SB), NOSPLIT, $0-17
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL luhn_u8_32(SB)
+    MOVB AL, ret+16(FP)
+    RET
+
+// func luhn_u8_64_raw() uint8
+TEXT ·luhn_u8_64_raw(SB), NOSPLIT, $0-17
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL luhn_u8_64(SB)
+    MOVB AL, ret+16(FP)
+    RET
+
+// func popcount_and_u8_16_raw() uint64
+TEXT ·popcount_and_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    CALL popcount_and_u8_16(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func popcount_and_u8_32_raw() uint64
+TEXT ·popcount_and_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    CALL popcount_and_u8_32(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func popcount_and_u8_64_raw() uint64
+TEXT ·popcount_and_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    CALL popcount_and_u8_64(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func popcount_xor_u8_16_raw() uint64
+TEXT ·popcount_xor_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    CALL popcount_xor_u8_16(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func popcount_xor_u8_32_raw() uint64
+TEXT ·popcount_xor_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    CALL popcount_xor_u8_32(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func popcount_xor_u8_64_raw() uint64
+TEXT ·popcount_xor_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    CALL popcount_xor_u8_64(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func popcount_u8_16_raw() uint64
+TEXT ·popcount_u8_16_raw(SB), NOSPLIT, $0-24
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL popcount_u8_16(SB)
+    MOVQ AX, ret+16(FP)
+    RET
+
+// func popcount_u8_32_raw() uint64
+TEXT ·popcount_u8_32_raw(SB), NOSPLIT, $0-24
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL popcount_u8_32(SB)
+    MOVQ AX, ret+16(FP)
+    RET
+
+// func popcount_u8_64_raw() uint64
+TEXT ·popcount_u8_64_raw(SB), NOSPLIT, $0-24
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL popcount_u8_64(SB)
+    MOVQ AX, ret+16(FP)
+    RET
+
+// func fletcher16_u8_16_raw() uint32
+TEXT ·fletcher16_u8_16_raw(SB), NOSPLIT, $0-20
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL fletcher16_u8_16(SB)
+    MOVL AX, ret+16(FP)
+    RET
+
+// func fletcher16_u8_32_raw() uint32
+TEXT ·fletcher16_u8_32_raw(SB), NOSPLIT, $0-20
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL fletcher16_u8_32(SB)
+    MOVL AX, ret+16(FP)
+    RET
+
+// func fletcher16_u8_64_raw() uint32
+TEXT ·fletcher16_u8_64_raw(SB), NOSPLIT, $0-20
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL fletcher16_u8_64(SB)
+    MOVL AX, ret+16(FP)
+    RET
+
+// func fletcher32_u16_16_raw() uint32
+TEXT ·fletcher32_u16_16_raw(SB), NOSPLIT, $0-20
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL fletcher32_u16_16(SB)
+    MOVL AX, ret+16(FP)
+    RET
+
+// func fletcher32_u16_32_raw() uint32
+TEXT ·fletcher32_u16_32_raw(SB), NOSPLIT, $0-20
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL fletcher32_u16_32(SB)
+    MOVL AX, ret+16(FP)
+    RET
+
+// func fletcher32_u16_64_raw() uint32
+TEXT ·fletcher32_u16_64_raw(SB), NOSPLIT, $0-20
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL fletcher32_u16_64(SB)
+    MOVL AX, ret+16(FP)
+    RET
+
+// func count_u8_16_raw() uint64
+TEXT ·count_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVBLZX needle+16(FP), DX
+    CALL count_u8_16(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func count_u8_32_raw() uint64
+TEXT ·count_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVBLZX needle+16(FP), DX
+    CALL count_u8_32(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func count_u8_64_raw() uint64
+TEXT ·count_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVBLZX needle+16(FP), DX
+    CALL count_u8_64(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func crc32_ieee_update_raw() uint32
+TEXT ·crc32_ieee_update_raw(SB), NOSPLIT, $0-28
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVL init+16(FP), DX
+    CALL crc32_ieee_update(SB)
+    MOVL AX, ret+24(FP)
+    RET
+
+// func sum_u16_16_raw() uint64
+TEXT ·sum_u16_16_raw(SB), NOSPLIT, $0-24
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL sum_u16_16(SB)
+    MOVQ AX, ret+16(FP)
+    RET
+
+// func sum_u16_32_raw() uint64
+TEXT ·sum_u16_32_raw(SB), NOSPLIT, $0-24
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL sum_u16_32(SB)
+    MOVQ AX, ret+16(FP)
+    RET
+
+// func sum_u16_64_raw() uint64
+TEXT ·sum_u16_64_raw(SB), NOSPLIT, $0-24
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL sum_u16_64(SB)
+    MOVQ AX, ret+16(FP)
+    RET
+
+// func sum_u32_16_raw() uint64
+TEXT ·sum_u32_16_raw(SB), NOSPLIT, $0-24
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL sum_u32_16(SB)
+    MOVQ AX, ret+16(FP)
+    RET
+
+// func sum_u32_32_raw() uint64
+TEXT ·sum_u32_32_raw(SB), NOSPLIT, $0-24
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL sum_u32_32(SB)
+    MOVQ AX, ret+16(FP)
+    RET
+
+// func sum_u32_64_raw() uint64
+TEXT ·sum_u32_64_raw(SB), NOSPLIT, $0-24
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL sum_u32_64(SB)
+    MOVQ AX, ret+16(FP)
+    RET
+
+// func min_u8_16_raw() uint8
+TEXT ·min_u8_16_raw(SB), NOSPLIT, $0-17
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL min_u8_16(SB)
+    MOVB AL, ret+16(FP)
+    RET
+
+// func min_u8_32_raw() uint8
+TEXT ·min_u8_32_raw(SB), NOSPLIT, $0-17
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL min_u8_32(SB)
+    MOVB AL, ret+16(FP)
+    RET
+
+// func min_u8_64_raw() uint8
+TEXT ·min_u8_64_raw(SB), NOSPLIT, $0-17
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL min_u8_64(SB)
+    MOVB AL, ret+16(FP)
+    RET
+
+// func max_u8_16_raw() uint8
+TEXT ·max_u8_16_raw(SB), NOSPLIT, $0-17
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL max_u8_16(SB)
+    MOVB AL, ret+16(FP)
+    RET
+
+// func max_u8_32_raw() uint8
+TEXT ·max_u8_32_raw(SB), NOSPLIT, $0-17
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL max_u8_32(SB)
+    MOVB AL, ret+16(FP)
+    RET
+
+// func max_u8_64_raw() uint8
+TEXT ·max_u8_64_raw(SB), NOSPLIT, $0-17
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    CALL max_u8_64(SB)
+    MOVB AL, ret+16(FP)
+    RET
+
+// func xor_u8_16_raw()
+TEXT ·xor_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    MOVQ dst+24(FP), CX
+    CALL xor_u8_16(SB)
+    RET
+
+// func xor_u8_32_raw()
+TEXT ·xor_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    MOVQ dst+24(FP), CX
+    CALL xor_u8_32(SB)
+    RET
+
+// func xor_u8_64_raw()
+TEXT ·xor_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    MOVQ dst+24(FP), CX
+    CALL xor_u8_64(SB)
+    RET
+
+// func and_u8_16_raw()
+TEXT ·and_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    MOVQ dst+24(FP), CX
+    CALL and_u8_16(SB)
+    RET
+
+// func and_u8_32_raw()
+TEXT ·and_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    MOVQ dst+24(FP), CX
+    CALL and_u8_32(SB)
+    RET
+
+// func and_u8_64_raw()
+TEXT ·and_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    MOVQ dst+24(FP), CX
+    CALL and_u8_64(SB)
+    RET
+
+// func or_u8_16_raw()
+TEXT ·or_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    MOVQ dst+24(FP), CX
+    CALL or_u8_16(SB)
+    RET
+
+// func or_u8_32_raw()
+TEXT ·or_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    MOVQ dst+24(FP), CX
+    CALL or_u8_32(SB)
+    RET
+
+// func or_u8_64_raw()
+TEXT ·or_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    MOVQ dst+24(FP), CX
+    CALL or_u8_64(SB)
+    RET
+
+// func index_diff_u8_16_raw() uintptr
+TEXT ·index_diff_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    CALL index_diff_u8_16(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func index_diff_u8_32_raw() uintptr
+TEXT ·index_diff_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    CALL index_diff_u8_32(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func index_diff_u8_64_raw() uintptr
+TEXT ·index_diff_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    CALL index_diff_u8_64(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func index_diff_fold_u8_16_raw() uintptr
+TEXT ·index_diff_fold_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    CALL index_diff_fold_u8_16(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func index_diff_fold_u8_32_raw() uintptr
+TEXT ·index_diff_fold_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    CALL index_diff_fold_u8_32(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func index_diff_fold_u8_64_raw() uintptr
+TEXT ·index_diff_fold_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    CALL index_diff_fold_u8_64(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func hex_encode_raw() uintptr
+TEXT ·hex_encode_raw(SB), NOSPLIT, $0-32
+    MOVQ src+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ dst+16(FP), DX
+    CALL hex_encode(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func hex_decode_raw() uintptr
+TEXT ·hex_decode_raw(SB), NOSPLIT, $0-32
+    MOVQ src+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ dst+16(FP), DX
+    CALL hex_decode(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func base64_encode_raw() uintptr
+TEXT ·base64_encode_raw(SB), NOSPLIT, $0-40
+    MOVQ src+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ dst+16(FP), DX
+    MOVBLZX url+24(FP), CX
+    CALL base64_encode(SB)
+    MOVQ AX, ret+32(FP)
+    RET
+
+// func base64_decode_raw() uintptr
+TEXT ·base64_decode_raw(SB), NOSPLIT, $0-40
+    MOVQ src+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ dst+16(FP), DX
+    MOVBLZX url+24(FP), CX
+    CALL base64_decode(SB)
+    MOVQ AX, ret+32(FP)
+    RET
+
+// func replace_u8_16_raw() uint64
+TEXT ·replace_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVBLZX old+16(FP), DX
+    MOVBLZX new+17(FP), CX
+    CALL replace_u8_16(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func replace_u8_32_raw() uint64
+TEXT ·replace_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVBLZX old+16(FP), DX
+    MOVBLZX new+17(FP), CX
+    CALL replace_u8_32(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func replace_u8_64_raw() uint64
+TEXT ·replace_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVBLZX old+16(FP), DX
+    MOVBLZX new+17(FP), CX
+    CALL replace_u8_64(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func histogram_u8_16_raw()
+TEXT ·histogram_u8_16_raw(SB), NOSPLIT, $0-24
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ hist+16(FP), DX
+    CALL histogram_u8_16(SB)
+    RET
+
+// func histogram_u8_32_raw()
+TEXT ·histogram_u8_32_raw(SB), NOSPLIT, $0-24
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ hist+16(FP), DX
+    CALL histogram_u8_32(SB)
+    RET
+
+// func histogram_u8_64_raw()
+TEXT ·histogram_u8_64_raw(SB), NOSPLIT, $0-24
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ hist+16(FP), DX
+    CALL histogram_u8_64(SB)
+    RET
+
+// func any_u8_lut16_raw() uint8
+TEXT ·any_u8_lut16_raw(SB), NOSPLIT, $0-25
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ lut+16(FP), DX
+    CALL any_u8_lut16(SB)
+    MOVB AL, ret+24(FP)
+    RET
+
+// func any_u8_lut32_raw() uint8
+TEXT ·any_u8_lut32_raw(SB), NOSPLIT, $0-25
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ lut+16(FP), DX
+    CALL any_u8_lut32(SB)
+    MOVB AL, ret+24(FP)
+    RET
+
+// func any_u8_lut64_raw() uint8
+TEXT ·any_u8_lut64_raw(SB), NOSPLIT, $0-25
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ lut+16(FP), DX
+    CALL any_u8_lut64(SB)
+    MOVB AL, ret+24(FP)
+    RET
+
+// func index_lut16_raw() uintptr
+TEXT ·index_lut16_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ lut+16(FP), DX
+    CALL index_lut16(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func index_lut32_raw() uintptr
+TEXT ·index_lut32_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ lut+16(FP), DX
+    CALL index_lut32(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func index_lut64_raw() uintptr
+TEXT ·index_lut64_raw(SB), NOSPLIT, $0-32
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVQ lut+16(FP), DX
+    CALL index_lut64(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func is_ascii_batch_raw()
+TEXT ·is_ascii_batch_raw(SB), NOSPLIT, $0-32
+    MOVQ descs+0(FP), DI
+    MOVQ count+8(FP), SI
+    MOVQ stride+16(FP), DX
+    MOVQ out+24(FP), CX
+    CALL is_ascii_batch(SB)
+    RET
+
+// func validate_tag_batch_raw()
+TEXT ·validate_tag_batch_raw(SB), NOSPLIT, $0-48
+    MOVQ descs+0(FP), DI
+    MOVQ count+8(FP), SI
+    MOVQ stride+16(FP), DX
+    MOVQ minLen+24(FP), CX
+    MOVQ maxLen+32(FP), R8
+    MOVQ out+40(FP), R9
+    CALL validate_tag_batch(SB)
+    RET
+
+// func add_u8_sat_16_raw()
+TEXT ·add_u8_sat_16_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    MOVQ dst+24(FP), CX
+    CALL add_u8_sat_16(SB)
+    RET
+
+// func add_u8_sat_32_raw()
+TEXT ·add_u8_sat_32_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    MOVQ dst+24(FP), CX
+    CALL add_u8_sat_32(SB)
+    RET
+
+// func add_u8_sat_64_raw()
+TEXT ·add_u8_sat_64_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    MOVQ dst+24(FP), CX
+    CALL add_u8_sat_64(SB)
+    RET
+
+// func add_u8_wrap_16_raw()
+TEXT ·add_u8_wrap_16_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    MOVQ dst+24(FP), CX
+    CALL add_u8_wrap_16(SB)
+    RET
+
+// func add_u8_wrap_32_raw()
+TEXT ·add_u8_wrap_32_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    MOVQ dst+24(FP), CX
+    CALL add_u8_wrap_32(SB)
+    RET
+
+// func add_u8_wrap_64_raw()
+TEXT ·add_u8_wrap_64_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    MOVQ dst+24(FP), CX
+    CALL add_u8_wrap_64(SB)
+    RET
+
+// func dot_u8_16_raw() uint64
+TEXT ·dot_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    CALL dot_u8_16(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func dot_u8_32_raw() uint64
+TEXT ·dot_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    CALL dot_u8_32(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func dot_u8_64_raw() uint64
+TEXT ·dot_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    CALL dot_u8_64(SB)
+    MOVQ AX, ret+24(FP)
+    RET
+
+// func dot_f32_16_raw() float64
+TEXT ·dot_f32_16_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    CALL dot_f32_16(SB)
+    MOVSD X0, ret+24(FP)
+    RET
+
+// func dot_f32_32_raw() float64
+TEXT ·dot_f32_32_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    CALL dot_f32_32(SB)
+    MOVSD X0, ret+24(FP)
+    RET
+
+// func dot_f32_64_raw() float64
+TEXT ·dot_f32_64_raw(SB), NOSPLIT, $0-32
+    MOVQ a+0(FP), DI
+    MOVQ b+8(FP), SI
+    MOVQ n+16(FP), DX
+    CALL dot_f32_64(SB)
+    MOVSD X0, ret+24(FP)
+    RET
+
+// func all_in_range_u8_16_raw() uint8
+TEXT ·all_in_range_u8_16_raw(SB), NOSPLIT, $0-25
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVBLZX lo+16(FP), DX
+    MOVBLZX hi+17(FP), CX
+    CALL all_in_range_u8_16(SB)
+    MOVB AL, ret+24(FP)
+    RET
+
+// func all_in_range_u8_32_raw() uint8
+TEXT ·all_in_range_u8_32_raw(SB), NOSPLIT, $0-25
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVBLZX lo+16(FP), DX
+    MOVBLZX hi+17(FP), CX
+    CALL all_in_range_u8_32(SB)
+    MOVB AL, ret+24(FP)
+    RET
+
+// func all_in_range_u8_64_raw() uint8
+TEXT ·all_in_range_u8_64_raw(SB), NOSPLIT, $0-25
+    MOVQ ptr+0(FP), DI
+    MOVQ n+8(FP), SI
+    MOVBLZX lo+16(FP), DX
+    MOVBLZX hi+17(FP), CX
+    CALL all_in_range_u8_64(SB)
+    MOVB AL, ret+24(FP)
+    RET
+
 // func trampoline_sanity_raw() uintptr
-TEXT ·trampoline_sanity_raw(SB), NOSPLIT, $0-56
+TEXT ·trampoline_sanity_raw(SB), NOSPLIT, $8-56
     MOVQ ptr+0(FP), DI
     MOVQ n+8(FP), SI
     MOVL val32+16(FP), DX
     MOVBLZX val8+20(FP), CX
     MOVQ val64+24(FP), R8
     MOVQ f64bits+32(FP), R9
-    SUBQ $8, SP
     MOVL f32bits+40(FP), AX
     MOVL AX, 0(SP)
     CALL trampoline_sanity(SB)
-    ADDQ $8, SP
     MOVQ AX, ret+48(FP)
     RET
 
 // func trampoline_echo_raw()
-TEXT ·trampoline_echo_raw(SB), NOSPLIT, $0-56
+TEXT ·trampoline_echo_raw(SB), NOSPLIT, $16-56
     MOVQ ptr+0(FP), DI
     MOVQ n+8(FP), SI
     MOVL v32+16(FP), DX
     MOVBLZX v8+20(FP), CX
     MOVQ v64+24(FP), R8
     MOVQ f64bits+32(FP), R9
-    SUBQ $16, SP
     MOVL f32bits+40(FP), AX
     MOVL AX, 0(SP)
-    MOVQ out+44(FP), AX
+    MOVQ out+48(FP), AX
     MOVQ AX, 8(SP)
     CALL trampoline_echo(SB)
-    ADDQ $16, SP
+    RET
+
+// func trampoline_echo_f64_raw() float64
+TEXT ·trampoline_echo_f64_raw(SB), NOSPLIT, $0-16
+    MOVQ bits+0(FP), DI
+    CALL trampoline_echo_f64(SB)
+    MOVSD X0, ret+8(FP)
     RET
 
@@ -1,6 +1,6 @@
 // Code generated by gen_trampolines; DO NOT EDIT.
-//go:build arm64
-// +build arm64
+//go:build arm64 && !simba_trace && !simba_noffi && !simba_reftramp
+// +build arm64,!simba_trace,!simba_noffi,!simba_reftramp
 
 #include "textflag.h"
 
@@ -126,6 +126,16 @@ TEXT ·eq_u8_masks64_raw(SB), NOSPLIT, $0-40
     MOVD R0, ret+32(FP)
     RET
 
+// func eq_any_masks64_raw() uintptr
+TEXT ·eq_any_masks64_raw(SB), NOSPLIT, $0-40
+    MOVD src+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD needles+16(FP), R2
+    MOVD out+24(FP), R3
+    CALL eq_any_masks64(SB)
+    MOVD R0, ret+32(FP)
+    RET
+
 // func eq_u8_masks16_raw() uintptr
 TEXT ·eq_u8_masks16_raw(SB), NOSPLIT, $0-40
     MOVD src+0(FP), R0
@@ -136,13 +146,43 @@ TEXT ·eq_u8_masks16_raw(SB), NOSPLIT, $0-40
     MOVD R0, ret+32(FP)
     RET
 
+// func lut_masks16_raw() uintptr
+TEXT ·lut_masks16_raw(SB), NOSPLIT, $0-40
+    MOVD src+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD table+16(FP), R2
+    MOVD out+24(FP), R3
+    CALL lut_masks16(SB)
+    MOVD R0, ret+32(FP)
+    RET
+
+// func lut_masks32_raw() uintptr
+TEXT ·lut_masks32_raw(SB), NOSPLIT, $0-40
+    MOVD src+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD table+16(FP), R2
+    MOVD out+24(FP), R3
+    CALL lut_masks32(SB)
+    MOVD R0, ret+32(FP)
+    RET
+
+// func lut_masks64_raw() uintptr
+TEXT ·lut_masks64_raw(SB), NOSPLIT, $0-40
+    MOVD src+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD table+16(FP), R2
+    MOVD out+24(FP), R3
+    CALL lut_masks64(SB)
+    MOVD R0, ret+32(FP)
+    RET
+
 // func noop_raw()
 TEXT ·noop_raw(SB), NOSPLIT, $0-0
     CALL noop(SB)
     RET
 
 // func crc32_update_32_raw() uint32
-TEXT ·crc32_update_32_raw(SB), NOSPLIT, $0-24
+TEXT ·crc32_update_32_raw(SB), NOSPLIT, $0-28
     MOVD ptr+0(FP), R0
     MOVD n+8(FP), R1
     MOVW init+16(FP), R2
@@ -151,7 +191,7 @@ TEXT ·crc32_update_32_raw(SB), NOSPLIT, $0-24
     RET
 
 // func crc32_update_64_raw() uint32
-TEXT ·crc32_update_64_raw(SB), NOSPLIT, $0-24
+TEXT ·crc32_update_64_raw(SB), NOSPLIT, $0-28
     MOVD ptr+0(FP), R0
     MOVD n+8(FP), R1
     MOVW init+16(FP), R2
@@ -168,6 +208,1082 @@ TEXT ·crc32_combine_raw(SB), NOSPLIT, $0-20
     MOVW R0, ret+16(FP)
     RET
 
+// func index_lt_u8_16_raw() uintptr
+TEXT ·index_lt_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVBU threshold+16(FP), R2
+    CALL index_lt_u8_16(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func index_lt_u8_32_raw() uintptr
+TEXT ·index_lt_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVBU threshold+16(FP), R2
+    CALL index_lt_u8_32(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func index_lt_u8_64_raw() uintptr
+TEXT ·index_lt_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVBU threshold+16(FP), R2
+    CALL index_lt_u8_64(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func last_index_lut16_raw() uintptr
+TEXT ·last_index_lut16_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD lut+16(FP), R2
+    CALL last_index_lut16(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func last_index_lut32_raw() uintptr
+TEXT ·last_index_lut32_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD lut+16(FP), R2
+    CALL last_index_lut32(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func last_index_lut64_raw() uintptr
+TEXT ·last_index_lut64_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD lut+16(FP), R2
+    CALL last_index_lut64(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func min_f32_16_raw() uint32
+TEXT ·min_f32_16_raw(SB), NOSPLIT, $0-20
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL min_f32_16(SB)
+    MOVW R0, ret+16(FP)
+    RET
+
+// func min_f32_32_raw() uint32
+TEXT ·min_f32_32_raw(SB), NOSPLIT, $0-20
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL min_f32_32(SB)
+    MOVW R0, ret+16(FP)
+    RET
+
+// func min_f32_64_raw() uint32
+TEXT ·min_f32_64_raw(SB), NOSPLIT, $0-20
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL min_f32_64(SB)
+    MOVW R0, ret+16(FP)
+    RET
+
+// func max_f32_16_raw() uint32
+TEXT ·max_f32_16_raw(SB), NOSPLIT, $0-20
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL max_f32_16(SB)
+    MOVW R0, ret+16(FP)
+    RET
+
+// func max_f32_32_raw() uint32
+TEXT ·max_f32_32_raw(SB), NOSPLIT, $0-20
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL max_f32_32(SB)
+    MOVW R0, ret+16(FP)
+    RET
+
+// func max_f32_64_raw() uint32
+TEXT ·max_f32_64_raw(SB), NOSPLIT, $0-20
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL max_f32_64(SB)
+    MOVW R0, ret+16(FP)
+    RET
+
+// func count_outside_u8_16_raw() uintptr
+TEXT ·count_outside_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVBU lo+16(FP), R2
+    MOVBU hi+17(FP), R3
+    CALL count_outside_u8_16(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func count_outside_u8_32_raw() uintptr
+TEXT ·count_outside_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVBU lo+16(FP), R2
+    MOVBU hi+17(FP), R3
+    CALL count_outside_u8_32(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func count_outside_u8_64_raw() uintptr
+TEXT ·count_outside_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVBU lo+16(FP), R2
+    MOVBU hi+17(FP), R3
+    CALL count_outside_u8_64(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func filter_u8_lut32_raw() uintptr
+TEXT ·filter_u8_lut32_raw(SB), NOSPLIT, $0-40
+    MOVD src+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD dst+16(FP), R2
+    MOVD lut+24(FP), R3
+    CALL filter_u8_lut32(SB)
+    MOVD R0, ret+32(FP)
+    RET
+
+// func filter_u8_lut64_raw() uintptr
+TEXT ·filter_u8_lut64_raw(SB), NOSPLIT, $0-40
+    MOVD src+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD dst+16(FP), R2
+    MOVD lut+24(FP), R3
+    CALL filter_u8_lut64(SB)
+    MOVD R0, ret+32(FP)
+    RET
+
+// func filter_u8_lut16_raw() uintptr
+TEXT ·filter_u8_lut16_raw(SB), NOSPLIT, $0-40
+    MOVD src+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD dst+16(FP), R2
+    MOVD lut+24(FP), R3
+    CALL filter_u8_lut16(SB)
+    MOVD R0, ret+32(FP)
+    RET
+
+// func validate_map_u8_lut32_raw() uintptr
+TEXT ·validate_map_u8_lut32_raw(SB), NOSPLIT, $0-48
+    MOVD src+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD dst+16(FP), R2
+    MOVD allowed+24(FP), R3
+    MOVD lut+32(FP), R4
+    CALL validate_map_u8_lut32(SB)
+    MOVD R0, ret+40(FP)
+    RET
+
+// func validate_map_u8_lut64_raw() uintptr
+TEXT ·validate_map_u8_lut64_raw(SB), NOSPLIT, $0-48
+    MOVD src+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD dst+16(FP), R2
+    MOVD allowed+24(FP), R3
+    MOVD lut+32(FP), R4
+    CALL validate_map_u8_lut64(SB)
+    MOVD R0, ret+40(FP)
+    RET
+
+// func validate_map_u8_lut16_raw() uintptr
+TEXT ·validate_map_u8_lut16_raw(SB), NOSPLIT, $0-48
+    MOVD src+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD dst+16(FP), R2
+    MOVD allowed+24(FP), R3
+    MOVD lut+32(FP), R4
+    CALL validate_map_u8_lut16(SB)
+    MOVD R0, ret+40(FP)
+    RET
+
+// func index_ne_u8_16_raw() uintptr
+TEXT ·index_ne_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVBU val+16(FP), R2
+    CALL index_ne_u8_16(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func index_ne_u8_32_raw() uintptr
+TEXT ·index_ne_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVBU val+16(FP), R2
+    CALL index_ne_u8_32(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func index_ne_u8_64_raw() uintptr
+TEXT ·index_ne_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVBU val+16(FP), R2
+    CALL index_ne_u8_64(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func index_pair_u8_16_raw() uintptr
+TEXT ·index_pair_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVBU b0+16(FP), R2
+    MOVBU b1+17(FP), R3
+    CALL index_pair_u8_16(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func index_pair_u8_32_raw() uintptr
+TEXT ·index_pair_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVBU b0+16(FP), R2
+    MOVBU b1+17(FP), R3
+    CALL index_pair_u8_32(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func index_pair_u8_64_raw() uintptr
+TEXT ·index_pair_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVBU b0+16(FP), R2
+    MOVBU b1+17(FP), R3
+    CALL index_pair_u8_64(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func xor_reduce_u8_16_raw() uint8
+TEXT ·xor_reduce_u8_16_raw(SB), NOSPLIT, $0-17
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL xor_reduce_u8_16(SB)
+    MOVBU R0, ret+16(FP)
+    RET
+
+// func xor_reduce_u8_32_raw() uint8
+TEXT ·xor_reduce_u8_32_raw(SB), NOSPLIT, $0-17
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL xor_reduce_u8_32(SB)
+    MOVBU R0, ret+16(FP)
+    RET
+
+// func xor_reduce_u8_64_raw() uint8
+TEXT ·xor_reduce_u8_64_raw(SB), NOSPLIT, $0-17
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL xor_reduce_u8_64(SB)
+    MOVBU R0, ret+16(FP)
+    RET
+
+// func count_class_transitions_u8_16_raw() uintptr
+TEXT ·count_class_transitions_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD table+16(FP), R2
+    CALL count_class_transitions_u8_16(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func count_class_transitions_u8_32_raw() uintptr
+TEXT ·count_class_transitions_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD table+16(FP), R2
+    CALL count_class_transitions_u8_32(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func count_class_transitions_u8_64_raw() uintptr
+TEXT ·count_class_transitions_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD table+16(FP), R2
+    CALL count_class_transitions_u8_64(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func is_sorted_u8_16_raw() uint8
+TEXT ·is_sorted_u8_16_raw(SB), NOSPLIT, $0-17
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL is_sorted_u8_16(SB)
+    MOVBU R0, ret+16(FP)
+    RET
+
+// func is_sorted_u8_32_raw() uint8
+TEXT ·is_sorted_u8_32_raw(SB), NOSPLIT, $0-17
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL is_sorted_u8_32(SB)
+    MOVBU R0, ret+16(FP)
+    RET
+
+// func is_sorted_u8_64_raw() uint8
+TEXT ·is_sorted_u8_64_raw(SB), NOSPLIT, $0-17
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL is_sorted_u8_64(SB)
+    MOVBU R0, ret+16(FP)
+    RET
+
+// func crc32_sum_u8_raw() uint32
+TEXT ·crc32_sum_u8_raw(SB), NOSPLIT, $0-36
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVW init+16(FP), R2
+    MOVD sum+24(FP), R3
+    CALL crc32_sum_u8(SB)
+    MOVW R0, ret+32(FP)
+    RET
+
+// func base32_encode_raw() uintptr
+TEXT ·base32_encode_raw(SB), NOSPLIT, $0-32
+    MOVD src+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD dst+16(FP), R2
+    CALL base32_encode(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func base32_decode_raw() uintptr
+TEXT ·base32_decode_raw(SB), NOSPLIT, $0-32
+    MOVD src+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD dst+16(FP), R2
+    CALL base32_decode(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func luhn_u8_16_raw() uint8
+TEXT ·luhn_u8_16_raw(SB), NOSPLIT, $0-17
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL luhn_u8_16(SB)
+    MOVBU R0, ret+16(FP)
+    RET
+
+// func luhn_u8_32_raw() uint8
+TEXT ·luhn_u8_32_raw(SB), NOSPLIT, $0-17
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL luhn_u8_32(SB)
+    MOVBU R0, ret+16(FP)
+    RET
+
+// func luhn_u8_64_raw() uint8
+TEXT ·luhn_u8_64_raw(SB), NOSPLIT, $0-17
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL luhn_u8_64(SB)
+    MOVBU R0, ret+16(FP)
+    RET
+
+// func popcount_and_u8_16_raw() uint64
+TEXT ·popcount_and_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    CALL popcount_and_u8_16(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func popcount_and_u8_32_raw() uint64
+TEXT ·popcount_and_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    CALL popcount_and_u8_32(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func popcount_and_u8_64_raw() uint64
+TEXT ·popcount_and_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    CALL popcount_and_u8_64(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func popcount_xor_u8_16_raw() uint64
+TEXT ·popcount_xor_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    CALL popcount_xor_u8_16(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func popcount_xor_u8_32_raw() uint64
+TEXT ·popcount_xor_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    CALL popcount_xor_u8_32(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func popcount_xor_u8_64_raw() uint64
+TEXT ·popcount_xor_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    CALL popcount_xor_u8_64(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func popcount_u8_16_raw() uint64
+TEXT ·popcount_u8_16_raw(SB), NOSPLIT, $0-24
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL popcount_u8_16(SB)
+    MOVD R0, ret+16(FP)
+    RET
+
+// func popcount_u8_32_raw() uint64
+TEXT ·popcount_u8_32_raw(SB), NOSPLIT, $0-24
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL popcount_u8_32(SB)
+    MOVD R0, ret+16(FP)
+    RET
+
+// func popcount_u8_64_raw() uint64
+TEXT ·popcount_u8_64_raw(SB), NOSPLIT, $0-24
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL popcount_u8_64(SB)
+    MOVD R0, ret+16(FP)
+    RET
+
+// func fletcher16_u8_16_raw() uint32
+TEXT ·fletcher16_u8_16_raw(SB), NOSPLIT, $0-20
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL fletcher16_u8_16(SB)
+    MOVW R0, ret+16(FP)
+    RET
+
+// func fletcher16_u8_32_raw() uint32
+TEXT ·fletcher16_u8_32_raw(SB), NOSPLIT, $0-20
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL fletcher16_u8_32(SB)
+    MOVW R0, ret+16(FP)
+    RET
+
+// func fletcher16_u8_64_raw() uint32
+TEXT ·fletcher16_u8_64_raw(SB), NOSPLIT, $0-20
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL fletcher16_u8_64(SB)
+    MOVW R0, ret+16(FP)
+    RET
+
+// func fletcher32_u16_16_raw() uint32
+TEXT ·fletcher32_u16_16_raw(SB), NOSPLIT, $0-20
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL fletcher32_u16_16(SB)
+    MOVW R0, ret+16(FP)
+    RET
+
+// func fletcher32_u16_32_raw() uint32
+TEXT ·fletcher32_u16_32_raw(SB), NOSPLIT, $0-20
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL fletcher32_u16_32(SB)
+    MOVW R0, ret+16(FP)
+    RET
+
+// func fletcher32_u16_64_raw() uint32
+TEXT ·fletcher32_u16_64_raw(SB), NOSPLIT, $0-20
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL fletcher32_u16_64(SB)
+    MOVW R0, ret+16(FP)
+    RET
+
+// func count_u8_16_raw() uint64
+TEXT ·count_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVBU needle+16(FP), R2
+    CALL count_u8_16(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func count_u8_32_raw() uint64
+TEXT ·count_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVBU needle+16(FP), R2
+    CALL count_u8_32(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func count_u8_64_raw() uint64
+TEXT ·count_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVBU needle+16(FP), R2
+    CALL count_u8_64(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func crc32_ieee_update_raw() uint32
+TEXT ·crc32_ieee_update_raw(SB), NOSPLIT, $0-28
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVW init+16(FP), R2
+    CALL crc32_ieee_update(SB)
+    MOVW R0, ret+24(FP)
+    RET
+
+// func sum_u16_16_raw() uint64
+TEXT ·sum_u16_16_raw(SB), NOSPLIT, $0-24
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL sum_u16_16(SB)
+    MOVD R0, ret+16(FP)
+    RET
+
+// func sum_u16_32_raw() uint64
+TEXT ·sum_u16_32_raw(SB), NOSPLIT, $0-24
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL sum_u16_32(SB)
+    MOVD R0, ret+16(FP)
+    RET
+
+// func sum_u16_64_raw() uint64
+TEXT ·sum_u16_64_raw(SB), NOSPLIT, $0-24
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL sum_u16_64(SB)
+    MOVD R0, ret+16(FP)
+    RET
+
+// func sum_u32_16_raw() uint64
+TEXT ·sum_u32_16_raw(SB), NOSPLIT, $0-24
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL sum_u32_16(SB)
+    MOVD R0, ret+16(FP)
+    RET
+
+// func sum_u32_32_raw() uint64
+TEXT ·sum_u32_32_raw(SB), NOSPLIT, $0-24
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL sum_u32_32(SB)
+    MOVD R0, ret+16(FP)
+    RET
+
+// func sum_u32_64_raw() uint64
+TEXT ·sum_u32_64_raw(SB), NOSPLIT, $0-24
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL sum_u32_64(SB)
+    MOVD R0, ret+16(FP)
+    RET
+
+// func min_u8_16_raw() uint8
+TEXT ·min_u8_16_raw(SB), NOSPLIT, $0-17
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL min_u8_16(SB)
+    MOVBU R0, ret+16(FP)
+    RET
+
+// func min_u8_32_raw() uint8
+TEXT ·min_u8_32_raw(SB), NOSPLIT, $0-17
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL min_u8_32(SB)
+    MOVBU R0, ret+16(FP)
+    RET
+
+// func min_u8_64_raw() uint8
+TEXT ·min_u8_64_raw(SB), NOSPLIT, $0-17
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL min_u8_64(SB)
+    MOVBU R0, ret+16(FP)
+    RET
+
+// func max_u8_16_raw() uint8
+TEXT ·max_u8_16_raw(SB), NOSPLIT, $0-17
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL max_u8_16(SB)
+    MOVBU R0, ret+16(FP)
+    RET
+
+// func max_u8_32_raw() uint8
+TEXT ·max_u8_32_raw(SB), NOSPLIT, $0-17
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL max_u8_32(SB)
+    MOVBU R0, ret+16(FP)
+    RET
+
+// func max_u8_64_raw() uint8
+TEXT ·max_u8_64_raw(SB), NOSPLIT, $0-17
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    CALL max_u8_64(SB)
+    MOVBU R0, ret+16(FP)
+    RET
+
+// func xor_u8_16_raw()
+TEXT ·xor_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    MOVD dst+24(FP), R3
+    CALL xor_u8_16(SB)
+    RET
+
+// func xor_u8_32_raw()
+TEXT ·xor_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    MOVD dst+24(FP), R3
+    CALL xor_u8_32(SB)
+    RET
+
+// func xor_u8_64_raw()
+TEXT ·xor_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    MOVD dst+24(FP), R3
+    CALL xor_u8_64(SB)
+    RET
+
+// func and_u8_16_raw()
+TEXT ·and_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    MOVD dst+24(FP), R3
+    CALL and_u8_16(SB)
+    RET
+
+// func and_u8_32_raw()
+TEXT ·and_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    MOVD dst+24(FP), R3
+    CALL and_u8_32(SB)
+    RET
+
+// func and_u8_64_raw()
+TEXT ·and_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    MOVD dst+24(FP), R3
+    CALL and_u8_64(SB)
+    RET
+
+// func or_u8_16_raw()
+TEXT ·or_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    MOVD dst+24(FP), R3
+    CALL or_u8_16(SB)
+    RET
+
+// func or_u8_32_raw()
+TEXT ·or_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    MOVD dst+24(FP), R3
+    CALL or_u8_32(SB)
+    RET
+
+// func or_u8_64_raw()
+TEXT ·or_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    MOVD dst+24(FP), R3
+    CALL or_u8_64(SB)
+    RET
+
+// func index_diff_u8_16_raw() uintptr
+TEXT ·index_diff_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    CALL index_diff_u8_16(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func index_diff_u8_32_raw() uintptr
+TEXT ·index_diff_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    CALL index_diff_u8_32(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func index_diff_u8_64_raw() uintptr
+TEXT ·index_diff_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    CALL index_diff_u8_64(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func index_diff_fold_u8_16_raw() uintptr
+TEXT ·index_diff_fold_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    CALL index_diff_fold_u8_16(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func index_diff_fold_u8_32_raw() uintptr
+TEXT ·index_diff_fold_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    CALL index_diff_fold_u8_32(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func index_diff_fold_u8_64_raw() uintptr
+TEXT ·index_diff_fold_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    CALL index_diff_fold_u8_64(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func hex_encode_raw() uintptr
+TEXT ·hex_encode_raw(SB), NOSPLIT, $0-32
+    MOVD src+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD dst+16(FP), R2
+    CALL hex_encode(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func hex_decode_raw() uintptr
+TEXT ·hex_decode_raw(SB), NOSPLIT, $0-32
+    MOVD src+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD dst+16(FP), R2
+    CALL hex_decode(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func base64_encode_raw() uintptr
+TEXT ·base64_encode_raw(SB), NOSPLIT, $0-40
+    MOVD src+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD dst+16(FP), R2
+    MOVBU url+24(FP), R3
+    CALL base64_encode(SB)
+    MOVD R0, ret+32(FP)
+    RET
+
+// func base64_decode_raw() uintptr
+TEXT ·base64_decode_raw(SB), NOSPLIT, $0-40
+    MOVD src+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD dst+16(FP), R2
+    MOVBU url+24(FP), R3
+    CALL base64_decode(SB)
+    MOVD R0, ret+32(FP)
+    RET
+
+// func replace_u8_16_raw() uint64
+TEXT ·replace_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVBU old+16(FP), R2
+    MOVBU new+17(FP), R3
+    CALL replace_u8_16(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func replace_u8_32_raw() uint64
+TEXT ·replace_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVBU old+16(FP), R2
+    MOVBU new+17(FP), R3
+    CALL replace_u8_32(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func replace_u8_64_raw() uint64
+TEXT ·replace_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVBU old+16(FP), R2
+    MOVBU new+17(FP), R3
+    CALL replace_u8_64(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func histogram_u8_16_raw()
+TEXT ·histogram_u8_16_raw(SB), NOSPLIT, $0-24
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD hist+16(FP), R2
+    CALL histogram_u8_16(SB)
+    RET
+
+// func histogram_u8_32_raw()
+TEXT ·histogram_u8_32_raw(SB), NOSPLIT, $0-24
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD hist+16(FP), R2
+    CALL histogram_u8_32(SB)
+    RET
+
+// func histogram_u8_64_raw()
+TEXT ·histogram_u8_64_raw(SB), NOSPLIT, $0-24
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD hist+16(FP), R2
+    CALL histogram_u8_64(SB)
+    RET
+
+// func any_u8_lut16_raw() uint8
+TEXT ·any_u8_lut16_raw(SB), NOSPLIT, $0-25
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD lut+16(FP), R2
+    CALL any_u8_lut16(SB)
+    MOVBU R0, ret+24(FP)
+    RET
+
+// func any_u8_lut32_raw() uint8
+TEXT ·any_u8_lut32_raw(SB), NOSPLIT, $0-25
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD lut+16(FP), R2
+    CALL any_u8_lut32(SB)
+    MOVBU R0, ret+24(FP)
+    RET
+
+// func any_u8_lut64_raw() uint8
+TEXT ·any_u8_lut64_raw(SB), NOSPLIT, $0-25
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD lut+16(FP), R2
+    CALL any_u8_lut64(SB)
+    MOVBU R0, ret+24(FP)
+    RET
+
+// func index_lut16_raw() uintptr
+TEXT ·index_lut16_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD lut+16(FP), R2
+    CALL index_lut16(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func index_lut32_raw() uintptr
+TEXT ·index_lut32_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD lut+16(FP), R2
+    CALL index_lut32(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func index_lut64_raw() uintptr
+TEXT ·index_lut64_raw(SB), NOSPLIT, $0-32
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVD lut+16(FP), R2
+    CALL index_lut64(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func is_ascii_batch_raw()
+TEXT ·is_ascii_batch_raw(SB), NOSPLIT, $0-32
+    MOVD descs+0(FP), R0
+    MOVD count+8(FP), R1
+    MOVD stride+16(FP), R2
+    MOVD out+24(FP), R3
+    CALL is_ascii_batch(SB)
+    RET
+
+// func validate_tag_batch_raw()
+TEXT ·validate_tag_batch_raw(SB), NOSPLIT, $0-48
+    MOVD descs+0(FP), R0
+    MOVD count+8(FP), R1
+    MOVD stride+16(FP), R2
+    MOVD minLen+24(FP), R3
+    MOVD maxLen+32(FP), R4
+    MOVD out+40(FP), R5
+    CALL validate_tag_batch(SB)
+    RET
+
+// func add_u8_sat_16_raw()
+TEXT ·add_u8_sat_16_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    MOVD dst+24(FP), R3
+    CALL add_u8_sat_16(SB)
+    RET
+
+// func add_u8_sat_32_raw()
+TEXT ·add_u8_sat_32_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    MOVD dst+24(FP), R3
+    CALL add_u8_sat_32(SB)
+    RET
+
+// func add_u8_sat_64_raw()
+TEXT ·add_u8_sat_64_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    MOVD dst+24(FP), R3
+    CALL add_u8_sat_64(SB)
+    RET
+
+// func add_u8_wrap_16_raw()
+TEXT ·add_u8_wrap_16_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    MOVD dst+24(FP), R3
+    CALL add_u8_wrap_16(SB)
+    RET
+
+// func add_u8_wrap_32_raw()
+TEXT ·add_u8_wrap_32_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    MOVD dst+24(FP), R3
+    CALL add_u8_wrap_32(SB)
+    RET
+
+// func add_u8_wrap_64_raw()
+TEXT ·add_u8_wrap_64_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    MOVD dst+24(FP), R3
+    CALL add_u8_wrap_64(SB)
+    RET
+
+// func dot_u8_16_raw() uint64
+TEXT ·dot_u8_16_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    CALL dot_u8_16(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func dot_u8_32_raw() uint64
+TEXT ·dot_u8_32_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    CALL dot_u8_32(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func dot_u8_64_raw() uint64
+TEXT ·dot_u8_64_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    CALL dot_u8_64(SB)
+    MOVD R0, ret+24(FP)
+    RET
+
+// func dot_f32_16_raw() float64
+TEXT ·dot_f32_16_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    CALL dot_f32_16(SB)
+    FMOVD F0, ret+24(FP)
+    RET
+
+// func dot_f32_32_raw() float64
+TEXT ·dot_f32_32_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    CALL dot_f32_32(SB)
+    FMOVD F0, ret+24(FP)
+    RET
+
+// func dot_f32_64_raw() float64
+TEXT ·dot_f32_64_raw(SB), NOSPLIT, $0-32
+    MOVD a+0(FP), R0
+    MOVD b+8(FP), R1
+    MOVD n+16(FP), R2
+    CALL dot_f32_64(SB)
+    FMOVD F0, ret+24(FP)
+    RET
+
+// func all_in_range_u8_16_raw() uint8
+TEXT ·all_in_range_u8_16_raw(SB), NOSPLIT, $0-25
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVBU lo+16(FP), R2
+    MOVBU hi+17(FP), R3
+    CALL all_in_range_u8_16(SB)
+    MOVBU R0, ret+24(FP)
+    RET
+
+// func all_in_range_u8_32_raw() uint8
+TEXT ·all_in_range_u8_32_raw(SB), NOSPLIT, $0-25
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVBU lo+16(FP), R2
+    MOVBU hi+17(FP), R3
+    CALL all_in_range_u8_32(SB)
+    MOVBU R0, ret+24(FP)
+    RET
+
+// func all_in_range_u8_64_raw() uint8
+TEXT ·all_in_range_u8_64_raw(SB), NOSPLIT, $0-25
+    MOVD ptr+0(FP), R0
+    MOVD n+8(FP), R1
+    MOVBU lo+16(FP), R2
+    MOVBU hi+17(FP), R3
+    CALL all_in_range_u8_64(SB)
+    MOVBU R0, ret+24(FP)
+    RET
+
 // func trampoline_sanity_raw() uintptr
 TEXT ·trampoline_sanity_raw(SB), NOSPLIT, $0-56
     MOVD ptr+0(FP), R0
@@ -194,3 +1310,10 @@ TEXT ·trampoline_echo_raw(SB), NOSPLIT, $0-56
     CALL trampoline_echo(SB)
     RET
 
+// func trampoline_echo_f64_raw() float64
+TEXT ·trampoline_echo_f64_raw(SB), NOSPLIT, $0-16
+    MOVD bits+0(FP), R0
+    CALL trampoline_echo_f64(SB)
+    FMOVD F0, ret+8(FP)
+    RET
+
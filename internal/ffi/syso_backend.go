@@ -23,6 +23,12 @@
 //go:generate go run ../../scripts/gen_trampolines
 package ffi
 
+import (
+	"math"
+	"runtime"
+	"unsafe"
+)
+
 // Width-specific thin wrappers around the raw assembly syscalls.  Higher-level
 // packages decide which lane width to use based on slice length.
 
@@ -183,153 +189,1335 @@ func EqU8Masks16(data []byte, needle byte, out []uint16) int {
 	return chunks * 16
 }
 
-//go:noinline
-func Noop() {
-	noop_raw()
+// EqAnyMasks64 writes one 64-bit mask per full 64-byte chunk of data, with
+// bit i set when data[i] equals any of the eight needles.  Unused needle
+// slots should repeat a real needle.  Tail bytes are skipped.  Returns bytes
+// processed.
+func EqAnyMasks64(data []byte, needles *[8]byte, out []uint64) int {
+	chunks := len(data) / 64
+	if chunks == 0 {
+		return 0
+	}
+	if len(out) < chunks {
+		panic("ffi: EqAnyMasks64 out slice too short")
+	}
+	eq_any_masks64_raw(&data[0], uintptr(len(data)), needles, &out[0])
+	return chunks * 64
 }
 
-// Crc32Update32 updates CRC32 using the 32-lane SIMD kernel.
-func Crc32Update32(data []byte, init uint32) uint32 {
+// InSetMasks16 writes one 16-bit mask per full 16-byte chunk of data, with
+// bit i set when lut[data[i]] != 0.  Tail bytes are skipped.  Returns bytes
+// processed.
+func InSetMasks16(data []byte, lut *[256]byte, out []uint16) int {
+	chunks := len(data) / 16
+	if chunks == 0 {
+		return 0
+	}
+	if len(out) < chunks {
+		panic("ffi: InSetMasks16 out slice too short")
+	}
+	lut_masks16_raw(&data[0], uintptr(len(data)), &lut[0], &out[0])
+	return chunks * 16
+}
+
+// InSetMasks32 is the 32-lane variant of InSetMasks16.
+func InSetMasks32(data []byte, lut *[256]byte, out []uint32) int {
+	chunks := len(data) / 32
+	if chunks == 0 {
+		return 0
+	}
+	if len(out) < chunks {
+		panic("ffi: InSetMasks32 out slice too short")
+	}
+	lut_masks32_raw(&data[0], uintptr(len(data)), &lut[0], &out[0])
+	return chunks * 32
+}
+
+// InSetMasks64 is the 64-lane variant of InSetMasks16.
+func InSetMasks64(data []byte, lut *[256]byte, out []uint64) int {
+	chunks := len(data) / 64
+	if chunks == 0 {
+		return 0
+	}
+	if len(out) < chunks {
+		panic("ffi: InSetMasks64 out slice too short")
+	}
+	lut_masks64_raw(&data[0], uintptr(len(data)), &lut[0], &out[0])
+	return chunks * 64
+}
+
+// IndexByteLess16 returns the offset of the first byte < threshold using the
+// 16-lane kernel, or -1 if there is none.
+func IndexByteLess16(data []byte, threshold byte) int {
 	if len(data) == 0 {
-		return init
+		return -1
 	}
-	return crc32_update_32_raw(&data[0], uintptr(len(data)), init)
+	return indexOrNone(index_lt_u8_16_raw(&data[0], uintptr(len(data)), threshold), len(data))
 }
 
-// Crc32Update64 updates CRC32 using the 64-lane SIMD kernel.
-func Crc32Update64(data []byte, init uint32) uint32 {
+// IndexByteLess32 is the 32-lane variant of IndexByteLess16.
+func IndexByteLess32(data []byte, threshold byte) int {
 	if len(data) == 0 {
-		return init
+		return -1
 	}
-	return crc32_update_64_raw(&data[0], uintptr(len(data)), init)
+	return indexOrNone(index_lt_u8_32_raw(&data[0], uintptr(len(data)), threshold), len(data))
 }
 
-// Crc32Combine returns CRC32 of concatenation of two buffers given their CRCs
-// and the length of the second buffer.
-func Crc32Combine(crc1, crc2 uint32, len2 int) uint32 {
-	return crc32_combine_raw(crc1, crc2, uintptr(len2))
+// IndexByteLess64 is the 64-lane variant of IndexByteLess16.
+func IndexByteLess64(data []byte, threshold byte) int {
+	if len(data) == 0 {
+		return -1
+	}
+	return indexOrNone(index_lt_u8_64_raw(&data[0], uintptr(len(data)), threshold), len(data))
 }
 
-// Echo mirrors the rust Echo struct; used only in trampoline tests.
-type Echo struct {
-	Ptr     uintptr
-	Len     uintptr
-	V32     uint32
-	V8      uint8
-	_pad1   [3]byte // align
-	V64     uint64
-	F64Bits uint64
-	F32Bits uint32
-	_pad2   [4]byte
+// LastIndexAnyByte16 returns the offset of the last byte whose LUT entry is
+// non-zero, scanning 16-byte chunks from the end, or -1 if there is none.
+func LastIndexAnyByte16(data []byte, lut *[256]byte) int {
+	if len(data) == 0 {
+		return -1
+	}
+	return indexOrNone(last_index_lut16_raw(&data[0], uintptr(len(data)), &lut[0]), len(data))
 }
 
-// --- raw syscall signatures implemented in assembly ---
-//
-// Each Go prototype below is paired with a **trampoline** implemented in
-// architecture-specific assembly (`syso_*.s`).  To keep the two in sync we
-// tag every prototype with:
-//
-//   //simba:trampoline <arches>
-//
-// The `gen_trampolines` generator (invoked via `go:generate` above) scans the
-// package, finds these tags, and auto-writes the minimal `MOV / CALL / RET`
-// stubs for the listed architectures.  Adding a new FFI symbol now requires
-// only the Go prototype plus this comment—no hand-edited assembly.
-//
+// LastIndexAnyByte32 is the 32-lane variant of LastIndexAnyByte16.
+func LastIndexAnyByte32(data []byte, lut *[256]byte) int {
+	if len(data) == 0 {
+		return -1
+	}
+	return indexOrNone(last_index_lut32_raw(&data[0], uintptr(len(data)), &lut[0]), len(data))
+}
+
+// LastIndexAnyByte64 is the 64-lane variant of LastIndexAnyByte16.
+func LastIndexAnyByte64(data []byte, lut *[256]byte) int {
+	if len(data) == 0 {
+		return -1
+	}
+	return indexOrNone(last_index_lut64_raw(&data[0], uintptr(len(data)), &lut[0]), len(data))
+}
+
+// MinF32_16 returns the minimum of data using the 16-lane kernel.  NaN
+// propagates and -0 < +0, as with math.Min; an empty slice yields +Inf.
+func MinF32_16(data []float32) float32 {
+	if len(data) == 0 {
+		return float32(math.Inf(1))
+	}
+	return math.Float32frombits(min_f32_16_raw(&data[0], uintptr(len(data))))
+}
+
+// MinF32_32 is the 32-lane variant of MinF32_16.
+func MinF32_32(data []float32) float32 {
+	if len(data) == 0 {
+		return float32(math.Inf(1))
+	}
+	return math.Float32frombits(min_f32_32_raw(&data[0], uintptr(len(data))))
+}
+
+// MinF32_64 is the 64-lane variant of MinF32_16.
+func MinF32_64(data []float32) float32 {
+	if len(data) == 0 {
+		return float32(math.Inf(1))
+	}
+	return math.Float32frombits(min_f32_64_raw(&data[0], uintptr(len(data))))
+}
+
+// MaxF32_16 returns the maximum of data using the 16-lane kernel.  NaN
+// propagates and +0 > -0, as with math.Max; an empty slice yields -Inf.
+func MaxF32_16(data []float32) float32 {
+	if len(data) == 0 {
+		return float32(math.Inf(-1))
+	}
+	return math.Float32frombits(max_f32_16_raw(&data[0], uintptr(len(data))))
+}
+
+// MaxF32_32 is the 32-lane variant of MaxF32_16.
+func MaxF32_32(data []float32) float32 {
+	if len(data) == 0 {
+		return float32(math.Inf(-1))
+	}
+	return math.Float32frombits(max_f32_32_raw(&data[0], uintptr(len(data))))
+}
+
+// MaxF32_64 is the 64-lane variant of MaxF32_16.
+func MaxF32_64(data []float32) float32 {
+	if len(data) == 0 {
+		return float32(math.Inf(-1))
+	}
+	return math.Float32frombits(max_f32_64_raw(&data[0], uintptr(len(data))))
+}
+
+// CountOutsideRange16 counts bytes outside the inclusive range [lo, hi] using
+// the 16-lane kernel.
+func CountOutsideRange16(data []byte, lo, hi byte) int {
+	if len(data) == 0 {
+		return 0
+	}
+	return int(count_outside_u8_16_raw(&data[0], uintptr(len(data)), lo, hi))
+}
+
+// CountOutsideRange32 is the 32-lane variant of CountOutsideRange16.
+func CountOutsideRange32(data []byte, lo, hi byte) int {
+	if len(data) == 0 {
+		return 0
+	}
+	return int(count_outside_u8_32_raw(&data[0], uintptr(len(data)), lo, hi))
+}
+
+// CountOutsideRange64 is the 64-lane variant of CountOutsideRange16.
+func CountOutsideRange64(data []byte, lo, hi byte) int {
+	if len(data) == 0 {
+		return 0
+	}
+	return int(count_outside_u8_64_raw(&data[0], uintptr(len(data)), lo, hi))
+}
+
+// FilterBytes32 copies the bytes of src whose LUT entry is non-zero into dst,
+// compacting them, using the 32-lane kernel.  Returns the number of bytes
+// written.  dst may be src itself but must not otherwise overlap it.
+func FilterBytes32(dst, src []byte, lut *[256]byte) int {
+	if len(src) == 0 {
+		return 0
+	}
+	if len(dst) < len(src) {
+		panic("ffi: FilterBytes dst slice too short")
+	}
+	return int(filter_u8_lut32_raw(&src[0], uintptr(len(src)), &dst[0], &lut[0]))
+}
+
+// FilterBytes64 is the 64-lane variant of FilterBytes32.
+func FilterBytes64(dst, src []byte, lut *[256]byte) int {
+	if len(src) == 0 {
+		return 0
+	}
+	if len(dst) < len(src) {
+		panic("ffi: FilterBytes dst slice too short")
+	}
+	return int(filter_u8_lut64_raw(&src[0], uintptr(len(src)), &dst[0], &lut[0]))
+}
+
+// FilterBytes16 is the 16-lane variant of FilterBytes32.
+func FilterBytes16(dst, src []byte, lut *[256]byte) int {
+	if len(src) == 0 {
+		return 0
+	}
+	if len(dst) < len(src) {
+		panic("ffi: FilterBytes dst slice too short")
+	}
+	return int(filter_u8_lut16_raw(&src[0], uintptr(len(src)), &dst[0], &lut[0]))
+}
+
+// ValidateMapBytes32 maps src through lut into dst while checking every byte
+// against allowed (non-zero entry marks a valid byte), in one pass of the
+// 32-lane kernel.  It stops at the first invalid byte and returns its offset,
+// with every byte before it mapped, or len(src) if all bytes are valid.  dst
+// may be src itself but must not otherwise overlap it.
+func ValidateMapBytes32(dst, src []byte, allowed, lut *[256]byte) int {
+	if len(src) == 0 {
+		return 0
+	}
+	if len(dst) < len(src) {
+		panic("ffi: ValidateMapBytes dst slice too short")
+	}
+	return int(validate_map_u8_lut32_raw(&src[0], uintptr(len(src)), &dst[0], &allowed[0], &lut[0]))
+}
+
+// ValidateMapBytes64 is the 64-lane variant of ValidateMapBytes32.
+func ValidateMapBytes64(dst, src []byte, allowed, lut *[256]byte) int {
+	if len(src) == 0 {
+		return 0
+	}
+	if len(dst) < len(src) {
+		panic("ffi: ValidateMapBytes dst slice too short")
+	}
+	return int(validate_map_u8_lut64_raw(&src[0], uintptr(len(src)), &dst[0], &allowed[0], &lut[0]))
+}
+
+// ValidateMapBytes16 is the 16-lane variant of ValidateMapBytes32.
+func ValidateMapBytes16(dst, src []byte, allowed, lut *[256]byte) int {
+	if len(src) == 0 {
+		return 0
+	}
+	if len(dst) < len(src) {
+		panic("ffi: ValidateMapBytes dst slice too short")
+	}
+	return int(validate_map_u8_lut16_raw(&src[0], uintptr(len(src)), &dst[0], &allowed[0], &lut[0]))
+}
+
+// IndexNotByte16 returns the offset of the first byte != val using the
+// 16-lane kernel, or -1 if every byte equals val.
+func IndexNotByte16(data []byte, val byte) int {
+	if len(data) == 0 {
+		return -1
+	}
+	return indexOrNone(index_ne_u8_16_raw(&data[0], uintptr(len(data)), val), len(data))
+}
+
+// IndexNotByte32 is the 32-lane variant of IndexNotByte16.
+func IndexNotByte32(data []byte, val byte) int {
+	if len(data) == 0 {
+		return -1
+	}
+	return indexOrNone(index_ne_u8_32_raw(&data[0], uintptr(len(data)), val), len(data))
+}
+
+// IndexNotByte64 is the 64-lane variant of IndexNotByte16.
+func IndexNotByte64(data []byte, val byte) int {
+	if len(data) == 0 {
+		return -1
+	}
+	return indexOrNone(index_ne_u8_64_raw(&data[0], uintptr(len(data)), val), len(data))
+}
+
+// IndexBytePair16 returns the first offset i with data[i] == b0 and
+// data[i+1] == b1 using the 16-lane kernel, or -1 if the pair does not
+// occur.
+func IndexBytePair16(data []byte, b0, b1 byte) int {
+	if len(data) == 0 {
+		return -1
+	}
+	return indexOrNone(index_pair_u8_16_raw(&data[0], uintptr(len(data)), b0, b1), len(data))
+}
+
+// IndexBytePair32 is the 32-lane variant of IndexBytePair16.
+func IndexBytePair32(data []byte, b0, b1 byte) int {
+	if len(data) == 0 {
+		return -1
+	}
+	return indexOrNone(index_pair_u8_32_raw(&data[0], uintptr(len(data)), b0, b1), len(data))
+}
 
-//simba:trampoline amd64 arm64
-//go:noescape
-func sum_u8_32_raw(ptr *byte, n uintptr) uint32
+// IndexBytePair64 is the 64-lane variant of IndexBytePair16.
+func IndexBytePair64(data []byte, b0, b1 byte) int {
+	if len(data) == 0 {
+		return -1
+	}
+	return indexOrNone(index_pair_u8_64_raw(&data[0], uintptr(len(data)), b0, b1), len(data))
+}
 
-//simba:trampoline amd64 arm64
-//go:noescape
-func sum_u8_64_raw(ptr *byte, n uintptr) uint32
+// indexOrNone converts the Rust "not found" convention (an offset equal to the
+// input length) into Go's -1.
+func indexOrNone(idx uintptr, n int) int {
+	if int(idx) >= n {
+		return -1
+	}
+	return int(idx)
+}
 
-//simba:trampoline amd64 arm64
-//go:noescape
-func sum_u8_16_raw(ptr *byte, n uintptr) uint32
+// boolToU8 passes a Go bool to a kernel flag argument.
+func boolToU8(b bool) uint8 {
+	if b {
+		return 1
+	}
+	return 0
+}
 
-//simba:trampoline amd64 arm64
-//go:noescape
-func is_ascii32_raw(ptr *byte, n uintptr) uint8
+// XorReduce16 returns the XOR of all bytes using the 16-lane kernel.
+func XorReduce16(data []byte) byte {
+	if len(data) == 0 {
+		return 0
+	}
+	return xor_reduce_u8_16_raw(&data[0], uintptr(len(data)))
+}
 
-//simba:trampoline amd64 arm64
-//go:noescape
-func is_ascii64_raw(ptr *byte, n uintptr) uint8
+// XorReduce32 returns the XOR of all bytes using the 32-lane kernel.
+func XorReduce32(data []byte) byte {
+	if len(data) == 0 {
+		return 0
+	}
+	return xor_reduce_u8_32_raw(&data[0], uintptr(len(data)))
+}
 
-//simba:trampoline amd64 arm64
-//go:noescape
-func is_ascii16_raw(ptr *byte, n uintptr) uint8
+// XorReduce64 returns the XOR of all bytes using the 64-lane kernel.
+func XorReduce64(data []byte) byte {
+	if len(data) == 0 {
+		return 0
+	}
+	return xor_reduce_u8_64_raw(&data[0], uintptr(len(data)))
+}
 
-//simba:trampoline amd64 arm64
-//go:noescape
-func validate_u8_lut32_raw(ptr *byte, n uintptr, lut *byte) uint8
+// CountClassTransitions16 counts positions i > 0 where
+// class[data[i]] != class[data[i-1]] using the 16-lane kernel.
+func CountClassTransitions16(data []byte, class *[256]byte) int {
+	if len(data) == 0 {
+		return 0
+	}
+	return int(count_class_transitions_u8_16_raw(&data[0], uintptr(len(data)), &class[0]))
+}
 
-//simba:trampoline amd64 arm64
-//go:noescape
-func validate_u8_lut64_raw(ptr *byte, n uintptr, lut *byte) uint8
+// CountClassTransitions32 is the 32-lane variant of CountClassTransitions16.
+func CountClassTransitions32(data []byte, class *[256]byte) int {
+	if len(data) == 0 {
+		return 0
+	}
+	return int(count_class_transitions_u8_32_raw(&data[0], uintptr(len(data)), &class[0]))
+}
 
-//simba:trampoline amd64 arm64
-//go:noescape
-func validate_u8_lut16_raw(ptr *byte, n uintptr, lut *byte) uint8
+// CountClassTransitions64 is the 64-lane variant of CountClassTransitions16.
+func CountClassTransitions64(data []byte, class *[256]byte) int {
+	if len(data) == 0 {
+		return 0
+	}
+	return int(count_class_transitions_u8_64_raw(&data[0], uintptr(len(data)), &class[0]))
+}
 
-//simba:trampoline amd64 arm64
-//go:noescape
-func map_u8_lut32_raw(src *byte, n uintptr, dst *byte, lut *byte)
+// IsSortedU8_16 reports whether data is in non-decreasing order using the
+// 16-lane kernel.
+func IsSortedU8_16(data []byte) bool {
+	if len(data) == 0 {
+		return true
+	}
+	return is_sorted_u8_16_raw(&data[0], uintptr(len(data))) != 0
+}
 
-//simba:trampoline amd64 arm64
-//go:noescape
-func map_u8_lut64_raw(src *byte, n uintptr, dst *byte, lut *byte)
+// IsSortedU8_32 is the 32-lane variant of IsSortedU8_16.
+func IsSortedU8_32(data []byte) bool {
+	if len(data) == 0 {
+		return true
+	}
+	return is_sorted_u8_32_raw(&data[0], uintptr(len(data))) != 0
+}
 
-//simba:trampoline amd64 arm64
-//go:noescape
-func map_u8_lut16_raw(src *byte, n uintptr, dst *byte, lut *byte)
+// IsSortedU8_64 is the 64-lane variant of IsSortedU8_16.
+func IsSortedU8_64(data []byte) bool {
+	if len(data) == 0 {
+		return true
+	}
+	return is_sorted_u8_64_raw(&data[0], uintptr(len(data))) != 0
+}
 
-//simba:trampoline amd64 arm64
-//go:noescape
-func eq_u8_masks32_raw(src *byte, n uintptr, needle uint8, out *uint32) uintptr
+// Crc32AndSum updates CRC32C with data and returns it together with the
+// widening byte sum of data, reading the buffer once.
+func Crc32AndSum(data []byte, init uint32) (uint32, uint64) {
+	if len(data) == 0 {
+		return init, 0
+	}
+	var sum uint64
+	crc := crc32_sum_u8_raw(&data[0], uintptr(len(data)), init, &sum)
+	return crc, sum
+}
 
-//simba:trampoline amd64 arm64
-//go:noescape
-func eq_u8_masks64_raw(src *byte, n uintptr, needle uint8, out *uint64) uintptr
+// Base32EncodeBlocks base32-encodes the complete 5-byte groups of src into
+// dst and returns the number of bytes written (len(src)/5*8).
+func Base32EncodeBlocks(dst, src []byte) int {
+	if len(src) < 5 {
+		return 0
+	}
+	if len(dst) < len(src)/5*8 {
+		panic("ffi: Base32EncodeBlocks dst slice too short")
+	}
+	return int(base32_encode_raw(&src[0], uintptr(len(src)), &dst[0]))
+}
 
-//simba:trampoline amd64 arm64
-//go:noescape
-func eq_u8_masks16_raw(src *byte, n uintptr, needle uint8, out *uint16) uintptr
+// Base32DecodeBlocks decodes the complete 8-char groups of src into dst,
+// stopping before the first group with a byte outside the alphabet.  Returns
+// the number of src bytes consumed (a multiple of 8).
+func Base32DecodeBlocks(dst, src []byte) int {
+	if len(src) < 8 {
+		return 0
+	}
+	if len(dst) < len(src)/8*5 {
+		panic("ffi: Base32DecodeBlocks dst slice too short")
+	}
+	return int(base32_decode_raw(&src[0], uintptr(len(src)), &dst[0]))
+}
 
-//simba:trampoline amd64 arm64
-//go:noescape
-func noop_raw()
+// LuhnValid16 reports whether the ASCII digits in data pass the Luhn check
+// using the 16-lane kernel.  Non-digit bytes fail the check.
+func LuhnValid16(data []byte) bool {
+	if len(data) == 0 {
+		return false
+	}
+	return luhn_u8_16_raw(&data[0], uintptr(len(data))) != 0
+}
 
-//simba:trampoline amd64 arm64
-//go:noescape
-func crc32_update_32_raw(ptr *byte, n uintptr, init uint32) uint32
+// LuhnValid32 is the 32-lane variant of LuhnValid16.
+func LuhnValid32(data []byte) bool {
+	if len(data) == 0 {
+		return false
+	}
+	return luhn_u8_32_raw(&data[0], uintptr(len(data))) != 0
+}
 
-//simba:trampoline amd64 arm64
-//go:noescape
-func crc32_update_64_raw(ptr *byte, n uintptr, init uint32) uint32
+// LuhnValid64 is the 64-lane variant of LuhnValid16.
+func LuhnValid64(data []byte) bool {
+	if len(data) == 0 {
+		return false
+	}
+	return luhn_u8_64_raw(&data[0], uintptr(len(data))) != 0
+}
 
-//simba:trampoline amd64 arm64
-//go:noescape
-func crc32_combine_raw(crc1 uint32, crc2 uint32, len2 uintptr) uint32
+// PopCountAnd16 returns the number of set bits in a[i]&b[i] summed over
+// both slices using the 16-lane kernel.  a and b must have equal length.
+func PopCountAnd16(a, b []byte) uint64 {
+	if len(a) != len(b) {
+		panic("ffi: PopCountAnd slices differ in length")
+	}
+	if len(a) == 0 {
+		return 0
+	}
+	return popcount_and_u8_16_raw(&a[0], &b[0], uintptr(len(a)))
+}
 
-//simba:trampoline amd64 arm64
-//go:noescape
-func trampoline_sanity_raw(ptr *byte, n uintptr, val32 uint32, val8 uint8, val64 uint64, f64bits uint64, f32bits uint32) uintptr
+// PopCountAnd32 is the 32-lane variant of PopCountAnd16.
+func PopCountAnd32(a, b []byte) uint64 {
+	if len(a) != len(b) {
+		panic("ffi: PopCountAnd slices differ in length")
+	}
+	if len(a) == 0 {
+		return 0
+	}
+	return popcount_and_u8_32_raw(&a[0], &b[0], uintptr(len(a)))
+}
 
-// TrampolineSanityHash returns a 64-bit mix of the four arguments. Used by
-// tests to verify that trampolines marshal arguments verbatim.
-func TrampolineSanityHash(ptr *byte, length uintptr, v32 uint32, v8 uint8, v64 uint64, f64bits uint64, f32bits uint32) uintptr {
-	return trampoline_sanity_raw(ptr, length, v32, v8, v64, f64bits, f32bits)
+// PopCountAnd64 is the 64-lane variant of PopCountAnd16.
+func PopCountAnd64(a, b []byte) uint64 {
+	if len(a) != len(b) {
+		panic("ffi: PopCountAnd slices differ in length")
+	}
+	if len(a) == 0 {
+		return 0
+	}
+	return popcount_and_u8_64_raw(&a[0], &b[0], uintptr(len(a)))
 }
 
-//simba:trampoline amd64 arm64
-//go:noescape
-func trampoline_echo_raw(ptr *byte, n uintptr, v32 uint32, v8 uint8, v64 uint64, f64bits uint64, f32bits uint32, out *Echo)
+// PopCountXor16 returns the number of set bits in a[i]^b[i] summed over
+// both slices using the 16-lane kernel.  a and b must have equal length.
+func PopCountXor16(a, b []byte) uint64 {
+	if len(a) != len(b) {
+		panic("ffi: PopCountXor slices differ in length")
+	}
+	if len(a) == 0 {
+		return 0
+	}
+	return popcount_xor_u8_16_raw(&a[0], &b[0], uintptr(len(a)))
+}
 
-// TrampolineEcho calls the echo helper for debugging.
-func TrampolineEcho(ptr *byte, length uintptr, v32 uint32, v8 uint8, v64 uint64, f64bits uint64, f32bits uint32) Echo {
-	var e Echo
-	trampoline_echo_raw(ptr, length, v32, v8, v64, f64bits, f32bits, &e)
-	return e
+// PopCountXor32 is the 32-lane variant of PopCountXor16.
+func PopCountXor32(a, b []byte) uint64 {
+	if len(a) != len(b) {
+		panic("ffi: PopCountXor slices differ in length")
+	}
+	if len(a) == 0 {
+		return 0
+	}
+	return popcount_xor_u8_32_raw(&a[0], &b[0], uintptr(len(a)))
+}
+
+// PopCountXor64 is the 64-lane variant of PopCountXor16.
+func PopCountXor64(a, b []byte) uint64 {
+	if len(a) != len(b) {
+		panic("ffi: PopCountXor slices differ in length")
+	}
+	if len(a) == 0 {
+		return 0
+	}
+	return popcount_xor_u8_64_raw(&a[0], &b[0], uintptr(len(a)))
+}
+
+// PopCount16 returns the number of set bits in data using the 16-lane
+// kernel.
+func PopCount16(data []byte) uint64 {
+	if len(data) == 0 {
+		return 0
+	}
+	return popcount_u8_16_raw(&data[0], uintptr(len(data)))
+}
+
+// PopCount32 is the 32-lane variant of PopCount16.
+func PopCount32(data []byte) uint64 {
+	if len(data) == 0 {
+		return 0
+	}
+	return popcount_u8_32_raw(&data[0], uintptr(len(data)))
+}
+
+// PopCount64 is the 64-lane variant of PopCount16.
+func PopCount64(data []byte) uint64 {
+	if len(data) == 0 {
+		return 0
+	}
+	return popcount_u8_64_raw(&data[0], uintptr(len(data)))
+}
+
+// Fletcher16_16 returns the Fletcher-16 checksum of data using the 16-lane
+// kernel.
+func Fletcher16_16(data []byte) uint16 {
+	if len(data) == 0 {
+		return 0
+	}
+	return uint16(fletcher16_u8_16_raw(&data[0], uintptr(len(data))))
+}
+
+// Fletcher16_32 is the 32-lane variant of Fletcher16_16.
+func Fletcher16_32(data []byte) uint16 {
+	if len(data) == 0 {
+		return 0
+	}
+	return uint16(fletcher16_u8_32_raw(&data[0], uintptr(len(data))))
+}
+
+// Fletcher16_64 is the 64-lane entry point of Fletcher16_16.  It runs the
+// 32-lane loop, as 64 lanes of state would not fit the kernel's stack budget.
+func Fletcher16_64(data []byte) uint16 {
+	if len(data) == 0 {
+		return 0
+	}
+	return uint16(fletcher16_u8_64_raw(&data[0], uintptr(len(data))))
+}
+
+// Fletcher32_16 returns the Fletcher-32 checksum of data, read as
+// little-endian 16-bit words, using the 16-lane kernel.
+func Fletcher32_16(data []byte) uint32 {
+	if len(data) == 0 {
+		return 0
+	}
+	return fletcher32_u16_16_raw(&data[0], uintptr(len(data)))
+}
+
+// Fletcher32_32 is the 32-lane variant of Fletcher32_16.
+func Fletcher32_32(data []byte) uint32 {
+	if len(data) == 0 {
+		return 0
+	}
+	return fletcher32_u16_32_raw(&data[0], uintptr(len(data)))
+}
+
+// Fletcher32_64 is the 64-lane entry point of Fletcher32_16.  It runs the
+// 32-lane loop, as 64 lanes of state would not fit the kernel's stack budget.
+func Fletcher32_64(data []byte) uint32 {
+	if len(data) == 0 {
+		return 0
+	}
+	return fletcher32_u16_64_raw(&data[0], uintptr(len(data)))
+}
+
+// CountByte16 returns the number of bytes in data equal to needle using the
+// 16-lane kernel.
+func CountByte16(data []byte, needle byte) int {
+	if len(data) == 0 {
+		return 0
+	}
+	return int(count_u8_16_raw(&data[0], uintptr(len(data)), needle))
+}
+
+// CountByte32 is the 32-lane variant of CountByte16.
+func CountByte32(data []byte, needle byte) int {
+	if len(data) == 0 {
+		return 0
+	}
+	return int(count_u8_32_raw(&data[0], uintptr(len(data)), needle))
+}
+
+// CountByte64 is the 64-lane variant of CountByte16.
+func CountByte64(data []byte, needle byte) int {
+	if len(data) == 0 {
+		return 0
+	}
+	return int(count_u8_64_raw(&data[0], uintptr(len(data)), needle))
+}
+
+// Crc32IEEEUpdate updates a CRC-32 (IEEE polynomial) checksum with data.
+func Crc32IEEEUpdate(data []byte, init uint32) uint32 {
+	if len(data) == 0 {
+		return init
+	}
+	return crc32_ieee_update_raw(&data[0], uintptr(len(data)), init)
+}
+
+// SumU16_16 returns the sum of data, widened to uint64, using the 16-lane
+// kernel.
+func SumU16_16(data []uint16) uint64 {
+	if len(data) == 0 {
+		return 0
+	}
+	return sum_u16_16_raw(&data[0], uintptr(len(data)))
+}
+
+// SumU16_32 is the 32-lane variant of SumU16_16.
+func SumU16_32(data []uint16) uint64 {
+	if len(data) == 0 {
+		return 0
+	}
+	return sum_u16_32_raw(&data[0], uintptr(len(data)))
+}
+
+// SumU16_64 is the 64-lane variant of SumU16_16.
+func SumU16_64(data []uint16) uint64 {
+	if len(data) == 0 {
+		return 0
+	}
+	return sum_u16_64_raw(&data[0], uintptr(len(data)))
+}
+
+// SumU32_16 returns the sum of data, widened to uint64, using the 16-lane
+// kernel.
+func SumU32_16(data []uint32) uint64 {
+	if len(data) == 0 {
+		return 0
+	}
+	return sum_u32_16_raw(&data[0], uintptr(len(data)))
+}
+
+// SumU32_32 is the 32-lane variant of SumU32_16.
+func SumU32_32(data []uint32) uint64 {
+	if len(data) == 0 {
+		return 0
+	}
+	return sum_u32_32_raw(&data[0], uintptr(len(data)))
+}
+
+// SumU32_64 is the 64-lane variant of SumU32_16.
+func SumU32_64(data []uint32) uint64 {
+	if len(data) == 0 {
+		return 0
+	}
+	return sum_u32_64_raw(&data[0], uintptr(len(data)))
+}
+
+// MinU8_16 returns the smallest byte of data using the 16-lane kernel; an
+// empty slice yields 0xFF.
+func MinU8_16(data []byte) byte {
+	if len(data) == 0 {
+		return 0xFF
+	}
+	return min_u8_16_raw(&data[0], uintptr(len(data)))
+}
+
+// MinU8_32 is the 32-lane variant of MinU8_16.
+func MinU8_32(data []byte) byte {
+	if len(data) == 0 {
+		return 0xFF
+	}
+	return min_u8_32_raw(&data[0], uintptr(len(data)))
+}
+
+// MinU8_64 is the 64-lane variant of MinU8_16.
+func MinU8_64(data []byte) byte {
+	if len(data) == 0 {
+		return 0xFF
+	}
+	return min_u8_64_raw(&data[0], uintptr(len(data)))
+}
+
+// MaxU8_16 returns the largest byte of data using the 16-lane kernel; an
+// empty slice yields 0.
+func MaxU8_16(data []byte) byte {
+	if len(data) == 0 {
+		return 0
+	}
+	return max_u8_16_raw(&data[0], uintptr(len(data)))
+}
+
+// MaxU8_32 is the 32-lane variant of MaxU8_16.
+func MaxU8_32(data []byte) byte {
+	if len(data) == 0 {
+		return 0
+	}
+	return max_u8_32_raw(&data[0], uintptr(len(data)))
+}
+
+// MaxU8_64 is the 64-lane variant of MaxU8_16.
+func MaxU8_64(data []byte) byte {
+	if len(data) == 0 {
+		return 0
+	}
+	return max_u8_64_raw(&data[0], uintptr(len(data)))
+}
+
+// XorBytes16 writes a[i] ^ b[i] to dst[i] for every i < len(a) using the
+// 16-lane kernel.  b and dst must be at least as long as a; dst may alias a
+// or b.
+func XorBytes16(dst, a, b []byte) {
+	if len(a) == 0 {
+		return
+	}
+	if len(b) < len(a) || len(dst) < len(a) {
+		panic("ffi: XorBytes slices too short")
+	}
+	xor_u8_16_raw(&a[0], &b[0], uintptr(len(a)), &dst[0])
+}
+
+// XorBytes32 is the 32-lane variant of XorBytes16.
+func XorBytes32(dst, a, b []byte) {
+	if len(a) == 0 {
+		return
+	}
+	if len(b) < len(a) || len(dst) < len(a) {
+		panic("ffi: XorBytes slices too short")
+	}
+	xor_u8_32_raw(&a[0], &b[0], uintptr(len(a)), &dst[0])
+}
+
+// XorBytes64 is the 64-lane variant of XorBytes16.
+func XorBytes64(dst, a, b []byte) {
+	if len(a) == 0 {
+		return
+	}
+	if len(b) < len(a) || len(dst) < len(a) {
+		panic("ffi: XorBytes slices too short")
+	}
+	xor_u8_64_raw(&a[0], &b[0], uintptr(len(a)), &dst[0])
+}
+
+// AndBytes16 writes a[i] & b[i] to dst[i] for every i < len(a) using the
+// 16-lane kernel.  b and dst must be at least as long as a; dst may alias a
+// or b.
+func AndBytes16(dst, a, b []byte) {
+	if len(a) == 0 {
+		return
+	}
+	if len(b) < len(a) || len(dst) < len(a) {
+		panic("ffi: AndBytes slices too short")
+	}
+	and_u8_16_raw(&a[0], &b[0], uintptr(len(a)), &dst[0])
+}
+
+// AndBytes32 is the 32-lane variant of AndBytes16.
+func AndBytes32(dst, a, b []byte) {
+	if len(a) == 0 {
+		return
+	}
+	if len(b) < len(a) || len(dst) < len(a) {
+		panic("ffi: AndBytes slices too short")
+	}
+	and_u8_32_raw(&a[0], &b[0], uintptr(len(a)), &dst[0])
+}
+
+// AndBytes64 is the 64-lane variant of AndBytes16.
+func AndBytes64(dst, a, b []byte) {
+	if len(a) == 0 {
+		return
+	}
+	if len(b) < len(a) || len(dst) < len(a) {
+		panic("ffi: AndBytes slices too short")
+	}
+	and_u8_64_raw(&a[0], &b[0], uintptr(len(a)), &dst[0])
+}
+
+// OrBytes16 writes a[i] | b[i] to dst[i] for every i < len(a) using the
+// 16-lane kernel.  b and dst must be at least as long as a; dst may alias a
+// or b.
+func OrBytes16(dst, a, b []byte) {
+	if len(a) == 0 {
+		return
+	}
+	if len(b) < len(a) || len(dst) < len(a) {
+		panic("ffi: OrBytes slices too short")
+	}
+	or_u8_16_raw(&a[0], &b[0], uintptr(len(a)), &dst[0])
+}
+
+// OrBytes32 is the 32-lane variant of OrBytes16.
+func OrBytes32(dst, a, b []byte) {
+	if len(a) == 0 {
+		return
+	}
+	if len(b) < len(a) || len(dst) < len(a) {
+		panic("ffi: OrBytes slices too short")
+	}
+	or_u8_32_raw(&a[0], &b[0], uintptr(len(a)), &dst[0])
+}
+
+// OrBytes64 is the 64-lane variant of OrBytes16.
+func OrBytes64(dst, a, b []byte) {
+	if len(a) == 0 {
+		return
+	}
+	if len(b) < len(a) || len(dst) < len(a) {
+		panic("ffi: OrBytes slices too short")
+	}
+	or_u8_64_raw(&a[0], &b[0], uintptr(len(a)), &dst[0])
+}
+
+// IndexDiff16 returns the first i with a[i] != b[i], or -1 if the slices are
+// equal, using the 16-lane kernel.  a and b must have equal length.
+func IndexDiff16(a, b []byte) int {
+	if len(a) != len(b) {
+		panic("ffi: IndexDiff slices differ in length")
+	}
+	if len(a) == 0 {
+		return -1
+	}
+	return indexOrNone(index_diff_u8_16_raw(&a[0], &b[0], uintptr(len(a))), len(a))
+}
+
+// IndexDiff32 is the 32-lane variant of IndexDiff16.
+func IndexDiff32(a, b []byte) int {
+	if len(a) != len(b) {
+		panic("ffi: IndexDiff slices differ in length")
+	}
+	if len(a) == 0 {
+		return -1
+	}
+	return indexOrNone(index_diff_u8_32_raw(&a[0], &b[0], uintptr(len(a))), len(a))
+}
+
+// IndexDiff64 is the 64-lane variant of IndexDiff16.
+func IndexDiff64(a, b []byte) int {
+	if len(a) != len(b) {
+		panic("ffi: IndexDiff slices differ in length")
+	}
+	if len(a) == 0 {
+		return -1
+	}
+	return indexOrNone(index_diff_u8_64_raw(&a[0], &b[0], uintptr(len(a))), len(a))
+}
+
+// IndexDiffFold16 returns the first i at which a[i] and b[i] differ once
+// ASCII letters are folded to lower case, or -1 if the slices are equal up
+// to ASCII case, using the 16-lane kernel.  Other bytes, including those
+// >= 0x80, must match exactly.  a and b must have equal length.
+func IndexDiffFold16(a, b []byte) int {
+	if len(a) != len(b) {
+		panic("ffi: IndexDiffFold slices differ in length")
+	}
+	if len(a) == 0 {
+		return -1
+	}
+	return indexOrNone(index_diff_fold_u8_16_raw(&a[0], &b[0], uintptr(len(a))), len(a))
+}
+
+// IndexDiffFold32 is the 32-lane variant of IndexDiffFold16.
+func IndexDiffFold32(a, b []byte) int {
+	if len(a) != len(b) {
+		panic("ffi: IndexDiffFold slices differ in length")
+	}
+	if len(a) == 0 {
+		return -1
+	}
+	return indexOrNone(index_diff_fold_u8_32_raw(&a[0], &b[0], uintptr(len(a))), len(a))
+}
+
+// IndexDiffFold64 is the 64-lane variant of IndexDiffFold16.
+func IndexDiffFold64(a, b []byte) int {
+	if len(a) != len(b) {
+		panic("ffi: IndexDiffFold slices differ in length")
+	}
+	if len(a) == 0 {
+		return -1
+	}
+	return indexOrNone(index_diff_fold_u8_64_raw(&a[0], &b[0], uintptr(len(a))), len(a))
+}
+
+// HexEncode writes the lower-case hex encoding of src into dst and returns
+// the number of bytes written.  dst must hold at least 2*len(src) bytes.
+func HexEncode(dst, src []byte) int {
+	if len(src) == 0 {
+		return 0
+	}
+	if len(dst) < 2*len(src) {
+		panic("ffi: HexEncode destination too short")
+	}
+	return int(hex_encode_raw(&src[0], uintptr(len(src)), &dst[0]))
+}
+
+// HexDecode decodes the complete digit pairs of src into dst, stopping before
+// the first pair that holds a non-hex byte.  It returns the number of src
+// bytes consumed; half as many bytes were written.  dst must hold at least
+// len(src)/2 bytes.
+func HexDecode(dst, src []byte) int {
+	if len(src) < 2 {
+		return 0
+	}
+	if len(dst) < len(src)/2 {
+		panic("ffi: HexDecode destination too short")
+	}
+	return int(hex_decode_raw(&src[0], uintptr(len(src)), &dst[0]))
+}
+
+// Base64EncodeBlocks base64-encodes the complete 3-byte groups of src into
+// dst and returns the number of bytes written (len(src)/3*4).  url selects
+// the URL-safe alphabet instead of the standard one.
+func Base64EncodeBlocks(dst, src []byte, url bool) int {
+	if len(src) < 3 {
+		return 0
+	}
+	if len(dst) < len(src)/3*4 {
+		panic("ffi: Base64EncodeBlocks dst slice too short")
+	}
+	return int(base64_encode_raw(&src[0], uintptr(len(src)), &dst[0], boolToU8(url)))
+}
+
+// Base64DecodeBlocks decodes the complete 4-char groups of src into dst,
+// stopping before the first group with a byte outside the alphabet.  Returns
+// the number of src bytes consumed (a multiple of 4).
+func Base64DecodeBlocks(dst, src []byte, url bool) int {
+	if len(src) < 4 {
+		return 0
+	}
+	if len(dst) < len(src)/4*3 {
+		panic("ffi: Base64DecodeBlocks dst slice too short")
+	}
+	return int(base64_decode_raw(&src[0], uintptr(len(src)), &dst[0], boolToU8(url)))
+}
+
+// ReplaceByte16 rewrites every byte of data equal to old as new, in place,
+// and returns the number of bytes replaced, using the 16-lane kernel.
+func ReplaceByte16(data []byte, old, new byte) int {
+	if len(data) == 0 {
+		return 0
+	}
+	return int(replace_u8_16_raw(&data[0], uintptr(len(data)), old, new))
+}
+
+// ReplaceByte32 is the 32-lane variant of ReplaceByte16.
+func ReplaceByte32(data []byte, old, new byte) int {
+	if len(data) == 0 {
+		return 0
+	}
+	return int(replace_u8_32_raw(&data[0], uintptr(len(data)), old, new))
+}
+
+// ReplaceByte64 is the 64-lane variant of ReplaceByte16.
+func ReplaceByte64(data []byte, old, new byte) int {
+	if len(data) == 0 {
+		return 0
+	}
+	return int(replace_u8_64_raw(&data[0], uintptr(len(data)), old, new))
+}
+
+// HistogramU8_16 adds the frequency of every byte value in data to hist
+// using the 16-lane kernel.
+func HistogramU8_16(data []byte, hist *[256]uint64) {
+	if len(data) == 0 {
+		return
+	}
+	histogram_u8_16_raw(&data[0], uintptr(len(data)), &hist[0])
+}
+
+// HistogramU8_32 is the 32-lane variant of HistogramU8_16.
+func HistogramU8_32(data []byte, hist *[256]uint64) {
+	if len(data) == 0 {
+		return
+	}
+	histogram_u8_32_raw(&data[0], uintptr(len(data)), &hist[0])
+}
+
+// HistogramU8_64 is the 64-lane variant of HistogramU8_16.
+func HistogramU8_64(data []byte, hist *[256]uint64) {
+	if len(data) == 0 {
+		return
+	}
+	histogram_u8_64_raw(&data[0], uintptr(len(data)), &hist[0])
+}
+
+// ContainsAnyByte16 reports whether any byte of data has a non-zero LUT
+// entry using the 16-lane kernel, stopping at the first hit.
+func ContainsAnyByte16(data []byte, lut *[256]byte) bool {
+	if len(data) == 0 {
+		return false
+	}
+	return any_u8_lut16_raw(&data[0], uintptr(len(data)), &lut[0]) != 0
+}
+
+// ContainsAnyByte32 is the 32-lane variant of ContainsAnyByte16.
+func ContainsAnyByte32(data []byte, lut *[256]byte) bool {
+	if len(data) == 0 {
+		return false
+	}
+	return any_u8_lut32_raw(&data[0], uintptr(len(data)), &lut[0]) != 0
+}
+
+// ContainsAnyByte64 is the 64-lane variant of ContainsAnyByte16.
+func ContainsAnyByte64(data []byte, lut *[256]byte) bool {
+	if len(data) == 0 {
+		return false
+	}
+	return any_u8_lut64_raw(&data[0], uintptr(len(data)), &lut[0]) != 0
+}
+
+// IndexAnyByte16 returns the offset of the first byte whose LUT entry is
+// non-zero, scanning 16-byte chunks, or -1 if there is none.
+func IndexAnyByte16(data []byte, lut *[256]byte) int {
+	if len(data) == 0 {
+		return -1
+	}
+	return indexOrNone(index_lut16_raw(&data[0], uintptr(len(data)), &lut[0]), len(data))
+}
+
+// IndexAnyByte32 is the 32-lane variant of IndexAnyByte16.
+func IndexAnyByte32(data []byte, lut *[256]byte) int {
+	if len(data) == 0 {
+		return -1
+	}
+	return indexOrNone(index_lut32_raw(&data[0], uintptr(len(data)), &lut[0]), len(data))
+}
+
+// IndexAnyByte64 is the 64-lane variant of IndexAnyByte16.
+func IndexAnyByte64(data []byte, lut *[256]byte) int {
+	if len(data) == 0 {
+		return -1
+	}
+	return indexOrNone(index_lut64_raw(&data[0], uintptr(len(data)), &lut[0]), len(data))
+}
+
+// Header sizes, in words, of the Go slice and string headers the batch
+// kernels read in place: data pointer, length and (for slices) capacity.
+const (
+	sliceHeaderWords  = unsafe.Sizeof([]byte(nil)) / unsafe.Sizeof(uintptr(0))
+	stringHeaderWords = unsafe.Sizeof("") / unsafe.Sizeof(uintptr(0))
+)
+
+// IsASCIIBatch sets out[i] to whether slices[i] is pure ASCII, crossing into
+// Rust once for the whole batch.  The kernel reads the data pointer and
+// length straight from the slice headers in slices, so no descriptor array
+// is built; slices itself keeps every buffer reachable until the call
+// returns.  out must be at least len(slices) long.
+func IsASCIIBatch(slices [][]byte, out []bool) {
+	if len(slices) == 0 {
+		return
+	}
+	if len(out) < len(slices) {
+		panic("ffi: IsASCIIBatch out slice too short")
+	}
+	is_ascii_batch_raw((*uintptr)(unsafe.Pointer(&slices[0])), uintptr(len(slices)), sliceHeaderWords,
+		(*uint8)(unsafe.Pointer(&out[0])))
+	runtime.KeepAlive(slices)
+}
+
+// ValidateTagBatch sets out[i] to whether tags[i] is a valid Datadog tag
+// with a length in [minLen, maxLen]: a-z or ':' first, then only a-z, 0-9,
+// ':', '.', '/', '-' and '_', with no "__" and no trailing '_'.  A maxLen of
+// 0 or less means no upper bound.  Like IsASCIIBatch it makes one call for
+// the batch and reads the string headers in place.  out must be at least
+// len(tags) long.
+func ValidateTagBatch(tags []string, minLen, maxLen int, out []bool) {
+	if len(tags) == 0 {
+		return
+	}
+	if len(out) < len(tags) {
+		panic("ffi: ValidateTagBatch out slice too short")
+	}
+	limit := ^uintptr(0)
+	if maxLen > 0 {
+		limit = uintptr(maxLen)
+	}
+	validate_tag_batch_raw((*uintptr)(unsafe.Pointer(&tags[0])), uintptr(len(tags)), stringHeaderWords,
+		uintptr(max(minLen, 0)), limit, (*uint8)(unsafe.Pointer(&out[0])))
+	runtime.KeepAlive(tags)
+}
+
+// AddU8Saturating16 writes min(a[i]+b[i], 255) to dst[i] for every i < len(a)
+// using the 16-lane kernel.  b and dst must be at least as long as a; dst
+// may alias a or b.
+func AddU8Saturating16(dst, a, b []byte) {
+	if len(a) == 0 {
+		return
+	}
+	if len(b) < len(a) || len(dst) < len(a) {
+		panic("ffi: AddU8Saturating slices too short")
+	}
+	add_u8_sat_16_raw(&a[0], &b[0], uintptr(len(a)), &dst[0])
+}
+
+// AddU8Saturating32 is the 32-lane variant of AddU8Saturating16.
+func AddU8Saturating32(dst, a, b []byte) {
+	if len(a) == 0 {
+		return
+	}
+	if len(b) < len(a) || len(dst) < len(a) {
+		panic("ffi: AddU8Saturating slices too short")
+	}
+	add_u8_sat_32_raw(&a[0], &b[0], uintptr(len(a)), &dst[0])
+}
+
+// AddU8Saturating64 is the 64-lane variant of AddU8Saturating16.
+func AddU8Saturating64(dst, a, b []byte) {
+	if len(a) == 0 {
+		return
+	}
+	if len(b) < len(a) || len(dst) < len(a) {
+		panic("ffi: AddU8Saturating slices too short")
+	}
+	add_u8_sat_64_raw(&a[0], &b[0], uintptr(len(a)), &dst[0])
+}
+
+// AddU8Wrapping16 writes a[i] + b[i] (mod 256) to dst[i] for every i < len(a)
+// using the 16-lane kernel.  b and dst must be at least as long as a; dst
+// may alias a or b.
+func AddU8Wrapping16(dst, a, b []byte) {
+	if len(a) == 0 {
+		return
+	}
+	if len(b) < len(a) || len(dst) < len(a) {
+		panic("ffi: AddU8Wrapping slices too short")
+	}
+	add_u8_wrap_16_raw(&a[0], &b[0], uintptr(len(a)), &dst[0])
+}
+
+// AddU8Wrapping32 is the 32-lane variant of AddU8Wrapping16.
+func AddU8Wrapping32(dst, a, b []byte) {
+	if len(a) == 0 {
+		return
+	}
+	if len(b) < len(a) || len(dst) < len(a) {
+		panic("ffi: AddU8Wrapping slices too short")
+	}
+	add_u8_wrap_32_raw(&a[0], &b[0], uintptr(len(a)), &dst[0])
+}
+
+// AddU8Wrapping64 is the 64-lane variant of AddU8Wrapping16.
+func AddU8Wrapping64(dst, a, b []byte) {
+	if len(a) == 0 {
+		return
+	}
+	if len(b) < len(a) || len(dst) < len(a) {
+		panic("ffi: AddU8Wrapping slices too short")
+	}
+	add_u8_wrap_64_raw(&a[0], &b[0], uintptr(len(a)), &dst[0])
+}
+
+// DotU8_16 returns the sum of a[i]*b[i] widened to uint64, which cannot
+// overflow for any addressable input, using the 16-lane kernel.  a and b must
+// have equal length.
+func DotU8_16(a, b []byte) uint64 {
+	if len(a) != len(b) {
+		panic("ffi: DotU8 slices differ in length")
+	}
+	if len(a) == 0 {
+		return 0
+	}
+	return dot_u8_16_raw(&a[0], &b[0], uintptr(len(a)))
+}
+
+// DotU8_32 is the 32-lane variant of DotU8_16.
+func DotU8_32(a, b []byte) uint64 {
+	if len(a) != len(b) {
+		panic("ffi: DotU8 slices differ in length")
+	}
+	if len(a) == 0 {
+		return 0
+	}
+	return dot_u8_32_raw(&a[0], &b[0], uintptr(len(a)))
+}
+
+// DotU8_64 is the 64-lane variant of DotU8_16.
+func DotU8_64(a, b []byte) uint64 {
+	if len(a) != len(b) {
+		panic("ffi: DotU8 slices differ in length")
+	}
+	if len(a) == 0 {
+		return 0
+	}
+	return dot_u8_64_raw(&a[0], &b[0], uintptr(len(a)))
+}
+
+// DotF32_16 returns the sum of a[i]*b[i], with products formed and summed
+// in float64, using the 16-lane kernel.  a and b must have equal length.
+func DotF32_16(a, b []float32) float64 {
+	if len(a) != len(b) {
+		panic("ffi: DotF32 slices differ in length")
+	}
+	if len(a) == 0 {
+		return 0
+	}
+	return dot_f32_16_raw(&a[0], &b[0], uintptr(len(a)))
+}
+
+// DotF32_32 is the 32-lane variant of DotF32_16.
+func DotF32_32(a, b []float32) float64 {
+	if len(a) != len(b) {
+		panic("ffi: DotF32 slices differ in length")
+	}
+	if len(a) == 0 {
+		return 0
+	}
+	return dot_f32_32_raw(&a[0], &b[0], uintptr(len(a)))
+}
+
+// DotF32_64 is the 64-lane variant of DotF32_16.
+func DotF32_64(a, b []float32) float64 {
+	if len(a) != len(b) {
+		panic("ffi: DotF32 slices differ in length")
+	}
+	if len(a) == 0 {
+		return 0
+	}
+	return dot_f32_64_raw(&a[0], &b[0], uintptr(len(a)))
+}
+
+// AllBytesInRange16 reports whether every byte of data lies in [lo, hi]
+// using the 16-lane kernel.  An empty range (lo > hi) admits no bytes.
+func AllBytesInRange16(data []byte, lo, hi byte) bool {
+	if len(data) == 0 {
+		return true
+	}
+	return all_in_range_u8_16_raw(&data[0], uintptr(len(data)), lo, hi) != 0
+}
+
+// AllBytesInRange32 is the 32-lane variant of AllBytesInRange16.
+func AllBytesInRange32(data []byte, lo, hi byte) bool {
+	if len(data) == 0 {
+		return true
+	}
+	return all_in_range_u8_32_raw(&data[0], uintptr(len(data)), lo, hi) != 0
+}
+
+// AllBytesInRange64 is the 64-lane variant of AllBytesInRange16.
+func AllBytesInRange64(data []byte, lo, hi byte) bool {
+	if len(data) == 0 {
+		return true
+	}
+	return all_in_range_u8_64_raw(&data[0], uintptr(len(data)), lo, hi) != 0
+}
+
+//go:noinline
+func Noop() {
+	noop_raw()
+}
+
+// Crc32Update32 updates CRC32 using the 32-lane SIMD kernel.
+func Crc32Update32(data []byte, init uint32) uint32 {
+	if len(data) == 0 {
+		return init
+	}
+	return crc32_update_32_raw(&data[0], uintptr(len(data)), init)
+}
+
+// Crc32Update64 updates CRC32 using the 64-lane SIMD kernel.
+func Crc32Update64(data []byte, init uint32) uint32 {
+	if len(data) == 0 {
+		return init
+	}
+	return crc32_update_64_raw(&data[0], uintptr(len(data)), init)
+}
+
+// Crc32Combine returns CRC32 of concatenation of two buffers given their CRCs
+// and the length of the second buffer.
+func Crc32Combine(crc1, crc2 uint32, len2 int) uint32 {
+	return crc32_combine_raw(crc1, crc2, uintptr(len2))
+}
+
+// Echo mirrors the rust Echo struct; used only in trampoline tests.  F64
+// relies on natural alignment rather than explicit padding, so it lands at
+// offset 40 on 64-bit targets and at 36 on 386, where f64 is 4-aligned.
+type Echo struct {
+	Ptr     uintptr
+	Len     uintptr
+	V32     uint32
+	V8      uint8
+	_pad1   [3]byte // align
+	V64     uint64
+	F64Bits uint64
+	F32Bits uint32
+	F64     float64
+}
+
+// TrampolineSanityHash returns a 64-bit mix of the four arguments. Used by
+// tests to verify that trampolines marshal arguments verbatim.
+func TrampolineSanityHash(ptr *byte, length uintptr, v32 uint32, v8 uint8, v64 uint64, f64bits uint64, f32bits uint32) uintptr {
+	return trampoline_sanity_raw(ptr, length, v32, v8, v64, f64bits, f32bits)
+}
+
+// TrampolineEcho calls the echo helper for debugging.
+func TrampolineEcho(ptr *byte, length uintptr, v32 uint32, v8 uint8, v64 uint64, f64bits uint64, f32bits uint32) Echo {
+	var e Echo
+	trampoline_echo_raw(ptr, length, v32, v8, v64, f64bits, f32bits, &e)
+	return e
+}
+
+// TrampolineEchoF64 returns the float64 with the given bit pattern, passed
+// through the Rust helper and back via the floating-point return register.
+func TrampolineEchoF64(bits uint64) float64 {
+	return trampoline_echo_f64_raw(bits)
 }
@@ -1,6 +1,8 @@
 package ffi
 
 import (
+	"bytes"
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -29,6 +31,13 @@ func TestSumU8(t *testing.T) {
 		require.Equal(t, uint32(0), c.fn(nil), "SumU8_%s(nil)", c.name)
 		require.Equal(t, uint32(6), c.fn([]byte{1, 2, 3}), "SumU8_%s([1 2 3])", c.name)
 	}
+
+	// The sum wraps modulo 2^32.
+	const n = 16_843_010 // ceil(2^32 / 255)
+	data := bytes.Repeat([]byte{0xFF}, n)
+	for _, c := range cases {
+		require.Equal(t, uint32(255*n&0xFFFFFFFF), c.fn(data), "SumU8_%s wrap", c.name)
+	}
 }
 
 func TestIsASCII(t *testing.T) {
@@ -127,3 +136,63 @@ func TestEqU8Masks(t *testing.T) {
 	require.Equal(t, 64, EqU8Masks64(src64, '_', out64))
 	require.Equal(t, want64, out64[0])
 }
+
+// TestKernelWidthsAgree runs every lane width of each kernel over the same
+// random buffers and requires identical answers.  Lengths deliberately
+// include non-multiples of 64 so the per-width tail handling is compared.
+func TestKernelWidthsAgree(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	lengths := []int{0, 1, 15, 16, 17, 31, 32, 33, 63, 64, 65, 100, 127, 128, 129, 1000, 4097, 65536 + 13}
+
+	for _, n := range lengths {
+		random := make([]byte, n)
+		rng.Read(random)
+		ascii := make([]byte, n)
+		for i := range ascii {
+			ascii[i] = byte(rng.Intn(128))
+		}
+		inputs := map[string][]byte{"random": random, "ascii": ascii}
+		if n > 0 {
+			// ASCII except for one high byte in the tail.
+			tail := append([]byte(nil), ascii...)
+			tail[n-1] = 0x80
+			inputs["ascii-tail"] = tail
+		}
+
+		for name, data := range inputs {
+			s16 := SumU8_16(data)
+			require.Equal(t, s16, SumU8_32(data), "SumU8 16/32 n=%d %s", n, name)
+			require.Equal(t, s16, SumU8_64(data), "SumU8 16/64 n=%d %s", n, name)
+
+			a16 := IsASCII16(data)
+			require.Equal(t, a16, IsASCII32(data), "IsASCII 16/32 n=%d %s", n, name)
+			require.Equal(t, a16, IsASCII64(data), "IsASCII 16/64 n=%d %s", n, name)
+
+			l16 := AllBytesInSet16(data, asciiLUT)
+			require.Equal(t, l16, AllBytesInSet32(data, asciiLUT), "AllBytesInSet 16/32 n=%d %s", n, name)
+			require.Equal(t, l16, AllBytesInSet64(data, asciiLUT), "AllBytesInSet 16/64 n=%d %s", n, name)
+
+			require.Equal(t, Crc32Update32(data, 0x1234), Crc32Update64(data, 0x1234), "Crc32Update 32/64 n=%d %s", n, name)
+		}
+	}
+}
+
+var widthSink uint32
+
+// BenchmarkKernelWidths compares the lane widths of SumU8 on a large buffer,
+// the regime where the widest kernel is expected to win.
+func BenchmarkKernelWidths(b *testing.B) {
+	data := make([]byte, 64<<10)
+	rand.New(rand.NewSource(1)).Read(data)
+	for _, c := range []struct {
+		name string
+		fn   func([]byte) uint32
+	}{{"16", SumU8_16}, {"32", SumU8_32}, {"64", SumU8_64}} {
+		b.Run("SumU8_"+c.name, func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				widthSink = c.fn(data)
+			}
+		})
+	}
+}
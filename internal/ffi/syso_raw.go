@@ -0,0 +1,615 @@
+//go:build !simba_noffi && (simba_reftramp || !simba_trace)
+
+package ffi
+
+// --- raw syscall signatures implemented in assembly ---
+//
+// Each Go prototype below is paired with a **trampoline** implemented in
+// architecture-specific assembly (`syso_*.s`).  To keep the two in sync we
+// tag every prototype with:
+//
+//   //simba:trampoline <arches>
+//
+// The `gen_trampolines` generator (invoked via `go:generate` in
+// syso_backend.go) scans the package, finds these tags, and auto-writes the
+// minimal `MOV / CALL / RET` stubs for the listed architectures.  Adding a new
+// FFI symbol now requires only the Go prototype plus this comment—no
+// hand-edited assembly.
+//
+// This file is excluded from `-tags simba_trace` builds; there the generated
+// trace_gen.go supplies Go bodies for the same names that log each call
+// before entering the trampoline (see trace.go).  Under simba_reftramp the
+// same prototypes are bound to syso_reftramp_<arch>.s, whose trampolines
+// call the Go reference in noffi.go instead of Rust.
+//
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func sum_u8_32_raw(ptr *byte, n uintptr) uint32
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func sum_u8_64_raw(ptr *byte, n uintptr) uint32
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func sum_u8_16_raw(ptr *byte, n uintptr) uint32
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func is_ascii32_raw(ptr *byte, n uintptr) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func is_ascii64_raw(ptr *byte, n uintptr) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func is_ascii16_raw(ptr *byte, n uintptr) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func validate_u8_lut32_raw(ptr *byte, n uintptr, lut *byte) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func validate_u8_lut64_raw(ptr *byte, n uintptr, lut *byte) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func validate_u8_lut16_raw(ptr *byte, n uintptr, lut *byte) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func map_u8_lut32_raw(src *byte, n uintptr, dst *byte, lut *byte)
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func map_u8_lut64_raw(src *byte, n uintptr, dst *byte, lut *byte)
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func map_u8_lut16_raw(src *byte, n uintptr, dst *byte, lut *byte)
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func eq_u8_masks32_raw(src *byte, n uintptr, needle uint8, out *uint32) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func eq_u8_masks64_raw(src *byte, n uintptr, needle uint8, out *uint64) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func eq_any_masks64_raw(src *byte, n uintptr, needles *[8]byte, out *uint64) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func eq_u8_masks16_raw(src *byte, n uintptr, needle uint8, out *uint16) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func lut_masks16_raw(src *byte, n uintptr, table *byte, out *uint16) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func lut_masks32_raw(src *byte, n uintptr, table *byte, out *uint32) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func lut_masks64_raw(src *byte, n uintptr, table *byte, out *uint64) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func noop_raw()
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func crc32_update_32_raw(ptr *byte, n uintptr, init uint32) uint32
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func crc32_update_64_raw(ptr *byte, n uintptr, init uint32) uint32
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func crc32_combine_raw(crc1 uint32, crc2 uint32, len2 uintptr) uint32
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func index_lt_u8_16_raw(ptr *byte, n uintptr, threshold uint8) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func index_lt_u8_32_raw(ptr *byte, n uintptr, threshold uint8) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func index_lt_u8_64_raw(ptr *byte, n uintptr, threshold uint8) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func last_index_lut16_raw(ptr *byte, n uintptr, lut *byte) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func last_index_lut32_raw(ptr *byte, n uintptr, lut *byte) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func last_index_lut64_raw(ptr *byte, n uintptr, lut *byte) uintptr
+
+// The f32 reductions return the IEEE bit pattern as uint32; the trampolines
+// only move integer return registers.
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func min_f32_16_raw(ptr *float32, n uintptr) uint32
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func min_f32_32_raw(ptr *float32, n uintptr) uint32
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func min_f32_64_raw(ptr *float32, n uintptr) uint32
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func max_f32_16_raw(ptr *float32, n uintptr) uint32
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func max_f32_32_raw(ptr *float32, n uintptr) uint32
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func max_f32_64_raw(ptr *float32, n uintptr) uint32
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func count_outside_u8_16_raw(ptr *byte, n uintptr, lo uint8, hi uint8) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func count_outside_u8_32_raw(ptr *byte, n uintptr, lo uint8, hi uint8) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func count_outside_u8_64_raw(ptr *byte, n uintptr, lo uint8, hi uint8) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func filter_u8_lut32_raw(src *byte, n uintptr, dst *byte, lut *byte) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func filter_u8_lut64_raw(src *byte, n uintptr, dst *byte, lut *byte) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func filter_u8_lut16_raw(src *byte, n uintptr, dst *byte, lut *byte) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func validate_map_u8_lut32_raw(src *byte, n uintptr, dst *byte, allowed *byte, lut *byte) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func validate_map_u8_lut64_raw(src *byte, n uintptr, dst *byte, allowed *byte, lut *byte) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func validate_map_u8_lut16_raw(src *byte, n uintptr, dst *byte, allowed *byte, lut *byte) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func index_ne_u8_16_raw(ptr *byte, n uintptr, val uint8) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func index_ne_u8_32_raw(ptr *byte, n uintptr, val uint8) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func index_ne_u8_64_raw(ptr *byte, n uintptr, val uint8) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func index_pair_u8_16_raw(ptr *byte, n uintptr, b0, b1 uint8) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func index_pair_u8_32_raw(ptr *byte, n uintptr, b0, b1 uint8) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func index_pair_u8_64_raw(ptr *byte, n uintptr, b0, b1 uint8) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func xor_reduce_u8_16_raw(ptr *byte, n uintptr) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func xor_reduce_u8_32_raw(ptr *byte, n uintptr) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func xor_reduce_u8_64_raw(ptr *byte, n uintptr) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func count_class_transitions_u8_16_raw(ptr *byte, n uintptr, table *byte) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func count_class_transitions_u8_32_raw(ptr *byte, n uintptr, table *byte) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func count_class_transitions_u8_64_raw(ptr *byte, n uintptr, table *byte) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func is_sorted_u8_16_raw(ptr *byte, n uintptr) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func is_sorted_u8_32_raw(ptr *byte, n uintptr) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func is_sorted_u8_64_raw(ptr *byte, n uintptr) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func crc32_sum_u8_raw(ptr *byte, n uintptr, init uint32, sum *uint64) uint32
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func base32_encode_raw(src *byte, n uintptr, dst *byte) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func base32_decode_raw(src *byte, n uintptr, dst *byte) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func luhn_u8_16_raw(ptr *byte, n uintptr) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func luhn_u8_32_raw(ptr *byte, n uintptr) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func luhn_u8_64_raw(ptr *byte, n uintptr) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func popcount_and_u8_16_raw(a, b *byte, n uintptr) uint64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func popcount_and_u8_32_raw(a, b *byte, n uintptr) uint64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func popcount_and_u8_64_raw(a, b *byte, n uintptr) uint64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func popcount_xor_u8_16_raw(a, b *byte, n uintptr) uint64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func popcount_xor_u8_32_raw(a, b *byte, n uintptr) uint64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func popcount_xor_u8_64_raw(a, b *byte, n uintptr) uint64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func popcount_u8_16_raw(ptr *byte, n uintptr) uint64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func popcount_u8_32_raw(ptr *byte, n uintptr) uint64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func popcount_u8_64_raw(ptr *byte, n uintptr) uint64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func fletcher16_u8_16_raw(ptr *byte, n uintptr) uint32
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func fletcher16_u8_32_raw(ptr *byte, n uintptr) uint32
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func fletcher16_u8_64_raw(ptr *byte, n uintptr) uint32
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func fletcher32_u16_16_raw(ptr *byte, n uintptr) uint32
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func fletcher32_u16_32_raw(ptr *byte, n uintptr) uint32
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func fletcher32_u16_64_raw(ptr *byte, n uintptr) uint32
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func count_u8_16_raw(ptr *byte, n uintptr, needle uint8) uint64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func count_u8_32_raw(ptr *byte, n uintptr, needle uint8) uint64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func count_u8_64_raw(ptr *byte, n uintptr, needle uint8) uint64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func crc32_ieee_update_raw(ptr *byte, n uintptr, init uint32) uint32
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func sum_u16_16_raw(ptr *uint16, n uintptr) uint64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func sum_u16_32_raw(ptr *uint16, n uintptr) uint64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func sum_u16_64_raw(ptr *uint16, n uintptr) uint64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func sum_u32_16_raw(ptr *uint32, n uintptr) uint64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func sum_u32_32_raw(ptr *uint32, n uintptr) uint64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func sum_u32_64_raw(ptr *uint32, n uintptr) uint64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func min_u8_16_raw(ptr *byte, n uintptr) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func min_u8_32_raw(ptr *byte, n uintptr) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func min_u8_64_raw(ptr *byte, n uintptr) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func max_u8_16_raw(ptr *byte, n uintptr) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func max_u8_32_raw(ptr *byte, n uintptr) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func max_u8_64_raw(ptr *byte, n uintptr) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func xor_u8_16_raw(a, b *byte, n uintptr, dst *byte)
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func xor_u8_32_raw(a, b *byte, n uintptr, dst *byte)
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func xor_u8_64_raw(a, b *byte, n uintptr, dst *byte)
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func and_u8_16_raw(a, b *byte, n uintptr, dst *byte)
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func and_u8_32_raw(a, b *byte, n uintptr, dst *byte)
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func and_u8_64_raw(a, b *byte, n uintptr, dst *byte)
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func or_u8_16_raw(a, b *byte, n uintptr, dst *byte)
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func or_u8_32_raw(a, b *byte, n uintptr, dst *byte)
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func or_u8_64_raw(a, b *byte, n uintptr, dst *byte)
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func index_diff_u8_16_raw(a, b *byte, n uintptr) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func index_diff_u8_32_raw(a, b *byte, n uintptr) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func index_diff_u8_64_raw(a, b *byte, n uintptr) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func index_diff_fold_u8_16_raw(a, b *byte, n uintptr) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func index_diff_fold_u8_32_raw(a, b *byte, n uintptr) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func index_diff_fold_u8_64_raw(a, b *byte, n uintptr) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func hex_encode_raw(src *byte, n uintptr, dst *byte) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func hex_decode_raw(src *byte, n uintptr, dst *byte) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func base64_encode_raw(src *byte, n uintptr, dst *byte, url uint8) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func base64_decode_raw(src *byte, n uintptr, dst *byte, url uint8) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func replace_u8_16_raw(ptr *byte, n uintptr, old, new uint8) uint64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func replace_u8_32_raw(ptr *byte, n uintptr, old, new uint8) uint64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func replace_u8_64_raw(ptr *byte, n uintptr, old, new uint8) uint64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func histogram_u8_16_raw(ptr *byte, n uintptr, hist *uint64)
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func histogram_u8_32_raw(ptr *byte, n uintptr, hist *uint64)
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func histogram_u8_64_raw(ptr *byte, n uintptr, hist *uint64)
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func any_u8_lut16_raw(ptr *byte, n uintptr, lut *byte) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func any_u8_lut32_raw(ptr *byte, n uintptr, lut *byte) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func any_u8_lut64_raw(ptr *byte, n uintptr, lut *byte) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func index_lut16_raw(ptr *byte, n uintptr, lut *byte) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func index_lut32_raw(ptr *byte, n uintptr, lut *byte) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func index_lut64_raw(ptr *byte, n uintptr, lut *byte) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func is_ascii_batch_raw(descs *uintptr, count, stride uintptr, out *uint8)
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func validate_tag_batch_raw(descs *uintptr, count, stride, minLen, maxLen uintptr, out *uint8)
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func add_u8_sat_16_raw(a, b *byte, n uintptr, dst *byte)
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func add_u8_sat_32_raw(a, b *byte, n uintptr, dst *byte)
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func add_u8_sat_64_raw(a, b *byte, n uintptr, dst *byte)
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func add_u8_wrap_16_raw(a, b *byte, n uintptr, dst *byte)
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func add_u8_wrap_32_raw(a, b *byte, n uintptr, dst *byte)
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func add_u8_wrap_64_raw(a, b *byte, n uintptr, dst *byte)
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func dot_u8_16_raw(a, b *byte, n uintptr) uint64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func dot_u8_32_raw(a, b *byte, n uintptr) uint64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func dot_u8_64_raw(a, b *byte, n uintptr) uint64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func dot_f32_16_raw(a, b *float32, n uintptr) float64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func dot_f32_32_raw(a, b *float32, n uintptr) float64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func dot_f32_64_raw(a, b *float32, n uintptr) float64
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func all_in_range_u8_16_raw(ptr *byte, n uintptr, lo, hi uint8) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func all_in_range_u8_32_raw(ptr *byte, n uintptr, lo, hi uint8) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func all_in_range_u8_64_raw(ptr *byte, n uintptr, lo, hi uint8) uint8
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func trampoline_sanity_raw(ptr *byte, n uintptr, val32 uint32, val8 uint8, val64 uint64, f64bits uint64, f32bits uint32) uintptr
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func trampoline_echo_raw(ptr *byte, n uintptr, v32 uint32, v8 uint8, v64 uint64, f64bits uint64, f32bits uint32, out *Echo)
+
+//simba:trampoline amd64 arm64 riscv64 386
+//go:noescape
+func trampoline_echo_f64_raw(bits uint64) float64
@@ -0,0 +1,41 @@
+//go:build simba_trace
+
+package ffi
+
+import (
+	"fmt"
+	"os"
+	"sync/atomic"
+)
+
+// Building with `-tags simba_trace` routes every *_raw call through the
+// generated shims in trace_gen.go, which report the kernel name, the first
+// pointer argument and the first length argument to the trace sink before
+// entering the trampoline.  This is a debugging aid for ABI problems only:
+// each call pays for an indirect function call and, with the default sink, a
+// formatted write to stderr, which easily costs more than the kernel itself
+// on short inputs.  Never ship a simba_trace binary to production.
+
+// TraceSink receives one call per kernel invocation.
+type TraceSink func(kernel string, ptr, n uintptr)
+
+var traceSink atomic.Pointer[TraceSink]
+
+func init() {
+	SetTraceSink(nil)
+}
+
+// SetTraceSink installs fn as the trace sink; nil restores the default,
+// which writes one line per call to stderr.
+func SetTraceSink(fn TraceSink) {
+	if fn == nil {
+		fn = func(kernel string, ptr, n uintptr) {
+			fmt.Fprintf(os.Stderr, "simba: %s ptr=%#x len=%d\n", kernel, ptr, n)
+		}
+	}
+	traceSink.Store(&fn)
+}
+
+func traceCall(kernel string, ptr, n uintptr) {
+	(*traceSink.Load())(kernel, ptr, n)
+}
@@ -0,0 +1,27 @@
+//go:build simba_trace
+
+package ffi
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceSink(t *testing.T) {
+	type call struct {
+		kernel string
+		ptr, n uintptr
+	}
+	var calls []call
+	SetTraceSink(func(kernel string, ptr, n uintptr) {
+		calls = append(calls, call{kernel, ptr, n})
+	})
+	defer SetTraceSink(nil)
+
+	data := make([]byte, 100)
+	SumU8_32(data)
+
+	require.Equal(t, []call{{"sum_u8_32", uintptr(unsafe.Pointer(&data[0])), 100}}, calls)
+}
@@ -0,0 +1,49 @@
+//go:build 386 && !simba_noffi && !simba_reftramp
+
+package ffi
+
+import (
+	"math"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/require"
+)
+
+// split386Buf is a global so that its address, recorded as a uintptr in the
+// expected Echo, stays valid; a stack buffer may move when the stack grows.
+var split386Buf [8]byte
+
+// TestTrampoline386Split checks the cdecl stubs on 386, where every argument
+// is copied to the stack and each 64-bit value occupies two 4-byte slots.
+// Halves that differ catch a swapped or duplicated slot, which a symmetric
+// value like MaxUint64 would hide.
+func TestTrampoline386Split(t *testing.T) {
+	require.EqualValues(t, 4, unsafe.Sizeof(uintptr(0)))
+	require.EqualValues(t, 36, unsafe.Offsetof(Echo{}.F64), "f64 is 4-aligned in the i386 psABI")
+
+	buf := split386Buf[:]
+	for _, tc := range []struct {
+		ptr    *byte
+		length uintptr
+		v32    uint32
+		v8     uint8
+		v64    uint64
+		f64    uint64
+		f32    uint32
+	}{
+		{&buf[0], 8, 0x89abcdef, 0xa5, 0x0123456789abcdef, math.Float64bits(-math.Pi), math.Float32bits(1.5)},
+		{&buf[3], math.MaxUint32, math.MaxUint32, math.MaxUint8, 0xffffffff00000000, 0x00000000ffffffff, math.MaxUint32},
+		{nil, 0, 0, 0, 0x00000001_00000000, 1, 0},
+	} {
+		got := TrampolineEcho(tc.ptr, tc.length, tc.v32, tc.v8, tc.v64, tc.f64, tc.f32)
+		want := Echo{
+			Ptr: uintptr(unsafe.Pointer(tc.ptr)), Len: tc.length, V32: tc.v32, V8: tc.v8,
+			V64: tc.v64, F64Bits: tc.f64, F32Bits: tc.f32, F64: math.Float64frombits(tc.f64),
+		}
+		require.Equal(t, want, got)
+		require.Equal(t,
+			refHash(want.Ptr, tc.length, tc.v32, tc.v8, tc.v64, tc.f64, tc.f32),
+			TrampolineSanityHash(tc.ptr, tc.length, tc.v32, tc.v8, tc.v64, tc.f64, tc.f32))
+	}
+}
@@ -3,6 +3,7 @@ package ffi
 import (
 	"math"
 	"math/rand"
+	"runtime"
 	"testing"
 	"unsafe"
 )
@@ -77,3 +78,37 @@ func TestTrampolineSanity(t *testing.T) {
 		}
 	}
 }
+
+func TestTrampolineFloat64Return(t *testing.T) {
+	bits := []uint64{
+		0,
+		1 << 63, // -0
+		math.Float64bits(1),
+		math.Float64bits(-math.Pi),
+		math.Float64bits(math.Inf(1)),
+		math.Float64bits(math.Inf(-1)),
+		math.Float64bits(math.MaxFloat64),
+		math.Float64bits(math.SmallestNonzeroFloat64),
+		0x7ff8_0000_0000_0001, // quiet NaN with payload
+		0xfff0_0000_dead_beef, // signalling NaN with payload
+	}
+	rng := rand.New(rand.NewSource(7))
+	for i := 0; i < 100; i++ {
+		bits = append(bits, rng.Uint64())
+	}
+	for _, want := range bits {
+		ret := want
+		if runtime.GOARCH == "386" && Backend == "syso" && math.IsNaN(math.Float64frombits(want)) {
+			// cdecl returns float64 in the x87 ST(0), and loading a
+			// signalling NaN there sets its quiet bit.
+			ret |= 1 << 51
+		}
+		if got := math.Float64bits(TrampolineEchoF64(want)); got != ret {
+			t.Fatalf("float64 return: got %#016x, want %#016x", got, ret)
+		}
+		echo := TrampolineEcho(nil, 0, 0, 0, 0, want, 0)
+		if got := math.Float64bits(echo.F64); got != want {
+			t.Fatalf("echo F64 field: got %#016x, want %#016x", got, want)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package simba
+
+import "github.com/miretskiy/simba/internal/ffi"
+
+// SupportedKernels returns the sorted names of the Rust kernel symbols
+// linked into this binary, e.g. "sum_u8_64" or "crc32_update_64".  Callers
+// can use it to degrade gracefully when a kernel introduced by a newer
+// release is not available.
+//
+// The syso archive is linked statically, so the list is compiled in from the
+// trampoline declarations (kernels_gen.go): a binary that links at all has
+// every listed symbol.  Builds whose Backend is "go" link no Rust and return
+// nil.
+func SupportedKernels() []string {
+	return ffi.Kernels()
+}
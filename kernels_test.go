@@ -0,0 +1,24 @@
+package simba
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSupportedKernels(t *testing.T) {
+	kernels := SupportedKernels()
+	if Backend() == "go" {
+		require.Empty(t, kernels)
+		return
+	}
+	require.True(t, sort.StringsAreSorted(kernels))
+	for _, name := range []string{"sum_u8_16", "sum_u8_32", "sum_u8_64", "is_ascii16", "is_ascii32", "is_ascii64", "crc32_update_32", "crc32_update_64"} {
+		require.Contains(t, kernels, name)
+	}
+
+	// The result is a copy; mutating it must not affect later calls.
+	kernels[0] = "bogus"
+	require.NotContains(t, SupportedKernels(), "bogus")
+}
@@ -0,0 +1,124 @@
+package algo
+
+import (
+	"bytes"
+	"hash/crc32"
+	"testing"
+)
+
+// differentialOp runs one algo entry point and its scalar twin on the same
+// input and returns both results; they must compare equal with ==.
+type differentialOp struct {
+	name string
+	run  func(data []byte, arg byte) (simd, scalar any)
+}
+
+// fuzzSet derives a ByteSet from the fuzzer-chosen arg byte: roughly half of
+// all bytes are members, and which half changes with arg.
+func fuzzSet(arg byte) *ByteSet {
+	var s ByteSet
+	for i := range s {
+		if byte(i)&arg != arg {
+			s[i] = 1
+		}
+	}
+	s[arg] = 1
+	return &s
+}
+
+var differentialOps = []differentialOp{
+	{"AllBytesInSet", func(data []byte, arg byte) (any, any) {
+		set := fuzzSet(arg)
+		want := true
+		for _, b := range data {
+			if set[b] == 0 {
+				want = false
+				break
+			}
+		}
+		return AllBytesInSet(data, set), want
+	}},
+	{"MapBytes", func(data []byte, arg byte) (any, any) {
+		var lut ByteSet
+		for i := range lut {
+			lut[i] = byte(i) ^ arg
+		}
+		want := make([]byte, len(data))
+		for i, b := range data {
+			want[i] = lut[b]
+		}
+		got := make([]byte, len(data))
+		n := MapBytes(got, data, &lut)
+		return string(got[:n]), string(want)
+	}},
+	{"FilterBytes", func(data []byte, arg byte) (any, any) {
+		set := fuzzSet(arg)
+		got := make([]byte, len(data))
+		n := FilterBytes(got, data, set)
+		return string(got[:n]), string(scalarFilterBytes(data, set))
+	}},
+	{"SumU8", func(data []byte, _ byte) (any, any) {
+		var want uint32
+		for _, b := range data {
+			want += uint32(b)
+		}
+		return SumU8(data), want
+	}},
+	{"SumU8Wide", func(data []byte, _ byte) (any, any) {
+		var want uint64
+		for _, b := range data {
+			want += uint64(b)
+		}
+		return SumU8Wide(data), want
+	}},
+	{"XorChecksum", func(data []byte, _ byte) (any, any) {
+		var want byte
+		for _, b := range data {
+			want ^= b
+		}
+		return XorChecksum(data), want
+	}},
+	{"IsASCII", func(data []byte, _ byte) (any, any) {
+		want := true
+		for _, b := range data {
+			if b >= 0x80 {
+				want = false
+				break
+			}
+		}
+		return IsASCII(data), want
+	}},
+	{"ContainsByte", func(data []byte, arg byte) (any, any) {
+		return ContainsByte(data, arg), bytes.IndexByte(data, arg) >= 0
+	}},
+	{"CRC32", func(data []byte, _ byte) (any, any) {
+		return CRC32(data), crc32.Checksum(data, castagnoliTable)
+	}},
+}
+
+// FuzzAlgoDifferential checks every algo entry point in differentialOps
+// against its scalar twin.  The op byte selects the entry point, so a single
+// corpus exercises all of them, including both sides of every dispatch
+// threshold.
+func FuzzAlgoDifferential(f *testing.F) {
+	for op := range differentialOps {
+		for _, n := range []int{0, 1, 15, 16, 17, 31, 32, 33, 63, 64, 65, 1023, 1024, 1025} {
+			data := bytes.Repeat([]byte("a-Z_9"), n/5+1)[:n]
+			f.Add(byte(op), data, byte('a'))
+			if n > 0 {
+				hi := append([]byte(nil), data...)
+				hi[n-1] = 0x80
+				f.Add(byte(op), hi, byte(0x80))
+			}
+		}
+		f.Add(byte(op), bytes.Repeat([]byte{0xFF}, 64), byte(0xFF))
+	}
+
+	f.Fuzz(func(t *testing.T, op byte, data []byte, arg byte) {
+		d := differentialOps[int(op)%len(differentialOps)]
+		simd, scalar := d.run(data, arg)
+		if simd != scalar {
+			t.Fatalf("%s(len=%d, arg=%#x): simd=%v scalar=%v", d.name, len(data), arg, simd, scalar)
+		}
+	})
+}
@@ -9,12 +9,174 @@ import "github.com/miretskiy/simba/pkg/intrinsics"
 // overhead (see str_test.go benchmark table).  On Apple M-series silicon an
 // ~128-byte threshold is optimal; tailor as needed per platform.
 func SumU8(data []byte) uint32 {
+	return defaultDispatcher.Load().SumU8(data)
+}
+
+// sumU8WideBlock is the largest block whose byte-sum cannot wrap a uint32
+// (255 * 16 MiB < 2^32), so each block can go through the 32-bit kernels.
+const sumU8WideBlock = 16 << 20
+
+// SumU8Wide adds all bytes in the slice without wrapping at 2^32.  The input
+// is fed to SumU8 in blocks small enough that the 32-bit kernels never
+// overflow and the partial sums are accumulated in a uint64.
+func SumU8Wide(data []byte) uint64 {
+	var total uint64
+	for len(data) > sumU8WideBlock {
+		total += uint64(SumU8(data[:sumU8WideBlock]))
+		data = data[sumU8WideBlock:]
+	}
+	return total + uint64(SumU8(data))
+}
+
+// SumU16 returns the sum of data without wrapping.  Slices shorter than
+// simdThreshold elements are summed with a scalar loop.
+func SumU16(data []uint16) uint64 {
+	if len(data) < simdThreshold {
+		var acc uint64
+		for _, v := range data {
+			acc += uint64(v)
+		}
+		return acc
+	}
+	return intrinsics.SumU16(data)
+}
+
+// SumU32 returns the sum of data without wrapping.  Slices shorter than
+// simdThreshold elements are summed with a scalar loop.
+func SumU32(data []uint32) uint64 {
+	if len(data) < simdThreshold {
+		var acc uint64
+		for _, v := range data {
+			acc += uint64(v)
+		}
+		return acc
+	}
+	return intrinsics.SumU32(data)
+}
+
+// MinU8 returns the smallest byte of data and true, or (0, false) if data is
+// empty.  Slices shorter than simdThreshold are scanned with a scalar loop.
+func MinU8(data []byte) (byte, bool) {
+	if len(data) == 0 {
+		return 0, false
+	}
+	if len(data) < simdThreshold {
+		m := data[0]
+		for _, b := range data[1:] {
+			m = min(m, b)
+		}
+		return m, true
+	}
+	return intrinsics.MinU8(data), true
+}
+
+// MaxU8 returns the largest byte of data and true, or (0, false) if data is
+// empty.  A maximum below 0x80 means data is pure ASCII.
+func MaxU8(data []byte) (byte, bool) {
+	if len(data) == 0 {
+		return 0, false
+	}
+	if len(data) < simdThreshold {
+		m := data[0]
+		for _, b := range data[1:] {
+			m = max(m, b)
+		}
+		return m, true
+	}
+	return intrinsics.MaxU8(data), true
+}
+
+// XorChecksum returns the XOR of all bytes in data, or 0 for empty input.
+// Slices shorter than the SIMD threshold are folded with a scalar loop.
+func XorChecksum(data []byte) byte {
 	if len(data) < simdThreshold {
-		var acc uint32
+		var acc byte
 		for _, b := range data {
-			acc += uint32(b)
+			acc ^= b
 		}
 		return acc
 	}
-	return intrinsics.SumU8(data)
+	return intrinsics.XorReduce(data)
+}
+
+// Histogram returns the number of occurrences of every byte value in data;
+// the buckets always sum to len(data).  Slices shorter than the SIMD
+// threshold are tallied with a scalar loop.
+func Histogram(data []byte) *[256]uint64 {
+	var hist [256]uint64
+	if len(data) < simdThreshold {
+		for _, b := range data {
+			hist[b]++
+		}
+		return &hist
+	}
+	intrinsics.Histogram(data, &hist)
+	return &hist
+}
+
+// AddU8Saturating sets dst[i] = min(a[i]+b[i], 255), clamping instead of
+// wrapping as sample arithmetic usually wants.  Lengths, aliasing and the
+// scalar fallback behave as for XorBytes.
+func AddU8Saturating(dst, a, b []byte) int {
+	n := min(len(dst), len(a), len(b))
+	if n < simdThreshold {
+		for i := 0; i < n; i++ {
+			if s := a[i] + b[i]; s < a[i] {
+				dst[i] = 255
+			} else {
+				dst[i] = s
+			}
+		}
+		return n
+	}
+	return intrinsics.AddU8Saturating(dst[:n], a[:n], b[:n])
+}
+
+// AddU8Wrapping sets dst[i] = a[i] + b[i] modulo 256, as Go's byte addition
+// does.  Lengths, aliasing and the scalar fallback behave as for XorBytes.
+func AddU8Wrapping(dst, a, b []byte) int {
+	n := min(len(dst), len(a), len(b))
+	if n < simdThreshold {
+		for i := 0; i < n; i++ {
+			dst[i] = a[i] + b[i]
+		}
+		return n
+	}
+	return intrinsics.AddU8Wrapping(dst[:n], a[:n], b[:n])
+}
+
+// DotU8 returns the dot product of a and b, the sum of a[i]*b[i], as a
+// uint64 that cannot overflow for any addressable input.  a and b must have
+// equal length; DotU8 panics otherwise.  Slices shorter than the SIMD
+// threshold are multiplied with a scalar loop.
+func DotU8(a, b []byte) uint64 {
+	if len(a) != len(b) {
+		panic("algo: DotU8 slices differ in length")
+	}
+	if len(a) < simdThreshold {
+		var s uint64
+		for i := range a {
+			s += uint64(a[i]) * uint64(b[i])
+		}
+		return s
+	}
+	return intrinsics.DotU8(a, b)
+}
+
+// DotF32 returns the dot product of a and b with products formed and summed
+// in float64.  The SIMD kernel adds the products in a different order than a
+// sequential loop, so results may differ from one in the last bits.  Length
+// rules and the scalar fallback are as for DotU8.
+func DotF32(a, b []float32) float64 {
+	if len(a) != len(b) {
+		panic("algo: DotF32 slices differ in length")
+	}
+	if len(a) < simdThreshold {
+		var s float64
+		for i := range a {
+			s += float64(a[i]) * float64(b[i])
+		}
+		return s
+	}
+	return intrinsics.DotF32(a, b)
 }
@@ -0,0 +1,223 @@
+package algo
+
+import (
+	"strconv"
+
+	"github.com/miretskiy/simba/pkg/intrinsics"
+)
+
+// Base64Alphabet selects the RFC 4648 alphabet used by the base64 helpers.
+type Base64Alphabet uint8
+
+const (
+	// Base64Std is the standard alphabet ('+' and '/'), as used by
+	// base64.StdEncoding.
+	Base64Std Base64Alphabet = iota
+	// Base64URL is the URL- and filename-safe alphabet ('-' and '_'), as used
+	// by base64.URLEncoding.
+	Base64URL
+)
+
+const (
+	base64StdChars = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+	base64URLChars = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+)
+
+const base64Pad = '='
+
+// The SIMD kernels take over once the input covers one full 16-group step
+// (48 source bytes when encoding, 64 encoded bytes when decoding).
+const (
+	base64EncodeThreshold = 48
+	base64DecodeThreshold = 64
+)
+
+func base64DecodeMap(chars string) (m [256]byte) {
+	for i := range m {
+		m[i] = 0xFF
+	}
+	for i := 0; i < len(chars); i++ {
+		m[chars[i]] = byte(i)
+	}
+	return m
+}
+
+var (
+	base64StdDecodeMap = base64DecodeMap(base64StdChars)
+	base64URLDecodeMap = base64DecodeMap(base64URLChars)
+)
+
+// InvalidBase64Error reports the offset of the first invalid byte in a
+// base64 input: a byte outside the alphabet, a misplaced or miscounted '='
+// or a truncated final group.
+type InvalidBase64Error int64
+
+func (e InvalidBase64Error) Error() string {
+	return "algo: invalid base64 data at input byte " + strconv.FormatInt(int64(e), 10)
+}
+
+// String returns the alphabet's name.
+func (a Base64Alphabet) String() string {
+	switch a {
+	case Base64Std:
+		return "Std"
+	case Base64URL:
+		return "URL"
+	default:
+		return "Base64Alphabet(?)"
+	}
+}
+
+func (a Base64Alphabet) chars() string {
+	switch a {
+	case Base64Std:
+		return base64StdChars
+	case Base64URL:
+		return base64URLChars
+	default:
+		panic("algo: unknown Base64Alphabet")
+	}
+}
+
+func (a Base64Alphabet) decodeMap() *[256]byte {
+	switch a {
+	case Base64Std:
+		return &base64StdDecodeMap
+	case Base64URL:
+		return &base64URLDecodeMap
+	default:
+		panic("algo: unknown Base64Alphabet")
+	}
+}
+
+// Base64EncodedLen returns the length of the padded base64 encoding of n
+// bytes.
+func Base64EncodedLen(n int) int {
+	return (n + 2) / 3 * 4
+}
+
+// Base64DecodedLen returns the maximum number of bytes decoded from n bytes
+// of padded base64 input.
+func Base64DecodedLen(n int) int {
+	return n / 4 * 3
+}
+
+// Base64Encode encodes src with the standard alphabet into dst, padding
+// with '=', and returns Base64EncodedLen(len(src)), the number of bytes
+// written.  The output matches base64.StdEncoding.  It panics if dst is
+// shorter than the encoded length.
+func Base64Encode(dst, src []byte) int {
+	return Base64Std.Encode(dst, src)
+}
+
+// Base64Decode decodes '='-padded standard-alphabet base64 from src into dst;
+// see Base64Alphabet.Decode.
+func Base64Decode(dst, src []byte) (int, error) {
+	return Base64Std.Decode(dst, src)
+}
+
+// Encode encodes src with alphabet a into dst, padding with '=' to a
+// multiple of 4 bytes, and returns Base64EncodedLen(len(src)), the number of
+// bytes written.  It panics if dst is shorter than the encoded length.
+func (a Base64Alphabet) Encode(dst, src []byte) int {
+	chars := a.chars()
+	n := Base64EncodedLen(len(src))
+	if len(dst) < n {
+		panic("algo: Base64Encode dst too short")
+	}
+
+	di := 0
+	if len(src) >= base64EncodeThreshold {
+		di = intrinsics.Base64EncodeBlocks(dst, src, a == Base64URL)
+	}
+	si := di / 4 * 3
+	for ; si+3 <= len(src); si, di = si+3, di+4 {
+		v := uint(src[si])<<16 | uint(src[si+1])<<8 | uint(src[si+2])
+		dst[di] = chars[v>>18&63]
+		dst[di+1] = chars[v>>12&63]
+		dst[di+2] = chars[v>>6&63]
+		dst[di+3] = chars[v&63]
+	}
+
+	switch len(src) - si {
+	case 1:
+		v := uint(src[si]) << 16
+		dst[di], dst[di+1] = chars[v>>18&63], chars[v>>12&63]
+		dst[di+2], dst[di+3] = base64Pad, base64Pad
+	case 2:
+		v := uint(src[si])<<16 | uint(src[si+1])<<8
+		dst[di], dst[di+1], dst[di+2] = chars[v>>18&63], chars[v>>12&63], chars[v>>6&63]
+		dst[di+3] = base64Pad
+	}
+	return n
+}
+
+// Decode decodes '='-padded base64 in alphabet a from src into dst and
+// returns the number of bytes written.  src must be a multiple of 4 bytes
+// with at most two trailing '=', as produced by Encode.  Unlike
+// encoding/base64, line breaks are not skipped; as there, unused bits in the
+// final group are ignored.
+//
+// On malformed input it returns the bytes decoded before the first bad group
+// together with an InvalidBase64Error holding the offset of the offending
+// byte.  It panics if dst is shorter than Base64DecodedLen(len(src)).
+func (a Base64Alphabet) Decode(dst, src []byte) (int, error) {
+	m := a.decodeMap()
+	if len(dst) < Base64DecodedLen(len(src)) {
+		panic("algo: Base64Decode dst too short")
+	}
+	if len(src)%4 != 0 {
+		return 0, InvalidBase64Error(len(src) &^ 3)
+	}
+	padding := 0
+	for padding < len(src) && padding < 4 && src[len(src)-1-padding] == base64Pad {
+		padding++
+	}
+	if padding > 2 {
+		return 0, InvalidBase64Error(len(src) - padding)
+	}
+	body := src[:len(src)-padding]
+
+	si := 0
+	if len(body) >= base64DecodeThreshold {
+		si = intrinsics.Base64DecodeBlocks(dst, body, a == Base64URL)
+	}
+	di := si / 4 * 3
+	for ; si+4 <= len(body); si, di = si+4, di+3 {
+		v, bad := base64DecodeBits(m, body[si:si+4])
+		if bad >= 0 {
+			return di, InvalidBase64Error(si + bad)
+		}
+		dst[di], dst[di+1], dst[di+2] = byte(v>>16), byte(v>>8), byte(v)
+	}
+
+	// A padded input leaves a final group of 2 or 3 characters.
+	rem := len(body) - si
+	if rem == 0 {
+		return di, nil
+	}
+	v, bad := base64DecodeBits(m, body[si:])
+	if bad >= 0 {
+		return di, InvalidBase64Error(si + bad)
+	}
+	v <<= 6 * uint(4-rem)
+	out := rem - 1
+	for j := 0; j < out; j++ {
+		dst[di+j] = byte(v >> (16 - 8*j))
+	}
+	return di + out, nil
+}
+
+// base64DecodeBits packs up to 4 base64 characters into the low
+// 6*len(chars) bits of a uint32.  bad is the index of the first byte outside
+// the alphabet, or -1.
+func base64DecodeBits(m *[256]byte, chars []byte) (v uint32, bad int) {
+	for i, c := range chars {
+		d := m[c]
+		if d == 0xFF {
+			return 0, i
+		}
+		v = v<<6 | uint32(d)
+	}
+	return v, -1
+}
@@ -0,0 +1,55 @@
+package algo
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+)
+
+func FuzzBase64(f *testing.F) {
+	f.Add([]byte(""), false)
+	f.Add([]byte("foobar"), true)
+	f.Add(bytes.Repeat([]byte{0xFF}, 47), false)
+	f.Add(bytes.Repeat([]byte{0xFB, 0xEF}, 40), true)
+	f.Add([]byte("Zm9vYg=="), false)
+	f.Add([]byte("Zm9vYmE="), true)
+	f.Add([]byte("Zm9=Zg=="), false)
+
+	f.Fuzz(func(t *testing.T, data []byte, url bool) {
+		alphabet, std := Base64Std, base64.StdEncoding
+		if url {
+			alphabet, std = Base64URL, base64.URLEncoding
+		}
+
+		// Encode: data is raw input.
+		enc := make([]byte, Base64EncodedLen(len(data)))
+		alphabet.Encode(enc, data)
+		if want := std.EncodeToString(data); string(enc) != want {
+			t.Fatalf("%v.Encode(%x) = %q, want %q", alphabet, data, enc, want)
+		}
+		dec := make([]byte, Base64DecodedLen(len(enc)))
+		n, err := alphabet.Decode(dec, enc)
+		if err != nil || !bytes.Equal(dec[:n], data) {
+			t.Fatalf("round trip of %x (%v) = %x, %v", data, alphabet, dec[:n], err)
+		}
+
+		// Decode: data is (possibly invalid) base64 text.  encoding/base64
+		// skips line breaks, so inputs holding them are only required to
+		// fail here.
+		want, wantErr := std.DecodeString(string(data))
+		got := make([]byte, Base64DecodedLen(len(data)))
+		n, err = alphabet.Decode(got, data)
+		if wantErr == nil && bytes.ContainsAny(data, "\r\n") {
+			if err == nil {
+				t.Fatalf("%v.Decode(%q) accepted a line break", alphabet, data)
+			}
+			return
+		}
+		if (err == nil) != (wantErr == nil) {
+			t.Fatalf("%v.Decode(%q) err = %v, encoding/base64 err = %v", alphabet, data, err, wantErr)
+		}
+		if err == nil && !bytes.Equal(got[:n], want) {
+			t.Fatalf("%v.Decode(%q) = %x, want %x", alphabet, data, got[:n], want)
+		}
+	})
+}
@@ -0,0 +1,124 @@
+package algo
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBase64RFC4648(t *testing.T) {
+	for _, tc := range []struct{ in, want string }{
+		{"", ""},
+		{"f", "Zg=="},
+		{"fo", "Zm8="},
+		{"foo", "Zm9v"},
+		{"foob", "Zm9vYg=="},
+		{"fooba", "Zm9vYmE="},
+		{"foobar", "Zm9vYmFy"},
+	} {
+		enc := make([]byte, Base64EncodedLen(len(tc.in)))
+		require.Equal(t, len(tc.want), Base64Encode(enc, []byte(tc.in)))
+		require.Equal(t, tc.want, string(enc), "encode %q", tc.in)
+
+		dec := make([]byte, Base64DecodedLen(len(tc.want)))
+		n, err := Base64Decode(dec, []byte(tc.want))
+		require.NoError(t, err)
+		require.Equal(t, tc.in, string(dec[:n]), "decode %q", tc.want)
+	}
+}
+
+func TestBase64Alphabets(t *testing.T) {
+	r := rand.New(rand.NewSource(17))
+	for _, tc := range []struct {
+		alphabet Base64Alphabet
+		std      *base64.Encoding
+	}{
+		{Base64Std, base64.StdEncoding},
+		{Base64URL, base64.URLEncoding},
+	} {
+		for _, n := range []int{0, 1, 2, 3, 47, 48, 49, 50, 100, 1000} {
+			src := make([]byte, n)
+			r.Read(src)
+			enc := make([]byte, Base64EncodedLen(n))
+			require.Equal(t, len(enc), tc.alphabet.Encode(enc, src))
+			require.Equal(t, tc.std.EncodeToString(src), string(enc), "%v n=%d", tc.alphabet, n)
+
+			dec := make([]byte, Base64DecodedLen(len(enc)))
+			got, err := tc.alphabet.Decode(dec, enc)
+			require.NoError(t, err)
+			require.Equal(t, src, dec[:got], "%v n=%d", tc.alphabet, n)
+		}
+	}
+
+	// Each alphabet rejects the other's two extra characters.
+	_, err := Base64URL.Decode(make([]byte, 3), []byte("ab+/"))
+	require.Equal(t, InvalidBase64Error(2), err)
+	_, err = Base64Std.Decode(make([]byte, 3), []byte("ab-_"))
+	require.Equal(t, InvalidBase64Error(2), err)
+}
+
+func TestBase64DecodeErrors(t *testing.T) {
+	long := strings.Repeat("Zm9vYmFy", 16)
+	for _, tc := range []struct {
+		in      string
+		written int
+		offset  int64
+	}{
+		{"Zm9", 0, 0},           // not a multiple of 4
+		{long + "Zg=", 0, 128},  // not a multiple of 4
+		{"Zg==Zm9v", 0, 2},      // padding inside the input
+		{"Z===", 0, 1},          // three pad bytes
+		{"====", 0, 0},          // only padding
+		{long + "A===", 0, 129}, // three pad bytes after a long body
+		{long[:70] + "*" + long[71:], 51, 70},
+		{long[:127] + "\n", 93, 127},
+		{long + "Zm=v", 96, 130}, // '=' inside the last group
+		{long + "Z!==", 96, 129},
+	} {
+		dst := make([]byte, Base64DecodedLen(len(tc.in)))
+		n, err := Base64Decode(dst, []byte(tc.in))
+		require.Equal(t, InvalidBase64Error(tc.offset), err, "%q", tc.in)
+		require.Equal(t, tc.written, n, "%q", tc.in)
+	}
+}
+
+var base64Sink int
+
+func BenchmarkBase64(b *testing.B) {
+	for _, n := range []int{64, 4 << 10, 1 << 20} {
+		src := make([]byte, n)
+		rand.New(rand.NewSource(1)).Read(src)
+		enc := make([]byte, Base64EncodedLen(n))
+		Base64Encode(enc, src)
+		dec := make([]byte, Base64DecodedLen(len(enc)))
+
+		b.Run(fmt.Sprintf("EncodeStdlib_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				base64.StdEncoding.Encode(enc, src)
+			}
+		})
+		b.Run(fmt.Sprintf("EncodeAlgo_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				base64Sink = Base64Encode(enc, src)
+			}
+		})
+		b.Run(fmt.Sprintf("DecodeStdlib_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				base64Sink, _ = base64.StdEncoding.Decode(dec, enc)
+			}
+		})
+		b.Run(fmt.Sprintf("DecodeAlgo_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				base64Sink, _ = Base64Decode(dec, enc)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package algo
+
+import (
+	"math/bits"
+
+	"github.com/miretskiy/simba/pkg/intrinsics"
+)
+
+// PopCount returns the number of set bits in data, e.g. the cardinality of a
+// byte-packed bitmap.  The count is a uint64 and never wraps.  Inputs shorter
+// than the SIMD threshold are counted with bits.OnesCount8.
+func PopCount(data []byte) uint64 {
+	if len(data) < simdThreshold {
+		var n int
+		for _, b := range data {
+			n += bits.OnesCount8(b)
+		}
+		return uint64(n)
+	}
+	return intrinsics.PopCount(data)
+}
+
+// XorBytes sets dst[i] = a[i] ^ b[i] and returns the number of bytes
+// written, min(len(dst), len(a), len(b)), following copy-like semantics: it
+// never panics on a length mismatch.  dst may be a or b itself for in-place
+// use.  Inputs shorter than the SIMD threshold are combined with a scalar
+// loop.
+func XorBytes(dst, a, b []byte) int {
+	n := min(len(dst), len(a), len(b))
+	if n < simdThreshold {
+		for i := 0; i < n; i++ {
+			dst[i] = a[i] ^ b[i]
+		}
+		return n
+	}
+	return intrinsics.XorBytes(dst[:n], a[:n], b[:n])
+}
+
+// AndBytes sets dst[i] = a[i] & b[i], e.g. the intersection of two byte-packed
+// bitmaps.  Lengths, aliasing and the scalar fallback behave as for XorBytes.
+func AndBytes(dst, a, b []byte) int {
+	n := min(len(dst), len(a), len(b))
+	if n < simdThreshold {
+		for i := 0; i < n; i++ {
+			dst[i] = a[i] & b[i]
+		}
+		return n
+	}
+	return intrinsics.AndBytes(dst[:n], a[:n], b[:n])
+}
+
+// OrBytes sets dst[i] = a[i] | b[i], e.g. the union of two byte-packed
+// bitmaps.  Lengths, aliasing and the scalar fallback behave as for XorBytes.
+func OrBytes(dst, a, b []byte) int {
+	n := min(len(dst), len(a), len(b))
+	if n < simdThreshold {
+		for i := 0; i < n; i++ {
+			dst[i] = a[i] | b[i]
+		}
+		return n
+	}
+	return intrinsics.OrBytes(dst[:n], a[:n], b[:n])
+}
@@ -0,0 +1,79 @@
+package algo
+
+import (
+	"bytes"
+	"testing"
+)
+
+// bitwiseOps pairs each (dst, a, b) combinator with its scalar definition.
+var bitwiseOps = []struct {
+	name string
+	fn   func(dst, a, b []byte) int
+	op   func(x, y byte) byte
+}{
+	{"XorBytes", XorBytes, func(x, y byte) byte { return x ^ y }},
+	{"AndBytes", AndBytes, func(x, y byte) byte { return x & y }},
+	{"OrBytes", OrBytes, func(x, y byte) byte { return x | y }},
+	{"AddU8Saturating", AddU8Saturating, func(x, y byte) byte { return byte(min(int(x)+int(y), 255)) }},
+	{"AddU8Wrapping", AddU8Wrapping, func(x, y byte) byte { return x + y }},
+}
+
+func FuzzBitwiseBytes(f *testing.F) {
+	for op := range bitwiseOps {
+		for _, n := range []int{0, 1, 15, 16, 17, 63, 64, 65, 200} {
+			a := bytes.Repeat([]byte{0x5A, 0xF0, 0x0F}, n/3+1)[:n]
+			f.Add(byte(op), a, bytes.Repeat([]byte{0x33}, n), uint8(255), uint8(0))
+			f.Add(byte(op), a, bytes.Repeat([]byte{0xC3}, n/2), uint8(1), uint8(2))
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, op byte, a, b []byte, dstLen, alias uint8) {
+		o := bitwiseOps[int(op)%len(bitwiseOps)]
+		n := min(len(a), len(b))
+		want := make([]byte, n)
+		for i := range want {
+			want[i] = o.op(a[i], b[i])
+		}
+
+		// dst is a fresh buffer of up to dstLen bytes or aliases a or b
+		// exactly.
+		a, b = bytes.Clone(a), bytes.Clone(b)
+		var dst []byte
+		switch alias % 3 {
+		case 0:
+			dst = make([]byte, min(int(dstLen), max(len(a), len(b))))
+			n = min(n, len(dst))
+			want = want[:n]
+		case 1:
+			dst = a
+		case 2:
+			dst = b
+		}
+
+		if got := o.fn(dst, a, b); got != n {
+			t.Fatalf("%s: returned %d, want %d", o.name, got, n)
+		}
+		if !bytes.Equal(dst[:n], want) {
+			t.Fatalf("%s(len(a)=%d, len(b)=%d, alias=%d): got %x, want %x", o.name, len(a), len(b), alias%3, dst[:n], want)
+		}
+	})
+}
+
+func FuzzPopCount(f *testing.F) {
+	for _, n := range []int{0, 1, 15, 16, 17, 31, 32, 33, 63, 64, 65, 200, 4097} {
+		f.Add(bytes.Repeat([]byte{0xFF}, n))
+		f.Add(bytes.Repeat([]byte{0x01, 0x80, 0x5A}, n/3+1)[:n])
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var want uint64
+		for _, b := range data {
+			for ; b != 0; b &= b - 1 {
+				want++
+			}
+		}
+		if got := PopCount(data); got != want {
+			t.Fatalf("PopCount(len=%d) = %d, want %d", len(data), got, want)
+		}
+	})
+}
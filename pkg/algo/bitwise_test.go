@@ -0,0 +1,115 @@
+package algo
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"fmt"
+	"math/bits"
+	"math/rand"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/require"
+)
+
+func scalarXor(a, b []byte) []byte {
+	n := min(len(a), len(b))
+	out := make([]byte, n)
+	for i := range out {
+		out[i] = a[i] ^ b[i]
+	}
+	return out
+}
+
+func TestXorBytes(t *testing.T) {
+	r := rand.New(rand.NewSource(14))
+	for _, n := range []int{0, 1, 15, 16, 17, 31, 32, 33, 63, 64, 65, 1000} {
+		a := make([]byte, n)
+		b := make([]byte, n)
+		r.Read(a)
+		r.Read(b)
+		want := scalarXor(a, b)
+
+		dst := make([]byte, n)
+		require.Equal(t, n, XorBytes(dst, a, b), "n=%d", n)
+		require.Equal(t, want, dst, "n=%d", n)
+
+		// dst aliasing a, then b.
+		inA := bytes.Clone(a)
+		require.Equal(t, n, XorBytes(inA, inA, b), "n=%d alias a", n)
+		require.Equal(t, want, inA, "n=%d alias a", n)
+		inB := bytes.Clone(b)
+		require.Equal(t, n, XorBytes(inB, a, inB), "n=%d alias b", n)
+		require.Equal(t, want, inB, "n=%d alias b", n)
+	}
+}
+
+func TestXorBytesLengthMismatch(t *testing.T) {
+	a := bytes.Repeat([]byte{0xF0}, 100)
+	b := bytes.Repeat([]byte{0x0F}, 70)
+
+	// Shortest input wins; bytes past it are untouched.
+	dst := bytes.Repeat([]byte{0xAA}, 100)
+	require.Equal(t, 70, XorBytes(dst, a, b))
+	require.Equal(t, bytes.Repeat([]byte{0xFF}, 70), dst[:70])
+	require.Equal(t, bytes.Repeat([]byte{0xAA}, 30), dst[70:])
+
+	// Short dst.
+	short := make([]byte, 20)
+	require.Equal(t, 20, XorBytes(short, a, b))
+	require.Equal(t, bytes.Repeat([]byte{0xFF}, 20), short)
+
+	require.Equal(t, 0, XorBytes(nil, a, b))
+	require.Equal(t, 0, XorBytes(dst, nil, b))
+}
+
+var xorSink int
+
+func BenchmarkXorBytes(b *testing.B) {
+	for _, n := range []int{64, 1024, 65536} {
+		x := make([]byte, n)
+		y := make([]byte, n)
+		dst := make([]byte, n)
+		rand.New(rand.NewSource(42)).Read(x)
+		b.Run(fmt.Sprintf("Subtle_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				xorSink = subtle.XORBytes(dst, x, y)
+			}
+		})
+		b.Run(fmt.Sprintf("Algo_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				xorSink = XorBytes(dst, x, y)
+			}
+		})
+	}
+}
+
+var popCountSink uint64
+
+// BenchmarkPopCount compares PopCount with the usual pure-Go loop, which
+// views the buffer as []uint64 and sums bits.OnesCount64.
+func BenchmarkPopCount(b *testing.B) {
+	for _, n := range []int{64, 1024, 65536} {
+		data := make([]byte, n)
+		rand.New(rand.NewSource(42)).Read(data)
+		words := unsafe.Slice((*uint64)(unsafe.Pointer(&data[0])), n/8)
+		b.Run(fmt.Sprintf("OnesCount64_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				var c int
+				for _, w := range words {
+					c += bits.OnesCount64(w)
+				}
+				popCountSink = uint64(c)
+			}
+		})
+		b.Run(fmt.Sprintf("Algo_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				popCountSink = PopCount(data)
+			}
+		})
+	}
+}
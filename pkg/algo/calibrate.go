@@ -0,0 +1,103 @@
+package algo
+
+import (
+	"slices"
+	"sync/atomic"
+	"time"
+)
+
+// calibrateLens are the crossover candidates Calibrate tries, in order; the
+// first length at which the kernel is no slower than the scalar loop wins,
+// and the last one is used when the kernel never catches up.
+var calibrateLens = []int{8, 16, 32, 64, 128, 256, 512, 1024}
+
+const (
+	// calibrateTrials is how many timings each side gets per length; their
+	// median absorbs preemption and frequency-scaling outliers.
+	calibrateTrials = 3
+	// calibrateBytes is roughly how much input one timing covers, so short
+	// lengths are repeated enough to rise above the timer resolution.
+	calibrateBytes = 256
+	// calibrateBudget bounds the time spent on one kernel.  Calibrate runs
+	// at startup, so a slow or noisy machine gives up and keeps the built-in
+	// threshold rather than delay every importer.
+	calibrateBudget = 10 * time.Microsecond
+)
+
+// calibrateSink receives the results of the timed calls, accumulated in a
+// local during the run, so that they cannot be optimised away.  It is
+// atomic because concurrent Calibrate calls each publish to it.
+var calibrateSink atomic.Uint32
+
+// Calibrate measures on the running machine where the SIMD kernels overtake
+// the scalar loops for IsASCII and SumU8.  It installs the measured
+// thresholds for the package-level functions and returns the resulting
+// Config; the other thresholds keep their defaults.  A threshold is always
+// one of 8, 16, ..., 1024 bytes.
+//
+// Each candidate length is timed three times on both paths and the medians
+// compared.  The sweep for a kernel stops once it has taken calibrateBudget
+// (10 µs), keeping that kernel's built-in threshold, so a run costs a few
+// tens of microseconds at most.  Calibrate runs once at initialisation
+// unless SIMBA_CALIBRATE is "off" or "0"; set it for deterministic builds
+// and reproducible benchmarks, since the result varies from run to run.
+// Builds with the simba_trace, simba_noffi or simba_reftramp tags never
+// calibrate at startup, since their kernel timings are meaningless.
+func Calibrate() Config {
+	simd := NewWith(Config{ASCIIThreshold: 1, SumThreshold: 1})
+	scalar := NewWith(Config{ASCIIThreshold: 1 << 30, SumThreshold: 1 << 30})
+
+	// Plain ASCII makes IsASCII scan the whole input on both paths.
+	data := make([]byte, calibrateLens[len(calibrateLens)-1])
+	for i := range data {
+		data[i] = 'a' + byte(i%26)
+	}
+
+	var sink uint32
+	d := NewWith(Config{
+		ASCIIThreshold: crossover(data, func(d *Dispatcher, b []byte) {
+			if d.IsASCII(b) {
+				sink++
+			}
+		}, simd, scalar, asciiThreshold),
+		SumThreshold: crossover(data, func(d *Dispatcher, b []byte) {
+			sink += d.SumU8(b)
+		}, simd, scalar, simdThreshold),
+	})
+	calibrateSink.Add(sink)
+	defaultDispatcher.Store(d)
+	return d.Config()
+}
+
+// crossover returns the first calibrateLens entry at which fn on simd takes
+// no longer than on scalar, by median time over calibrateTrials.  It returns
+// def if the sweep runs past calibrateBudget first.
+func crossover(data []byte, fn func(d *Dispatcher, b []byte), simd, scalar *Dispatcher, def int) int {
+	deadline := time.Now().Add(calibrateBudget)
+	for _, n := range calibrateLens {
+		if time.Now().After(deadline) {
+			return def
+		}
+		b := data[:n]
+		reps := max(calibrateBytes/n, 1)
+		if medianTime(reps, func() { fn(simd, b) }) <= medianTime(reps, func() { fn(scalar, b) }) {
+			return n
+		}
+	}
+	return calibrateLens[len(calibrateLens)-1]
+}
+
+// medianTime returns the median over calibrateTrials of the time reps calls
+// of fn take.
+func medianTime(reps int, fn func()) time.Duration {
+	var trials [calibrateTrials]time.Duration
+	for i := range trials {
+		start := time.Now()
+		for j := 0; j < reps; j++ {
+			fn()
+		}
+		trials[i] = time.Since(start)
+	}
+	slices.Sort(trials[:])
+	return trials[calibrateTrials/2]
+}
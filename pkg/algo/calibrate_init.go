@@ -0,0 +1,13 @@
+//go:build !simba_trace && !simba_noffi && !simba_reftramp
+
+package algo
+
+import "os"
+
+func init() {
+	switch os.Getenv("SIMBA_CALIBRATE") {
+	case "off", "0":
+	default:
+		Calibrate()
+	}
+}
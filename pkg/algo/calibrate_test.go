@@ -0,0 +1,64 @@
+package algo
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalibrate(t *testing.T) {
+	prev := defaultDispatcher.Load()
+	t.Cleanup(func() { defaultDispatcher.Store(prev) })
+
+	cfg := Calibrate()
+	for name, v := range map[string]int{"ASCIIThreshold": cfg.ASCIIThreshold, "SumThreshold": cfg.SumThreshold} {
+		require.GreaterOrEqual(t, v, 8, name)
+		require.LessOrEqual(t, v, 1024, name)
+	}
+	require.Equal(t, crc32Threshold, cfg.CRC32Threshold)
+	require.Equal(t, simdLUTThreshold, cfg.LUTThreshold)
+	require.Equal(t, cfg, defaultDispatcher.Load().Config())
+
+	// The package-level functions pick up the new thresholds and still
+	// agree with the scalar loops on either side of them.
+	for _, n := range []int{0, 1, cfg.ASCIIThreshold - 1, cfg.ASCIIThreshold, cfg.SumThreshold + 1, 2000} {
+		data := make([]byte, n)
+		for i := range data {
+			data[i] = byte(i * 7)
+		}
+		var sum uint32
+		ascii := true
+		for _, b := range data {
+			sum += uint32(b)
+			ascii = ascii && b < 0x80
+		}
+		require.Equal(t, sum, SumU8(data), "n=%d", n)
+		require.Equal(t, ascii, IsASCII(data), "n=%d", n)
+		require.True(t, IsASCII(data[:min(n, 18)]), "n=%d", n)
+	}
+}
+
+func TestCalibrateConcurrent(t *testing.T) {
+	prev := defaultDispatcher.Load()
+	t.Cleanup(func() { defaultDispatcher.Store(prev) })
+
+	// Run under -race: concurrent calls share nothing but the atomics.
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			Calibrate()
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkCalibrate(b *testing.B) {
+	prev := defaultDispatcher.Load()
+	b.Cleanup(func() { defaultDispatcher.Store(prev) })
+	for i := 0; i < b.N; i++ {
+		Calibrate()
+	}
+}
@@ -0,0 +1,64 @@
+package algo
+
+import "github.com/miretskiy/simba/pkg/intrinsics"
+
+// caseLUT returns the identity ByteSet with the 26 letters starting at from
+// remapped to the 26 starting at to.
+func caseLUT(from, to byte) *ByteSet {
+	var t ByteSet
+	for i := range t {
+		t[i] = byte(i)
+	}
+	for c := from; c < from+26; c++ {
+		t[c] = c - from + to
+	}
+	return &t
+}
+
+var (
+	// asciiLower maps 'A'..'Z' to 'a'..'z' and every other byte to itself.
+	asciiLower = caseLUT('A', 'a')
+	// asciiUpper maps 'a'..'z' to 'A'..'Z' and every other byte to itself.
+	asciiUpper = caseLUT('a', 'A')
+)
+
+// ToLowerASCII copies src into dst with ASCII upper-case letters folded to
+// lower case and returns the number of bytes written.  Bytes >= 0x80 are
+// copied unchanged, so UTF-8 text keeps its multi-byte sequences intact but
+// non-ASCII letters are not folded.
+//
+// Like MapBytes it follows copy-like semantics: only the first
+// min(len(src), len(dst)) bytes of src are processed and it never panics on a
+// length mismatch.  dst may be src itself for in-place conversion.
+func ToLowerASCII(dst, src []byte) int {
+	return MapBytes(dst, src, asciiLower)
+}
+
+// ToUpperASCII is the upper-case counterpart of ToLowerASCII.
+func ToUpperASCII(dst, src []byte) int {
+	return MapBytes(dst, src, asciiUpper)
+}
+
+// EqualFoldASCII reports whether a and b are equal under ASCII case folding:
+// 'A'..'Z' match 'a'..'z' and every other byte, including those >= 0x80,
+// must be identical.  Unlike bytes.EqualFold it never folds non-ASCII
+// letters, so it suits protocol tokens such as HTTP header names.  Slices of
+// different lengths are never equal.
+//
+// Inputs shorter than the SIMD threshold are compared with a scalar loop
+// over the asciiLower table; longer ones with the IndexDiffFold kernel,
+// which lowers both chunks in registers instead of copying either input.
+func EqualFoldASCII(a, b []byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	if len(a) < simdThreshold {
+		for i := range a {
+			if asciiLower[a[i]] != asciiLower[b[i]] {
+				return false
+			}
+		}
+		return true
+	}
+	return intrinsics.IndexDiffFold(a, b) < 0
+}
@@ -0,0 +1,30 @@
+package algo
+
+import (
+	"bytes"
+	"testing"
+)
+
+func FuzzEqualFoldASCII(f *testing.F) {
+	for _, n := range []int{0, 1, 15, 16, 17, 31, 32, 33, 63, 64, 65, 300} {
+		a := bytes.Repeat([]byte("Accept-Encoding@[`{"), n/19+1)[:n]
+		f.Add(a, upperASCII(a))
+		if n > 0 {
+			b := upperASCII(a)
+			b[n-1] ^= 0x20
+			f.Add(a, b)
+			f.Add(a, b[:n-1])
+		}
+	}
+	f.Add([]byte("\xc9t\xe9"), []byte("\xe9T\xc9"))
+
+	f.Fuzz(func(t *testing.T, a, b []byte) {
+		want := len(a) == len(b)
+		for i := 0; want && i < len(a); i++ {
+			want = asciiLower[a[i]] == asciiLower[b[i]]
+		}
+		if got := EqualFoldASCII(a, b); got != want {
+			t.Fatalf("EqualFoldASCII(%q, %q) = %v, want %v", a, b, got, want)
+		}
+	})
+}
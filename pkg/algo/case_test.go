@@ -0,0 +1,131 @@
+package algo
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToLowerUpperASCII(t *testing.T) {
+	// Every byte value, long enough to take the SIMD path.
+	src := make([]byte, 256)
+	for i := range src {
+		src[i] = byte(i)
+	}
+	lower := make([]byte, len(src))
+	upper := make([]byte, len(src))
+	require.Equal(t, 256, ToLowerASCII(lower, src))
+	require.Equal(t, 256, ToUpperASCII(upper, src))
+	for i := range src {
+		c := byte(i)
+		wantLower, wantUpper := c, c
+		if c >= 'A' && c <= 'Z' {
+			wantLower = c + 'a' - 'A'
+		}
+		if c >= 'a' && c <= 'z' {
+			wantUpper = c - ('a' - 'A')
+		}
+		require.Equal(t, wantLower, lower[i], "lower %#x", c)
+		require.Equal(t, wantUpper, upper[i], "upper %#x", c)
+	}
+
+	// Non-ASCII bytes pass through unchanged.
+	utf := []byte("ÀÉÎ straße ΣΑΣ MIXED case")
+	got := make([]byte, len(utf))
+	ToLowerASCII(got, utf)
+	require.Equal(t, "ÀÉÎ straße ΣΑΣ mixed case", string(got))
+}
+
+func TestToLowerASCIIInPlace(t *testing.T) {
+	for _, n := range []int{0, 5, 15, 16, 17, 64, 1000} {
+		buf := bytes.Repeat([]byte("Hello-WORLD_42\xff"), n/15+1)[:n]
+		want := bytes.Clone(buf)
+		for i, c := range want {
+			if c >= 'A' && c <= 'Z' {
+				want[i] = c + 'a' - 'A'
+			}
+		}
+
+		require.Equal(t, n, ToLowerASCII(buf, buf), "n=%d", n)
+		require.Equal(t, want, buf, "n=%d", n)
+
+		require.Equal(t, n, ToUpperASCII(buf, buf), "n=%d", n)
+		for i, c := range want {
+			if c >= 'a' && c <= 'z' {
+				want[i] = c - ('a' - 'A')
+			}
+		}
+		require.Equal(t, want, buf, "n=%d", n)
+	}
+}
+
+func TestToLowerASCIIShortDst(t *testing.T) {
+	src := []byte("ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+	dst := make([]byte, 20)
+	require.Equal(t, 20, ToLowerASCII(dst, src))
+	require.Equal(t, "abcdefghijklmnopqrst", string(dst))
+
+	require.Equal(t, 0, ToUpperASCII(nil, src))
+	require.Equal(t, 0, ToUpperASCII(dst, nil))
+}
+
+// upperASCII returns a copy of b with ASCII letters upper-cased.  Unlike
+// bytes.ToUpper it keeps invalid UTF-8 byte for byte.
+func upperASCII(b []byte) []byte {
+	out := make([]byte, len(b))
+	ToUpperASCII(out, b)
+	return out
+}
+
+func TestEqualFoldASCII(t *testing.T) {
+	for _, n := range []int{0, 1, 15, 16, 17, 31, 32, 33, 63, 64, 65, 1000} {
+		a := bytes.Repeat([]byte("Content-Type_42\xc3"), n/16+1)[:n]
+		b := upperASCII(a)
+		require.True(t, EqualFoldASCII(a, b), "n=%d", n)
+		require.True(t, EqualFoldASCII(b, a), "n=%d", n)
+		if n == 0 {
+			continue
+		}
+		require.False(t, EqualFoldASCII(a, b[:n-1]), "length mismatch n=%d", n)
+		for _, at := range []int{0, n / 2, n - 1} {
+			c := bytes.Clone(b)
+			c[at] ^= 0x40
+			require.False(t, EqualFoldASCII(a, c), "n=%d at=%d", n, at)
+		}
+	}
+
+	// Only ASCII letters fold: pairs one bit 5 apart that are not letters
+	// stay distinct, on both the scalar and the SIMD path.
+	for _, pair := range [][2]byte{{'@', '`'}, {'[', '{'}, {'^', '~'}, {0xc9, 0xe9}} {
+		for _, n := range []int{1, 64} {
+			x, y := bytes.Repeat(pair[:1], n), bytes.Repeat(pair[1:], n)
+			require.False(t, EqualFoldASCII(x, y), "%q vs %q n=%d", pair[0], pair[1], n)
+		}
+	}
+	// bytes.EqualFold folds the Kelvin sign to 'k'; EqualFoldASCII does not.
+	require.True(t, bytes.EqualFold([]byte("\u212a"), []byte("k")))
+	require.False(t, EqualFoldASCII([]byte("\u212a"), []byte("k\x00\x00")))
+}
+
+var equalFoldSink bool
+
+func BenchmarkEqualFoldASCII(b *testing.B) {
+	for _, n := range []int{12, 16, 32, 64, 256, 4096} {
+		x := bytes.Repeat([]byte("x-request-id"), n/12+1)[:n]
+		y := upperASCII(x)
+		b.Run(fmt.Sprintf("BytesEqualFold_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				equalFoldSink = bytes.EqualFold(x, y)
+			}
+		})
+		b.Run(fmt.Sprintf("Algo_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				equalFoldSink = EqualFoldASCII(x, y)
+			}
+		})
+	}
+}
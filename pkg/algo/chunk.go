@@ -1,5 +1,11 @@
 package algo
 
+import (
+	"math/bits"
+
+	"github.com/miretskiy/simba/pkg/intrinsics"
+)
+
 // Each64 invokes fn for every full 64-byte chunk in b and returns the tail.
 // It is designed to be inlined and incur zero overhead.
 func Each64(b []byte, fn func(chunk []byte)) (tail []byte) {
@@ -27,3 +33,133 @@ func Each16(b []byte, fn func(chunk []byte)) (tail []byte) {
 	}
 	return b
 }
+
+// EachN invokes fn for every full n-byte chunk in b, in order, and returns
+// the tail of fewer than n bytes; a b shorter than n is all tail.  It is the
+// general form of Each16/32/64 for chunk sizes chosen at run time, e.g. 48 or
+// 128 bytes when experimenting.  The constant-size helpers remain the ones to
+// use on hot paths, where the fixed size lets the compiler inline them and
+// drop bounds checks.  n must be positive.
+func EachN(b []byte, n int, fn func(chunk []byte)) (tail []byte) {
+	if n <= 0 {
+		panic("algo: EachN n must be positive")
+	}
+	for len(b) >= n {
+		fn(b[:n])
+		b = b[n:]
+	}
+	return b
+}
+
+// LaneWidth selects the chunk size of Reduce; it is intrinsics.LaneWidth.
+type LaneWidth = intrinsics.LaneWidth
+
+// The lane widths Reduce accepts.
+const (
+	Lane16 = intrinsics.Lane16
+	Lane32 = intrinsics.Lane32
+	Lane64 = intrinsics.Lane64
+)
+
+// Reduce folds data chunk by chunk: perChunk maps every full w-byte chunk,
+// in order, to a value and combine folds it into an accumulator starting at
+// zero.  The tail of fewer than w bytes, if any, is passed to perChunk last,
+// so perChunk must accept short chunks and the whole of data is covered.
+// Empty data returns zero.  It is the value-returning counterpart of the
+// Each helpers, which it uses for the chunking; w must be Lane16, Lane32
+// or Lane64, otherwise Reduce panics.
+//
+// perChunk and combine are called through function values, which the
+// compiler does not inline here: on 64-byte chunks of a scalar sum that
+// costs about a quarter of the throughput of a hand-written Each64 loop, and
+// proportionally less as perChunk does more work.
+func Reduce[T any](data []byte, w LaneWidth, perChunk func(chunk []byte) T, combine func(a, b T) T, zero T) T {
+	acc := zero
+	fold := func(chunk []byte) { acc = combine(acc, perChunk(chunk)) }
+	var tail []byte
+	switch w {
+	case Lane16:
+		tail = Each16(data, fold)
+	case Lane32:
+		tail = Each32(data, fold)
+	case Lane64:
+		tail = Each64(data, fold)
+	default:
+		panic("algo: unsupported LaneWidth " + w.String())
+	}
+	if len(tail) > 0 {
+		fold(tail)
+	}
+	return acc
+}
+
+// SplitLanes splits b into the longest prefix that is a whole number of
+// lane-byte chunks and the remaining tail, i.e. the
+//
+//	full := len(b) / lane * lane
+//
+// idiom every custom kernel loop needs.  lane must be positive.
+func SplitLanes(b []byte, lane int) (full, tail []byte) {
+	if lane <= 0 {
+		panic("algo: SplitLanes lane must be positive")
+	}
+	n := len(b) / lane * lane
+	return b[:n:n], b[n:]
+}
+
+// SplitByte classifies splitFirstBlock bytes first and doubles the block up
+// to splitBlock, so a callback that stops early wastes at most a few hundred
+// classified bytes; 4 KiB keeps the mask array (64 words) on the stack.
+const (
+	splitFirstBlock = 256
+	splitBlock      = 4096
+)
+
+// SplitByte calls fn for each sep-separated field of data, in order, without
+// allocating; it stops early when fn returns false.  As with bytes.Split, a
+// trailing separator yields a final empty field and data without sep is a
+// single field, but empty data yields no fields at all.  Each field is capped
+// at its own length, so appending to it cannot overwrite the rest of data.
+//
+// Inputs of simdThreshold bytes or more are classified once with the 64-lane
+// equality-mask kernel and the fields are emitted by walking the set bits of
+// each 64-byte window's mask; the bytes past the last whole chunk are checked
+// with a scalar loop.  This walk replaces one IndexByte call per field:
+// IndexByte classifies a whole block to find one separator, so with short
+// fields most bytes would be classified many times over.  Blocks start at
+// 256 bytes and double up to 4 KiB, which bounds the bytes classified but
+// never emitted when fn stops early.
+func SplitByte(data []byte, sep byte, fn func(field []byte) bool) {
+	if len(data) == 0 {
+		return
+	}
+	start := 0 // offset of the current field
+	emit := func(end int) bool {
+		ok := fn(data[start:end:end])
+		start = end + 1
+		return ok
+	}
+
+	full := len(data) &^ 63
+	if len(data) < simdThreshold {
+		full = 0
+	}
+	var masks [splitBlock / 64]uint64
+	for base, size := 0, splitFirstBlock; base < full; base, size = base+size, min(2*size, splitBlock) {
+		block := data[base:min(base+size, full)]
+		intrinsics.EqU8Masks64(block, sep, masks[:])
+		for w, m := range masks[:len(block)/64] {
+			for ; m != 0; m &= m - 1 {
+				if !emit(base + w*64 + bits.TrailingZeros64(m)) {
+					return
+				}
+			}
+		}
+	}
+	for i := full; i < len(data); i++ {
+		if data[i] == sep && !emit(i) {
+			return
+		}
+	}
+	fn(data[start:len(data):len(data)])
+}
@@ -1,6 +1,9 @@
 package algo
 
 import (
+	"bytes"
+	"math/rand"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -18,3 +21,220 @@ func TestEachHelpers(t *testing.T) {
 	require.Equal(t, 0, c16, "16-chunk count")
 	require.Equal(t, 4, len(tail), "tail length")
 }
+
+func TestEachN(t *testing.T) {
+	data := make([]byte, 300)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	for _, n := range []int{1, 7, 16, 48, 64, 100, 128, 299, 300, 301, 1000} {
+		var chunks [][]byte
+		tail := EachN(data, n, func(chunk []byte) { chunks = append(chunks, chunk) })
+		require.Len(t, chunks, len(data)/n, "n=%d", n)
+		for _, c := range chunks {
+			require.Len(t, c, n, "n=%d", n)
+		}
+		require.Len(t, tail, len(data)%n, "n=%d", n)
+		// The chunks and the tail cover data, in order.
+		require.Equal(t, data, append(bytes.Join(chunks, nil), tail...), "n=%d", n)
+	}
+
+	// A buffer shorter than n is returned whole, without calling fn.
+	short := data[:10]
+	tail := EachN(short, 48, func([]byte) { t.Fatal("fn called on a short buffer") })
+	require.Equal(t, short, tail)
+	require.Empty(t, EachN(nil, 48, func([]byte) {}))
+
+	// The fixed-size helpers agree with EachN.
+	for _, pair := range []struct {
+		each func([]byte, func([]byte)) []byte
+		n    int
+	}{{Each16, 16}, {Each32, 32}, {Each64, 64}} {
+		var got, want int
+		require.Equal(t, EachN(data, pair.n, func([]byte) { want++ }), pair.each(data, func([]byte) { got++ }))
+		require.Equal(t, want, got, "n=%d", pair.n)
+	}
+
+	require.Panics(t, func() { EachN(data, 0, func([]byte) {}) })
+	require.Panics(t, func() { EachN(data, -1, func([]byte) {}) })
+}
+
+func sumChunk(chunk []byte) uint32 {
+	var s uint32
+	for _, b := range chunk {
+		s += uint32(b)
+	}
+	return s
+}
+
+func addU32(a, b uint32) uint32 { return a + b }
+
+func TestReduce(t *testing.T) {
+	r := rand.New(rand.NewSource(1556))
+	for _, n := range []int{0, 1, 15, 16, 17, 63, 64, 65, 100, 1000} {
+		data := make([]byte, n)
+		r.Read(data)
+		for _, w := range []LaneWidth{Lane16, Lane32, Lane64} {
+			require.Equal(t, SumU8(data), Reduce(data, w, sumChunk, addU32, 0), "n=%d w=%d", n, w)
+
+			// Chunks arrive in order, full ones first, then the tail.
+			lens := Reduce(data, w, func(c []byte) []int { return []int{len(c)} },
+				func(a, b []int) []int { return append(a, b...) }, nil)
+			var want []int
+			for i := 0; i < n/int(w); i++ {
+				want = append(want, int(w))
+			}
+			if n%int(w) != 0 {
+				want = append(want, n%int(w))
+			}
+			require.Equal(t, want, lens, "n=%d w=%d", n, w)
+		}
+
+		minU8 := func(c []byte) byte { return slices.Min(c) }
+		got := Reduce(data, Lane64, minU8, func(a, b byte) byte { return min(a, b) }, 0xFF)
+		if want, ok := MinU8(data); ok {
+			require.Equal(t, want, got, "n=%d min", n)
+		}
+	}
+	require.Panics(t, func() { Reduce([]byte("x"), 8, sumChunk, addU32, 0) })
+}
+
+func BenchmarkReduce(b *testing.B) {
+	data := make([]byte, 4096)
+	rand.New(rand.NewSource(1)).Read(data)
+	b.Run("Reduce", func(b *testing.B) {
+		b.SetBytes(int64(len(data)))
+		for i := 0; i < b.N; i++ {
+			Reduce(data, Lane64, sumChunk, addU32, 0)
+		}
+	})
+	b.Run("loop", func(b *testing.B) {
+		b.SetBytes(int64(len(data)))
+		for i := 0; i < b.N; i++ {
+			var s uint32
+			tail := Each64(data, func(c []byte) { s += sumChunk(c) })
+			s += sumChunk(tail)
+		}
+	})
+	b.Run("SumU8", func(b *testing.B) {
+		b.SetBytes(int64(len(data)))
+		for i := 0; i < b.N; i++ {
+			SumU8(data)
+		}
+	})
+}
+
+func TestSplitLanes(t *testing.T) {
+	for _, lane := range []int{16, 32, 64} {
+		for _, n := range []int{0, lane - 1, lane, lane + 1, 2*lane - 1, 2 * lane, 2*lane + 1} {
+			data := make([]byte, n)
+			full, tail := SplitLanes(data, lane)
+			require.Equal(t, n/lane*lane, len(full), "lane=%d n=%d full", lane, n)
+			require.Equal(t, n%lane, len(tail), "lane=%d n=%d tail", lane, n)
+			require.Equal(t, len(full), cap(full), "full must not alias the tail via append")
+		}
+	}
+	require.Panics(t, func() { SplitLanes(nil, 0) })
+}
+
+func splitByteFields(data []byte, sep byte) [][]byte {
+	var out [][]byte
+	SplitByte(data, sep, func(field []byte) bool {
+		out = append(out, field)
+		return true
+	})
+	return out
+}
+
+func TestSplitByte(t *testing.T) {
+	require.Empty(t, splitByteFields(nil, ','), "empty input")
+	require.Equal(t, [][]byte{[]byte("abc")}, splitByteFields([]byte("abc"), ','), "no separator")
+	require.Equal(t, [][]byte{[]byte("a"), []byte("b"), {}}, splitByteFields([]byte("a,b,"), ','), "trailing separator")
+	require.Equal(t, [][]byte{{}, {}}, splitByteFields([]byte(","), ','))
+
+	// Matches bytes.Split on longer inputs that take the SIMD path.
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 15, 16, 64, 100, 1000, 5000} {
+		data := make([]byte, n)
+		for i := range data {
+			data[i] = "abcdefgh\n"[r.Intn(9)]
+		}
+		require.Equal(t, bytes.Split(data, []byte{'\n'}), splitByteFields(data, '\n'), "n=%d", n)
+	}
+
+	// Separators at every position of a 64-byte window, across the growing
+	// block boundaries (256, 768, 1792, 3840 bytes, then every 4 KiB) and in
+	// the scalar tail, with empty fields between adjacent ones.
+	for _, n := range []int{63, 64, 65, 127, 128, splitFirstBlock - 1, splitFirstBlock, splitFirstBlock + 1, 769, 1792, 3841, splitBlock - 1, splitBlock, splitBlock + 1, 2*splitBlock + 70} {
+		for _, period := range []int{1, 2, 63, 64, 65} {
+			data := bytes.Repeat([]byte{'x'}, n)
+			for i := period - 1; i < n; i += period {
+				data[i] = ','
+			}
+			require.Equal(t, bytes.Split(data, []byte{','}), splitByteFields(data, ','), "n=%d period=%d", n, period)
+		}
+	}
+
+	// Stopping inside a mask word emits nothing further.
+	long := bytes.Repeat([]byte("ab,"), 100)
+	var count int
+	SplitByte(long, ',', func([]byte) bool {
+		count++
+		return count < 30
+	})
+	require.Equal(t, 30, count)
+
+	// Stops after the callback returns false.
+	var seen []string
+	SplitByte([]byte("a,b,c,d"), ',', func(field []byte) bool {
+		seen = append(seen, string(field))
+		return len(seen) < 2
+	})
+	require.Equal(t, []string{"a", "b"}, seen)
+
+	// Fields are capped so append cannot clobber the following bytes.
+	data := []byte("a,b")
+	SplitByte(data, ',', func(field []byte) bool {
+		_ = append(field, 'X')
+		return true
+	})
+	require.Equal(t, "a,b", string(data))
+
+	// The last field is capped too: spare capacity past len(data) belongs to
+	// the caller.
+	buf := []byte("a,bYZ")
+	SplitByte(buf[:3], ',', func(field []byte) bool {
+		_ = append(field, 'X')
+		return true
+	})
+	require.Equal(t, "a,bYZ", string(buf))
+}
+
+func benchmarkLines() []byte {
+	var b bytes.Buffer
+	r := rand.New(rand.NewSource(1))
+	for b.Len() < 1<<20 {
+		b.Write(bytes.Repeat([]byte{'x'}, 20+r.Intn(100)))
+		b.WriteByte('\n')
+	}
+	return b.Bytes()
+}
+
+func BenchmarkSplitByte(b *testing.B) {
+	data := benchmarkLines()
+	b.Run("simba", func(b *testing.B) {
+		b.SetBytes(int64(len(data)))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			n := 0
+			SplitByte(data, '\n', func([]byte) bool { n++; return true })
+		}
+	})
+	b.Run("bytes.Split", func(b *testing.B) {
+		b.SetBytes(int64(len(data)))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = bytes.Split(data, []byte{'\n'})
+		}
+	})
+}
@@ -0,0 +1,58 @@
+package algo
+
+import (
+	"bytes"
+
+	"github.com/miretskiy/simba/pkg/intrinsics"
+)
+
+// prefixThreshold is the prefix length at which HasPrefix and HasSuffix hand
+// the comparison to the IndexDiff kernel.  The runtime's memequal behind
+// bytes.HasPrefix is already vectorised, so the kernel only wins once the
+// trampoline cost is amortised; BenchmarkHasPrefix shows the crossover.
+const prefixThreshold = 256
+
+// Compare returns the offset of the first byte at which a and b differ, or
+// -1 if they agree on their first min(len(a), len(b)) bytes.  Unlike
+// bytes.Compare it reports where two buffers diverge rather than how they
+// order: a result of -1 with len(a) != len(b) means the shorter slice is a
+// prefix of the longer one.  Inputs shorter than the SIMD threshold are
+// compared with a scalar loop.
+func Compare(a, b []byte) int {
+	n := min(len(a), len(b))
+	if n < simdThreshold {
+		for i := 0; i < n; i++ {
+			if a[i] != b[i] {
+				return i
+			}
+		}
+		return -1
+	}
+	return intrinsics.IndexDiff(a, b)
+}
+
+// HasPrefix reports whether data begins with prefix, like bytes.HasPrefix.
+// An empty prefix always matches and a prefix longer than data never does.
+// Prefixes shorter than prefixThreshold use bytes.HasPrefix; longer ones
+// compare the overlapping region with the IndexDiff kernel.
+func HasPrefix(data, prefix []byte) bool {
+	if len(prefix) > len(data) {
+		return false
+	}
+	if len(prefix) < prefixThreshold {
+		return bytes.HasPrefix(data, prefix)
+	}
+	return intrinsics.IndexDiff(data[:len(prefix)], prefix) < 0
+}
+
+// HasSuffix reports whether data ends with suffix, like bytes.HasSuffix, with
+// the same edge cases and threshold as HasPrefix.
+func HasSuffix(data, suffix []byte) bool {
+	if len(suffix) > len(data) {
+		return false
+	}
+	if len(suffix) < prefixThreshold {
+		return bytes.HasSuffix(data, suffix)
+	}
+	return intrinsics.IndexDiff(data[len(data)-len(suffix):], suffix) < 0
+}
@@ -0,0 +1,58 @@
+package algo
+
+import (
+	"bytes"
+	"testing"
+)
+
+func FuzzCompare(f *testing.F) {
+	for _, n := range []int{0, 1, 15, 16, 17, 63, 64, 65, 130} {
+		a := bytes.Repeat([]byte("z"), n)
+		f.Add(a, bytes.Clone(a))
+		if n > 0 {
+			b := bytes.Clone(a)
+			b[n-1] = 'y'
+			f.Add(a, b)
+			f.Add(a, b[:n/2])
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, a, b []byte) {
+		want := -1
+		for i := 0; i < min(len(a), len(b)); i++ {
+			if a[i] != b[i] {
+				want = i
+				break
+			}
+		}
+		if got := Compare(a, b); got != want {
+			t.Fatalf("Compare(len=%d, len=%d) = %d, want %d", len(a), len(b), got, want)
+		}
+	})
+}
+
+func FuzzHasPrefixSuffix(f *testing.F) {
+	for _, n := range []int{0, 1, 255, 256, 257, 300, 1000} {
+		a := bytes.Repeat([]byte("k"), n)
+		f.Add(a, bytes.Clone(a))
+		f.Add(append(bytes.Clone(a), "tail"...), a)
+		if n > 0 {
+			b := bytes.Clone(a)
+			b[0] = 'x'
+			f.Add(a, b)
+			b = bytes.Clone(a)
+			b[n-1] = 'x'
+			f.Add(a, b)
+			f.Add(a[:n-1], a)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, data, affix []byte) {
+		if got, want := HasPrefix(data, affix), bytes.HasPrefix(data, affix); got != want {
+			t.Fatalf("HasPrefix(len=%d, len=%d) = %v, want %v", len(data), len(affix), got, want)
+		}
+		if got, want := HasSuffix(data, affix), bytes.HasSuffix(data, affix); got != want {
+			t.Fatalf("HasSuffix(len=%d, len=%d) = %v, want %v", len(data), len(affix), got, want)
+		}
+	})
+}
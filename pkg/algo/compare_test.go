@@ -0,0 +1,99 @@
+package algo
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/miretskiy/simba/pkg/intrinsics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompare(t *testing.T) {
+	require.Equal(t, -1, Compare(nil, nil))
+	require.Equal(t, -1, Compare([]byte("abc"), []byte("abcdef")), "prefix")
+	require.Equal(t, -1, Compare([]byte("abcdef"), nil), "empty is a prefix")
+
+	for _, n := range []int{1, 15, 16, 17, 31, 32, 33, 63, 64, 65, 200} {
+		a := bytes.Repeat([]byte{'q'}, n)
+		require.Equal(t, -1, Compare(a, bytes.Clone(a)), "n=%d equal", n)
+		// A first difference at every offset, including the tail past the
+		// last full lane, with a later difference that must not win.
+		for at := 0; at < n; at++ {
+			b := bytes.Clone(a)
+			b[at] = 'x'
+			b[n-1] = 'y'
+			require.Equal(t, at, Compare(a, b), "n=%d at=%d", n, at)
+			require.Equal(t, at, Compare(append(b, "longer"...), a), "n=%d at=%d mismatched lengths", n, at)
+		}
+	}
+}
+
+func TestHasPrefixSuffix(t *testing.T) {
+	require.True(t, HasPrefix(nil, nil))
+	require.True(t, HasSuffix([]byte("abc"), nil))
+	require.False(t, HasPrefix([]byte("ab"), []byte("abc")))
+	require.False(t, HasSuffix([]byte("bc"), []byte("abc")))
+
+	for _, n := range []int{1, 255, 256, 257, 1000} {
+		key := bytes.Repeat([]byte("p"), n)
+		data := append(append([]byte("head"), key...), key...)
+		require.True(t, HasPrefix(data[4:], key), "n=%d", n)
+		require.True(t, HasSuffix(data, key), "n=%d", n)
+		require.False(t, HasPrefix(key, append(bytes.Clone(key), 'p')), "n=%d longer", n)
+		for _, at := range []int{0, n / 2, n - 1} {
+			bad := bytes.Clone(key)
+			bad[at] = 'x'
+			require.False(t, HasPrefix(data[4:], bad), "n=%d at=%d", n, at)
+			require.False(t, HasSuffix(data, bad), "n=%d at=%d", n, at)
+		}
+	}
+}
+
+var compareSink int
+
+func BenchmarkCompareEqual(b *testing.B) {
+	for _, n := range []int{64, 1024, 65536} {
+		x := bytes.Repeat([]byte("0123456789abcdef"), n/16)
+		y := bytes.Clone(x)
+		b.Run(fmt.Sprintf("BytesCompare_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				compareSink = bytes.Compare(x, y)
+			}
+		})
+		b.Run(fmt.Sprintf("Algo_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				compareSink = Compare(x, y)
+			}
+		})
+	}
+}
+
+var prefixSink bool
+
+func BenchmarkHasPrefix(b *testing.B) {
+	for _, n := range []int{16, 64, 128, 256, 512, 4096} {
+		prefix := bytes.Repeat([]byte("0123456789abcdef"), n/16)
+		data := append(bytes.Clone(prefix), "suffix"...)
+		b.Run(fmt.Sprintf("Bytes_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				prefixSink = bytes.HasPrefix(data, prefix)
+			}
+		})
+		b.Run(fmt.Sprintf("Kernel_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				prefixSink = intrinsics.IndexDiff(data[:n], prefix) < 0
+			}
+		})
+		b.Run(fmt.Sprintf("Algo_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				prefixSink = HasPrefix(data, prefix)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package algo
+
+import (
+	"hash/crc32"
+	"sync/atomic"
+
+	"github.com/miretskiy/simba/pkg/intrinsics"
+)
+
+// Config holds the input lengths, in bytes, at which the algo helpers switch
+// from their scalar loops to the SIMD kernels.  The package defaults are
+// tuned on Apple M-series silicon; Calibrate measures the IsASCII and SumU8
+// crossovers on the running machine, and a deployment can pin its own
+// values with NewWith instead of rebuilding.
+// A zero field keeps the package default; 1 sends every non-empty input to
+// the kernels, and a very large value keeps everything scalar.
+type Config struct {
+	// ASCIIThreshold is the crossover for IsASCII.
+	ASCIIThreshold int
+	// SumThreshold is the crossover for SumU8.
+	SumThreshold int
+	// CRC32Threshold is the crossover for CRC32 and CRC32Update.
+	CRC32Threshold int
+	// LUTThreshold is the crossover for AllBytesInSet.
+	LUTThreshold int
+}
+
+// DefaultConfig returns the built-in thresholds.  The package-level functions
+// use them until Calibrate replaces the IsASCII and SumU8 crossovers.
+func DefaultConfig() Config {
+	return Config{
+		ASCIIThreshold: asciiThreshold,
+		SumThreshold:   simdThreshold,
+		CRC32Threshold: crc32Threshold,
+		LUTThreshold:   simdLUTThreshold,
+	}
+}
+
+// Dispatcher runs the algo helpers with the thresholds of a Config.  The
+// package-level IsASCII, SumU8, CRC32, CRC32Update and AllBytesInSet are the
+// methods of the package Dispatcher, built from DefaultConfig and swapped
+// out by Calibrate.  A Dispatcher is immutable and safe for concurrent use.
+type Dispatcher struct {
+	cfg Config
+}
+
+// defaultDispatcher is the Dispatcher behind the package-level functions.
+// It is set by a variable initializer, so it is in place before any init
+// function runs.  Calibrate replaces it wholesale, so a call sees either the
+// old thresholds or the new ones, never a mix.
+var defaultDispatcher = newDispatcherPointer(NewWith(Config{}))
+
+// newDispatcherPointer returns an atomic pointer holding d.
+func newDispatcherPointer(d *Dispatcher) *atomic.Pointer[Dispatcher] {
+	p := new(atomic.Pointer[Dispatcher])
+	p.Store(d)
+	return p
+}
+
+// NewWith returns a Dispatcher using the thresholds in cfg, with zero fields
+// replaced by the package defaults.
+func NewWith(cfg Config) *Dispatcher {
+	def := DefaultConfig()
+	if cfg.ASCIIThreshold == 0 {
+		cfg.ASCIIThreshold = def.ASCIIThreshold
+	}
+	if cfg.SumThreshold == 0 {
+		cfg.SumThreshold = def.SumThreshold
+	}
+	if cfg.CRC32Threshold == 0 {
+		cfg.CRC32Threshold = def.CRC32Threshold
+	}
+	if cfg.LUTThreshold == 0 {
+		cfg.LUTThreshold = def.LUTThreshold
+	}
+	return &Dispatcher{cfg: cfg}
+}
+
+// Config returns the thresholds d uses, defaults filled in.
+func (d *Dispatcher) Config() Config {
+	return d.cfg
+}
+
+// IsASCII reports whether every byte of data is below 0x80; see IsASCII.
+func (d *Dispatcher) IsASCII(data []byte) bool {
+	if len(data) < d.cfg.ASCIIThreshold {
+		for _, b := range data {
+			if b&0x80 != 0 {
+				return false
+			}
+		}
+		return true
+	}
+	return intrinsics.IsASCII(data)
+}
+
+// SumU8 adds all bytes of data modulo 2^32; see SumU8.
+func (d *Dispatcher) SumU8(data []byte) uint32 {
+	if len(data) < d.cfg.SumThreshold {
+		var acc uint32
+		for _, b := range data {
+			acc += uint32(b)
+		}
+		return acc
+	}
+	return intrinsics.SumU8(data)
+}
+
+// CRC32 returns the Castagnoli CRC32C of data; see CRC32.
+func (d *Dispatcher) CRC32(data []byte) uint32 {
+	return d.CRC32Update(data, 0)
+}
+
+// CRC32Update extends a CRC32C value with data; see CRC32Update.
+func (d *Dispatcher) CRC32Update(data []byte, init uint32) uint32 {
+	if len(data) < d.cfg.CRC32Threshold {
+		return crc32.Update(init, castagnoliTable, data)
+	}
+	return intrinsics.Crc32Update(data, init)
+}
+
+// AllBytesInSet reports whether every byte of data is a member of lut; see
+// AllBytesInSet.
+func (d *Dispatcher) AllBytesInSet(data []byte, lut *ByteSet) bool {
+	if len(data) < d.cfg.LUTThreshold {
+		for _, b := range data {
+			if (*lut)[b] == 0 {
+				return false
+			}
+		}
+		return true
+	}
+	return intrinsics.AllBytesInSet(data, (*[256]byte)(lut))
+}
@@ -0,0 +1,44 @@
+package algo
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWithDefaults(t *testing.T) {
+	require.Equal(t, DefaultConfig(), NewWith(Config{}).Config())
+
+	// Start-up calibration only moves the IsASCII and SumU8 crossovers.
+	active := defaultDispatcher.Load().Config()
+	active.ASCIIThreshold, active.SumThreshold = asciiThreshold, simdThreshold
+	require.Equal(t, DefaultConfig(), active)
+
+	cfg := NewWith(Config{CRC32Threshold: 4096}).Config()
+	require.Equal(t, 4096, cfg.CRC32Threshold)
+	require.Equal(t, asciiThreshold, cfg.ASCIIThreshold)
+}
+
+func TestDispatcherThresholds(t *testing.T) {
+	simd := NewWith(Config{ASCIIThreshold: 1, SumThreshold: 1, CRC32Threshold: 1, LUTThreshold: 1})
+	scalar := NewWith(Config{ASCIIThreshold: 1 << 30, SumThreshold: 1 << 30, CRC32Threshold: 1 << 30, LUTThreshold: 1 << 30})
+	digits := MakeByteSet('0', '1', '2', '3', '4', '5', '6', '7', '8', '9')
+
+	r := rand.New(rand.NewSource(22))
+	for _, n := range []int{0, 1, 15, 31, 64, 1000, 5000} {
+		data := make([]byte, n)
+		r.Read(data)
+		text := bytes.Repeat([]byte("0123456789"), n/10+1)[:n]
+		for _, d := range []*Dispatcher{simd, scalar} {
+			require.Equal(t, IsASCII(data), d.IsASCII(data), "n=%d", n)
+			require.Equal(t, IsASCII(text), d.IsASCII(text), "n=%d", n)
+			require.Equal(t, SumU8(data), d.SumU8(data), "n=%d", n)
+			require.Equal(t, CRC32(data), d.CRC32(data), "n=%d", n)
+			require.Equal(t, CRC32Update(data, 0xDEADBEEF), d.CRC32Update(data, 0xDEADBEEF), "n=%d", n)
+			require.Equal(t, AllBytesInSet(text, digits), d.AllBytesInSet(text, digits), "n=%d", n)
+			require.Equal(t, AllBytesInSet(data, digits), d.AllBytesInSet(data, digits), "n=%d", n)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+//go:build simba_trace
+
+package algo
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/miretskiy/simba/internal/ffi"
+	"github.com/stretchr/testify/require"
+)
+
+// kernelCalls runs fn with a trace sink installed and returns the kernels it
+// entered.
+func kernelCalls(fn func()) []string {
+	var calls []string
+	ffi.SetTraceSink(func(kernel string, _, _ uintptr) {
+		calls = append(calls, kernel)
+	})
+	defer ffi.SetTraceSink(nil)
+	fn()
+	return calls
+}
+
+func TestDispatcherPathSelection(t *testing.T) {
+	simd := NewWith(Config{ASCIIThreshold: 1, SumThreshold: 1, CRC32Threshold: 1, LUTThreshold: 1})
+	scalar := NewWith(Config{ASCIIThreshold: 1 << 30, SumThreshold: 1 << 30, CRC32Threshold: 1 << 30, LUTThreshold: 1 << 30})
+	digits := MakeByteSet('0', '1', '2', '3', '4', '5', '6', '7', '8', '9')
+
+	for _, n := range []int{8, 4096} {
+		data := bytes.Repeat([]byte("0123456789"), n/10+1)[:n]
+		for _, tc := range []struct {
+			name string
+			call func(d *Dispatcher)
+		}{
+			{"IsASCII", func(d *Dispatcher) { d.IsASCII(data) }},
+			{"SumU8", func(d *Dispatcher) { d.SumU8(data) }},
+			{"CRC32", func(d *Dispatcher) { d.CRC32(data) }},
+			{"AllBytesInSet", func(d *Dispatcher) { d.AllBytesInSet(data, digits) }},
+		} {
+			require.NotEmpty(t, kernelCalls(func() { tc.call(simd) }), "%s n=%d: low threshold must use SIMD", tc.name, n)
+			require.Empty(t, kernelCalls(func() { tc.call(scalar) }), "%s n=%d: huge threshold must stay scalar", tc.name, n)
+		}
+	}
+}
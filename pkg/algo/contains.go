@@ -0,0 +1,51 @@
+package algo
+
+import "github.com/miretskiy/simba/pkg/intrinsics"
+
+// ContainsAnyByte reports whether any byte of data is a member of set, the
+// dual of AllBytesInSet.  The SIMD scan stops at the first chunk holding a
+// member; inputs shorter than the LUT threshold use a scalar loop.  Empty
+// input returns false.
+func ContainsAnyByte(data []byte, set *ByteSet) bool {
+	if len(data) < simdLUTThreshold {
+		for _, b := range data {
+			if (*set)[b] != 0 {
+				return true
+			}
+		}
+		return false
+	}
+	return intrinsics.ContainsAnyByte(data, (*[256]byte)(set))
+}
+
+// ContainsByte reports whether b occurs in data.  It is IndexByte(data, b)
+// >= 0, so the scan stops at the first occurrence.
+func ContainsByte(data []byte, b byte) bool {
+	return IndexByte(data, b) >= 0
+}
+
+// ContainsASCIIByte is ContainsByte restricted to ASCII needles, the byte
+// analogue of bytes.ContainsRune for runes < 0x80.  It panics if b is not
+// ASCII.
+func ContainsASCIIByte(data []byte, b byte) bool {
+	if b >= 0x80 {
+		panic("algo: ContainsASCIIByte needle is not ASCII")
+	}
+	return ContainsByte(data, b)
+}
+
+// ContainsAnyASCII reports whether data contains any of the bytes in chars,
+// like bytes.ContainsAny restricted to ASCII.  chars must be pure ASCII: a
+// byte >= 0x80 would only match part of a UTF-8 sequence, so it panics
+// rather than silently matching stray continuation bytes.
+func ContainsAnyASCII(data []byte, chars string) bool {
+	var set ByteSet
+	for i := 0; i < len(chars); i++ {
+		c := chars[i]
+		if c >= 0x80 {
+			panic("algo: ContainsAnyASCII chars must be ASCII")
+		}
+		set[c] = 1
+	}
+	return ContainsAnyByte(data, &set)
+}
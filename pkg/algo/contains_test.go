@@ -0,0 +1,92 @@
+package algo
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/miretskiy/simba/pkg/intrinsics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContainsAnyASCII(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	charsets := []string{"", ",", ",;|", "\t\n\r ", "xyz"}
+	for _, n := range []int{0, 1, 15, 16, 17, 31, 32, 64, 65, 300} {
+		data := make([]byte, n)
+		for i := range data {
+			data[i] = byte('a' + r.Intn(20)) // never x, y or z
+		}
+		for _, chars := range charsets {
+			require.Equal(t, bytes.ContainsAny(data, chars), ContainsAnyASCII(data, chars), "n=%d chars=%q", n, chars)
+		}
+		if n > 0 {
+			// A single hit in the last byte (tail of every lane width).
+			data[n-1] = '|'
+			require.True(t, ContainsAnyASCII(data, ",;|"), "n=%d tail hit", n)
+			require.True(t, ContainsASCIIByte(data, '|'), "n=%d tail hit", n)
+			require.False(t, ContainsASCIIByte(data, '#'), "n=%d", n)
+		}
+	}
+
+	require.Panics(t, func() { ContainsAnyASCII([]byte("abc"), "é") })
+	require.Panics(t, func() { ContainsASCIIByte([]byte("abc"), 0xC3) })
+}
+
+func TestContainsByte(t *testing.T) {
+	data := bytes.Repeat([]byte{0xFF}, 100)
+	require.False(t, ContainsByte(data, 0))
+	data[70] = 0
+	require.True(t, ContainsByte(data, 0))
+	require.False(t, ContainsByte(nil, 0))
+}
+
+func TestContainsAnyByte(t *testing.T) {
+	ctrl := MakeByteSet(0x00, 0x07, 0x1B, 0x7F)
+	require.False(t, ContainsAnyByte(nil, ctrl))
+	require.False(t, ContainsAnyByte([]byte{}, ctrl))
+
+	for _, n := range []int{1, 15, 16, 17, 31, 33, 63, 64, 65, 100, 255, 256, 257, 1000} {
+		data := bytes.Repeat([]byte("plain text "), n/11+1)[:n]
+		require.False(t, ContainsAnyByte(data, ctrl), "n=%d", n)
+		require.False(t, intrinsics.ContainsAnyByte(data, ctrl), "n=%d", n)
+
+		// Single hits at the head, in the middle and across the last 17
+		// bytes, which covers the len%lane tail of every kernel width.
+		positions := []int{0, n / 2}
+		for p := max(0, n-17); p < n; p++ {
+			positions = append(positions, p)
+		}
+		for _, p := range positions {
+			hit := append([]byte(nil), data...)
+			hit[p] = 0x1B
+			require.True(t, ContainsAnyByte(hit, ctrl), "n=%d hit at %d", n, p)
+			require.True(t, intrinsics.ContainsAnyByte(hit, ctrl), "n=%d hit at %d", n, p)
+		}
+	}
+}
+
+var containsSink bool
+
+func BenchmarkContainsAnyByte(b *testing.B) {
+	const chars = "\x00\x07\x1b\x7f"
+	set := MakeByteSet([]byte(chars)...)
+	for _, n := range []int{64, 1024, 64 << 10} {
+		// Worst case for both: no member present, so the whole input is
+		// scanned.
+		data := bytes.Repeat([]byte("plain text "), n/11+1)[:n]
+		b.Run(fmt.Sprintf("BytesContainsAny_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				containsSink = bytes.ContainsAny(data, chars)
+			}
+		})
+		b.Run(fmt.Sprintf("Algo_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				containsSink = ContainsAnyByte(data, set)
+			}
+		})
+	}
+}
@@ -1,22 +1,15 @@
 package algo
 
 import (
+	"hash"
 	"hash/crc32"
 
 	"github.com/miretskiy/simba/internal/ffi"
-	"github.com/miretskiy/simba/pkg/intrinsics"
 )
 
-// Threshold at which the SIMD FFI path overtakes Go's built-in crc32.  Recent
-// July-2025 benchmarks on an Apple M2 Max show that SIMD starts winning
-// around 1 KiB (break-even ≈ 800 B, still ~9 % slower at 512 B).  Keep the
-// threshold at a clean 1 KiB to stay conservative and to avoid a perf cliff
-// on other CPUs.
-const crc32Threshold = 1024
-
-// Castagnoli table (CRC-32C / iSCSI polynomial) — this is the only
-// polynomial supported by the Rust SIMD backend, so everything in the Simba
-// CRC layer is hard-wired to it.
+// Castagnoli table (CRC-32C / iSCSI polynomial) — the default polynomial of
+// the Simba CRC layer.  The IEEE polynomial is available separately through
+// CRC32IEEE and CRC32Poly (see crc32_ieee.go).
 var castagnoliTable = crc32.MakeTable(crc32.Castagnoli)
 
 // CRC32 returns the Castagnoli CRC32C of data by default.
@@ -25,20 +18,14 @@ var castagnoliTable = crc32.MakeTable(crc32.Castagnoli)
 // we jump directly to the 32/64-lane kernels exposed by the intrinsics
 // package.
 func CRC32(data []byte) uint32 {
-	if len(data) < crc32Threshold {
-		return crc32.Checksum(data, castagnoliTable)
-	}
-	return intrinsics.Crc32Update(data, 0)
+	return defaultDispatcher.Load().CRC32(data)
 }
 
 // Update extends an existing CRC32C (Castagnoli) value with additional data.
 // For long buffers (>256 B) it routes through SIMD kernels; otherwise it
 // falls back to Go's scalar routine.
 func CRC32Update(data []byte, init uint32) uint32 {
-	if len(data) < crc32Threshold {
-		return crc32.Update(init, castagnoliTable, data)
-	}
-	return intrinsics.Crc32Update(data, init)
+	return defaultDispatcher.Load().CRC32Update(data, init)
 }
 
 // Combine concatenates two CRC32C digests. For other tables use
@@ -46,3 +33,87 @@ func CRC32Update(data []byte, init uint32) uint32 {
 func CRC32Combine(crc1, crc2 uint32, len2 int) uint32 {
 	return ffi.Crc32Combine(crc1, crc2, len2)
 }
+
+// CRC32CResidue is the value CRC32Residue returns for any frame whose last
+// four bytes are the CRC32C of the preceding bytes, stored little-endian.
+const CRC32CResidue = 0x48674BC7
+
+// CRC32Residue returns the CRC32C of a frame that carries its own checksum
+// in the trailing four bytes (little-endian, as produced by
+// binary.LittleEndian.AppendUint32(msg, CRC32(msg))).  For an intact frame
+// the result is always CRC32CResidue, so validation is a single call and a
+// compare:
+//
+//	ok := algo.CRC32Residue(frame) == algo.CRC32CResidue
+func CRC32Residue(dataWithCRC []byte) uint32 {
+	return CRC32(dataWithCRC)
+}
+
+// CRC32Appender accumulates the CRC32C of a message built piece by piece.
+// It tracks the running CRC and total length, so pieces built independently
+// (e.g. in parallel) can be stitched together with Merge instead of being
+// re-checksummed.  The zero value is an empty message.
+type CRC32Appender struct {
+	crc uint32
+	n   int
+}
+
+// Append extends the message with data.
+func (a *CRC32Appender) Append(data []byte) {
+	a.crc = CRC32Update(data, a.crc)
+	a.n += len(data)
+}
+
+// Merge appends the message accumulated by other, as if its pieces had been
+// passed to a.Append, using CRC32Combine.  other is not modified.
+func (a *CRC32Appender) Merge(other *CRC32Appender) {
+	a.crc = CRC32Combine(a.crc, other.crc, other.n)
+	a.n += other.n
+}
+
+// Sum returns the CRC32C of the message so far.
+func (a *CRC32Appender) Sum() uint32 { return a.crc }
+
+// Len returns the number of bytes appended so far.
+func (a *CRC32Appender) Len() int { return a.n }
+
+// Reset empties the message.
+func (a *CRC32Appender) Reset() { *a = CRC32Appender{} }
+
+// crc32Digest implements hash.Hash32 on top of CRC32Poly.Update.
+type crc32Digest struct {
+	poly CRC32Poly
+	crc  uint32
+}
+
+// NewCRC32C returns a hash.Hash32 computing the CRC32C (Castagnoli)
+// checksum, a drop-in replacement for crc32.New(crc32.MakeTable(
+// crc32.Castagnoli)).  The running CRC is carried across Write calls and each
+// write goes through CRC32Update, so large writes take the SIMD path while
+// small ones stay on the table-driven scalar code.
+func NewCRC32C() hash.Hash32 { return NewCRC32(PolyCastagnoli) }
+
+// NewCRC32 returns a hash.Hash32 computing the CRC-32 with polynomial poly.
+// It behaves like NewCRC32C, with each write routed through poly.Update.
+func NewCRC32(poly CRC32Poly) hash.Hash32 { return &crc32Digest{poly: poly} }
+
+func (d *crc32Digest) Write(p []byte) (int, error) {
+	d.crc = d.poly.Update(p, d.crc)
+	return len(p), nil
+}
+
+func (d *crc32Digest) Sum32() uint32 { return d.crc }
+
+// Sum appends the big-endian digest to b, matching hash/crc32.
+func (d *crc32Digest) Sum(b []byte) []byte {
+	s := d.crc
+	return append(b, byte(s>>24), byte(s>>16), byte(s>>8), byte(s))
+}
+
+func (d *crc32Digest) Reset() { d.crc = 0 }
+
+func (d *crc32Digest) Size() int { return crc32.Size }
+
+// BlockSize is 1: CRC-32 consumes input a byte at a time, so writes of any
+// length are equally efficient (as for hash/crc32).
+func (d *crc32Digest) BlockSize() int { return 1 }
@@ -0,0 +1,100 @@
+package algo
+
+import "hash/crc32"
+
+// gf2Matrix is a 32x32 matrix over GF(2) stored column-wise: column i is
+// the image of bit i.  Appending zero bytes to a CRC register is a linear
+// operator, so "shift crc1 past len2 bytes" can be captured in one matrix
+// and reused for every chunk of that length.
+type gf2Matrix [32]uint32
+
+// times applies m to vec.
+func (m *gf2Matrix) times(vec uint32) (sum uint32) {
+	for i := 0; vec != 0; i, vec = i+1, vec>>1 {
+		if vec&1 != 0 {
+			sum ^= m[i]
+		}
+	}
+	return sum
+}
+
+// compose returns the operator that applies b, then m.
+func (m *gf2Matrix) compose(b *gf2Matrix) (r gf2Matrix) {
+	for i := range b {
+		r[i] = m.times(b[i])
+	}
+	return r
+}
+
+// crc32ZerosOperator returns the operator that advances a CRC32C register
+// over n zero bytes.  It costs O(log n) matrix squarings, which is why
+// CRC32CombineFixed builds it only once.
+func crc32ZerosOperator(n int) gf2Matrix {
+	// One zero bit: shift right, folding the reflected polynomial back in
+	// when the low bit falls off.
+	var op gf2Matrix
+	op[0] = crc32.Castagnoli
+	for i := 1; i < 32; i++ {
+		op[i] = 1 << (i - 1)
+	}
+	// Square three times: 1 → 2 → 4 → 8 bits, i.e. one zero byte.
+	for i := 0; i < 3; i++ {
+		op = op.compose(&op)
+	}
+
+	var result gf2Matrix
+	for i := range result {
+		result[i] = 1 << i
+	}
+	for ; n > 0; n >>= 1 {
+		if n&1 != 0 {
+			result = op.compose(&result)
+		}
+		op = op.compose(&op)
+	}
+	return result
+}
+
+// CRC32CombineGo is a pure-Go CRC32Combine: it shifts crc1 past len2 zero
+// bytes with the GF(2) operator from crc32ZerosOperator and folds in crc2.
+// It needs no Rust kernel, so it serves platforms without the syso backend
+// and as a reference to cross-check the FFI result.  Each call costs
+// O(log len2) 32x32 matrix squarings.
+func CRC32CombineGo(crc1, crc2 uint32, len2 int) uint32 {
+	op := crc32ZerosOperator(len2)
+	return op.times(crc1) ^ crc2
+}
+
+// CRC32CombineAll folds the CRC32C digests of consecutive chunks, left to
+// right, into the digest of their concatenation.  lens[i] is the length of
+// the chunk crcs[i] was computed over; both slices must have the same
+// length.
+func CRC32CombineAll(crcs []uint32, lens []int) uint32 {
+	if len(crcs) != len(lens) {
+		panic("algo: CRC32CombineAll crcs/lens length mismatch")
+	}
+	if len(crcs) == 0 {
+		return 0
+	}
+	acc := crcs[0]
+	for i := 1; i < len(crcs); i++ {
+		acc = CRC32Combine(acc, crcs[i], lens[i])
+	}
+	return acc
+}
+
+// CRC32CombineFixed is CRC32CombineAll for chunks that all have length
+// chunkLen, as in fixed-block storage.  The shift operator for chunkLen is
+// computed once and each further digest costs a single 32-column matrix
+// apply, much cheaper than a CRC32Combine call per chunk.
+func CRC32CombineFixed(crcs []uint32, chunkLen int) uint32 {
+	if len(crcs) == 0 {
+		return 0
+	}
+	op := crc32ZerosOperator(chunkLen)
+	acc := crcs[0]
+	for _, c := range crcs[1:] {
+		acc = op.times(acc) ^ c
+	}
+	return acc
+}
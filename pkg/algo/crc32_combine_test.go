@@ -0,0 +1,70 @@
+package algo
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRC32CombineFixed(t *testing.T) {
+	for _, chunkLen := range []int{0, 1, 7, 64, 4096} {
+		const chunks = 257
+		data := randomBytes(chunks * chunkLen)
+		crcs := make([]uint32, chunks)
+		lens := make([]int, chunks)
+		for i := range crcs {
+			crcs[i] = CRC32(data[i*chunkLen : (i+1)*chunkLen])
+			lens[i] = chunkLen
+		}
+
+		want := CRC32(data)
+		require.Equal(t, want, CRC32CombineAll(crcs, lens), "CombineAll chunkLen=%d", chunkLen)
+		require.Equal(t, want, CRC32CombineFixed(crcs, chunkLen), "CombineFixed chunkLen=%d", chunkLen)
+	}
+
+	require.Equal(t, uint32(0), CRC32CombineFixed(nil, 16))
+	require.Equal(t, uint32(0x1234), CRC32CombineFixed([]uint32{0x1234}, 16))
+}
+
+func TestCRC32CombineGo(t *testing.T) {
+	r := rand.New(rand.NewSource(11))
+	for i := 0; i < 200; i++ {
+		a := randomBytes(r.Intn(300))
+		b := randomBytes(r.Intn(5000))
+		crcA, crcB := CRC32(a), CRC32(b)
+		want := CRC32(append(a, b...))
+		require.Equal(t, want, CRC32CombineGo(crcA, crcB, len(b)), "len(a)=%d len(b)=%d", len(a), len(b))
+		require.Equal(t, CRC32Combine(crcA, crcB, len(b)), CRC32CombineGo(crcA, crcB, len(b)), "FFI vs Go, len(b)=%d", len(b))
+	}
+
+	// Large lengths exercise the high bits of the exponent.
+	for _, n := range []int{1 << 20, 1<<30 + 7, math.MaxInt} {
+		require.Equal(t, CRC32Combine(0xdeadbeef, 0x1234, n), CRC32CombineGo(0xdeadbeef, 0x1234, n), "len2=%d", n)
+	}
+}
+
+var crc32CombineSink uint32
+
+func BenchmarkCRC32CombineFixed(b *testing.B) {
+	const chunkLen, chunks = 4096, 1024
+	crcs := make([]uint32, chunks)
+	lens := make([]int, chunks)
+	for i := range crcs {
+		crcs[i] = uint32(i) * 0x9E3779B9
+		lens[i] = chunkLen
+	}
+
+	b.Run(fmt.Sprintf("impl=fixed/%d", chunks), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			crc32CombineSink = CRC32CombineFixed(crcs, chunkLen)
+		}
+	})
+	b.Run(fmt.Sprintf("impl=all/%d", chunks), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			crc32CombineSink = CRC32CombineAll(crcs, lens)
+		}
+	})
+}
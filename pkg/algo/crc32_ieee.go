@@ -0,0 +1,69 @@
+package algo
+
+import (
+	"hash"
+	"hash/crc32"
+
+	"github.com/miretskiy/simba/pkg/intrinsics"
+)
+
+// CRC32Poly selects the CRC-32 polynomial for NewCRC32 and the
+// polynomial-generic Update and Checksum helpers.
+type CRC32Poly uint8
+
+const (
+	// PolyCastagnoli is CRC-32C (iSCSI), the polynomial behind CRC32 and the
+	// rest of the simba CRC layer.
+	PolyCastagnoli CRC32Poly = iota
+	// PolyIEEE is the IEEE 802.3 polynomial used by zlib, gzip and PNG.
+	PolyIEEE
+)
+
+// String returns the polynomial's name.
+func (p CRC32Poly) String() string {
+	switch p {
+	case PolyCastagnoli:
+		return "Castagnoli"
+	case PolyIEEE:
+		return "IEEE"
+	default:
+		return "CRC32Poly(?)"
+	}
+}
+
+// Update extends the checksum init with data using polynomial p.  It panics
+// on an unknown polynomial.
+func (p CRC32Poly) Update(data []byte, init uint32) uint32 {
+	switch p {
+	case PolyCastagnoli:
+		return CRC32Update(data, init)
+	case PolyIEEE:
+		return CRC32IEEEUpdate(data, init)
+	default:
+		panic("algo: unknown CRC32Poly")
+	}
+}
+
+// Checksum returns the CRC-32 of data using polynomial p.
+func (p CRC32Poly) Checksum(data []byte) uint32 {
+	return p.Update(data, 0)
+}
+
+// CRC32IEEE returns the IEEE CRC-32 of data, as crc32.ChecksumIEEE does.
+// Buffers shorter than the CRC threshold use hash/crc32 with the IEEE table.
+func CRC32IEEE(data []byte) uint32 {
+	return CRC32IEEEUpdate(data, 0)
+}
+
+// CRC32IEEEUpdate extends an IEEE CRC-32 value with additional data, using
+// hash/crc32 below the CRC threshold and the Rust kernel above it.
+func CRC32IEEEUpdate(data []byte, init uint32) uint32 {
+	if len(data) < crc32Threshold {
+		return crc32.Update(init, crc32.IEEETable, data)
+	}
+	return intrinsics.Crc32IEEEUpdate(data, init)
+}
+
+// NewCRC32IEEE returns a hash.Hash32 computing the IEEE CRC-32, a drop-in
+// replacement for crc32.NewIEEE.
+func NewCRC32IEEE() hash.Hash32 { return NewCRC32(PolyIEEE) }
@@ -0,0 +1,61 @@
+package algo
+
+import (
+	"hash/crc32"
+	"testing"
+)
+
+func TestCRC32IEEEGoldenVectors(t *testing.T) {
+	vectors := []struct {
+		in   string
+		want uint32
+	}{
+		{"hello", 0x3610a686},       // CRC32("hello")
+		{"hello world", 0x0d4a1185}, // CRC32("hello world")
+	}
+
+	for _, v := range vectors {
+		if got := CRC32IEEE([]byte(v.in)); got != v.want {
+			t.Errorf("CRC32IEEE(%q) = %08x, want %08x", v.in, got, v.want)
+		}
+		if got := PolyIEEE.Checksum([]byte(v.in)); got != v.want {
+			t.Errorf("PolyIEEE.Checksum(%q) = %08x, want %08x", v.in, got, v.want)
+		}
+	}
+}
+
+func TestCRC32IEEEMatchesStdlib(t *testing.T) {
+	for _, n := range []int{0, 1, 100, crc32Threshold - 1, crc32Threshold, 3*crc32Threshold + 17} {
+		data := randomBytes(n)
+		want := crc32.ChecksumIEEE(data)
+		if got := CRC32IEEE(data); got != want {
+			t.Fatalf("len=%d: CRC32IEEE %08x, want %08x", n, got, want)
+		}
+
+		// Split updates across the threshold in both directions.
+		half := n / 2
+		if got := CRC32IEEEUpdate(data[half:], CRC32IEEE(data[:half])); got != want {
+			t.Fatalf("len=%d: split update %08x, want %08x", n, got, want)
+		}
+
+		h := NewCRC32IEEE()
+		h.Write(data[:half])
+		h.Write(data[half:])
+		if got := h.Sum32(); got != want {
+			t.Fatalf("len=%d: NewCRC32IEEE %08x, want %08x", n, got, want)
+		}
+	}
+}
+
+func TestCRC32PolySelectsTable(t *testing.T) {
+	data := randomBytes(2 * crc32Threshold)
+	if got, want := PolyCastagnoli.Checksum(data), CRC32(data); got != want {
+		t.Fatalf("PolyCastagnoli: %08x, want %08x", got, want)
+	}
+	if got, want := PolyIEEE.Checksum(data), crc32.ChecksumIEEE(data); got != want {
+		t.Fatalf("PolyIEEE: %08x, want %08x", got, want)
+	}
+	if PolyIEEE.String() != "IEEE" || PolyCastagnoli.String() != "Castagnoli" {
+		t.Fatalf("String: %v, %v", PolyIEEE, PolyCastagnoli)
+	}
+}
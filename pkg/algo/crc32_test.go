@@ -3,6 +3,7 @@ package algo
 import (
 	"bytes"
 	"crypto/rand"
+	"encoding/binary"
 	"hash/crc32"
 	"testing"
 )
@@ -90,3 +91,85 @@ func TestCRC32UpdateAndCombineGolden(t *testing.T) {
 		t.Fatalf("CRC32Combine result %08x want %08x", gotComb, want)
 	}
 }
+
+func TestCRC32Residue(t *testing.T) {
+	for _, n := range []int{0, 1, 5, 100, crc32Threshold, 4 * crc32Threshold} {
+		msg := randomBytes(n)
+		frame := binary.LittleEndian.AppendUint32(msg, CRC32(msg))
+		if got := CRC32Residue(frame); got != CRC32CResidue {
+			t.Fatalf("len=%d: residue %08x, want %08x", n, got, uint32(CRC32CResidue))
+		}
+
+		frame[len(frame)/2] ^= 0x01
+		if got := CRC32Residue(frame); got == CRC32CResidue {
+			t.Fatalf("len=%d: corrupted frame still yields the residue", n)
+		}
+	}
+}
+
+func TestCRC32Appender(t *testing.T) {
+	msg := randomBytes(10000)
+	cuts := []int{0, 0, 3, 64, 1023, 1024, 5000, 5001, len(msg)}
+	want := CRC32(msg)
+
+	// Sequential appends.
+	var seq CRC32Appender
+	for i := 1; i < len(cuts); i++ {
+		seq.Append(msg[cuts[i-1]:cuts[i]])
+	}
+	if seq.Sum() != want || seq.Len() != len(msg) {
+		t.Fatalf("sequential: sum %08x len %d, want %08x len %d", seq.Sum(), seq.Len(), want, len(msg))
+	}
+
+	// One appender per piece, merged left to right.
+	var merged CRC32Appender
+	for i := 1; i < len(cuts); i++ {
+		var piece CRC32Appender
+		piece.Append(msg[cuts[i-1]:cuts[i]])
+		merged.Merge(&piece)
+	}
+	if merged.Sum() != want || merged.Len() != len(msg) {
+		t.Fatalf("merged: sum %08x len %d, want %08x len %d", merged.Sum(), merged.Len(), want, len(msg))
+	}
+
+	merged.Reset()
+	if merged.Sum() != 0 || merged.Len() != 0 {
+		t.Fatalf("after Reset: sum %08x len %d", merged.Sum(), merged.Len())
+	}
+}
+
+func TestNewCRC32C(t *testing.T) {
+	msg := randomBytes(3 * crc32Threshold)
+	want := crc32.Checksum(msg, crc32.MakeTable(crc32.Castagnoli))
+
+	h := NewCRC32C()
+	if h.Size() != 4 || h.BlockSize() != 1 {
+		t.Fatalf("Size/BlockSize = %d/%d, want 4/1", h.Size(), h.BlockSize())
+	}
+
+	// Many small writes, then one write large enough for the SIMD path.
+	rest := msg
+	for i := 1; len(rest) > 2*crc32Threshold; i = i%13 + 1 {
+		h.Write(rest[:i])
+		rest = rest[i:]
+	}
+	h.Write(rest)
+	if got := h.Sum32(); got != want {
+		t.Fatalf("Sum32 = %08x, want %08x", got, want)
+	}
+
+	ref := crc32.New(crc32.MakeTable(crc32.Castagnoli))
+	ref.Write(msg)
+	if got, exp := h.Sum([]byte("x")), ref.Sum([]byte("x")); !bytes.Equal(got, exp) {
+		t.Fatalf("Sum = %x, want %x", got, exp)
+	}
+
+	h.Reset()
+	if h.Sum32() != 0 {
+		t.Fatalf("after Reset: %08x", h.Sum32())
+	}
+	h.Write([]byte("hello"))
+	if h.Sum32() != 0x9a71bb4c {
+		t.Fatalf("CRC32C(hello) after Reset = %08x", h.Sum32())
+	}
+}
@@ -0,0 +1,59 @@
+package algo
+
+import (
+	"math/bits"
+
+	"github.com/miretskiy/simba/pkg/intrinsics"
+)
+
+// csvBlock is how many bytes CountCSVFields classifies per kernel call.
+const csvBlock = 4096
+
+// CountCSVFields returns the number of fields in one CSV record: the number
+// of sep bytes outside quoted regions plus one, so an empty line is a single
+// empty field.  Each quote byte toggles the quoted state, which also handles
+// escaped quotes written as two quote bytes: the pair toggles twice and
+// leaves the state unchanged.  line should not include the record
+// terminator; a newline inside quotes is just another byte.
+//
+// Inputs of 64 bytes or more are classified with the 64-lane equality-mask
+// kernel, one pass for sep and one for quote.  The quoted state of every
+// byte in a 64-byte word is the prefix XOR of the quote mask, seeded with
+// the state carried out of the previous word, so separators are counted with
+// a single popcount per word.
+func CountCSVFields(line []byte, sep, quote byte) int {
+	fields := 1
+	var inQuote uint64 // all ones while inside quotes at a word boundary
+
+	var sepMasks, quoteMasks [csvBlock / 64]uint64
+	full := len(line) &^ 63
+	for base := 0; base < full; base += csvBlock {
+		block := line[base:min(base+csvBlock, full)]
+		intrinsics.EqU8Masks64(block, sep, sepMasks[:])
+		intrinsics.EqU8Masks64(block, quote, quoteMasks[:])
+		for w, q := range quoteMasks[:len(block)/64] {
+			q ^= q << 1
+			q ^= q << 2
+			q ^= q << 4
+			q ^= q << 8
+			q ^= q << 16
+			q ^= q << 32
+			quoted := q ^ inQuote
+			fields += bits.OnesCount64(sepMasks[w] &^ quoted)
+			inQuote = -(quoted >> 63)
+		}
+	}
+
+	quoted := inQuote != 0
+	for _, b := range line[full:] {
+		switch b {
+		case quote:
+			quoted = !quoted
+		case sep:
+			if !quoted {
+				fields++
+			}
+		}
+	}
+	return fields
+}
@@ -0,0 +1,59 @@
+package algo
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func scalarCountCSVFields(line string, sep, quote byte) int {
+	fields, quoted := 1, false
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case quote:
+			quoted = !quoted
+		case sep:
+			if !quoted {
+				fields++
+			}
+		}
+	}
+	return fields
+}
+
+func TestCountCSVFields(t *testing.T) {
+	for _, tc := range []struct {
+		line string
+		want int
+	}{
+		{"", 1},
+		{"a", 1},
+		{"a,b,c", 3},
+		{",,", 3},
+		{`"a,b",c`, 2},
+		{`"he said ""hi, there""",x`, 2},
+		{`"""",""`, 2},
+		{`a,"b` + "\n" + `c",d`, 3},
+		{`"unterminated,a,b`, 1},
+	} {
+		require.Equal(t, tc.want, CountCSVFields([]byte(tc.line), ',', '"'), "%q", tc.line)
+	}
+
+	// Quoted regions spanning 64-byte words and the 4 KiB block boundary.
+	wide := strings.Repeat(`plain,"quoted, with ""escapes"", and commas",`, 120)
+	for _, n := range []int{63, 64, 65, 127, 128, 129, 4095, 4096, 4097, len(wide)} {
+		line := wide[:n]
+		require.Equal(t, scalarCountCSVFields(line, ',', '"'), CountCSVFields([]byte(line), ',', '"'), "n=%d", n)
+	}
+
+	r := rand.New(rand.NewSource(4))
+	for trial := 0; trial < 200; trial++ {
+		b := make([]byte, r.Intn(300))
+		for i := range b {
+			b[i] = "ab;'\t"[r.Intn(5)]
+		}
+		require.Equal(t, scalarCountCSVFields(string(b), ';', '\''), CountCSVFields(b, ';', '\''), "%q", b)
+	}
+}
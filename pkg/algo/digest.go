@@ -0,0 +1,50 @@
+package algo
+
+import (
+	"hash/crc32"
+
+	"github.com/miretskiy/simba/pkg/intrinsics"
+)
+
+// DigestWriter is an io.Writer that keeps two running digests of everything
+// written to it: the CRC32C (Castagnoli) checksum and the byte sum modulo
+// 2^32, a cheap sanity digest.  Writes at or above the CRC threshold go
+// through the fused CRC+sum kernel, which computes both in one pass over the
+// data; shorter writes use hash/crc32 and a scalar sum.  Either way the
+// digests depend only on the concatenated bytes, not on how they were split
+// into writes.  The zero value is ready to use.
+type DigestWriter struct {
+	crc uint32
+	sum uint32
+}
+
+// NewDigestWriter returns an empty DigestWriter.
+func NewDigestWriter() *DigestWriter {
+	return &DigestWriter{}
+}
+
+// Write adds p to both digests.  It never fails.
+func (w *DigestWriter) Write(p []byte) (int, error) {
+	if len(p) < crc32Threshold {
+		w.crc = crc32.Update(w.crc, castagnoliTable, p)
+		for _, b := range p {
+			w.sum += uint32(b)
+		}
+		return len(p), nil
+	}
+	crc, sum := intrinsics.CrcAndSum(p, w.crc)
+	w.crc = crc
+	w.sum += uint32(sum)
+	return len(p), nil
+}
+
+// CRC32 returns the CRC32C of the bytes written so far, as CRC32 would
+// return for their concatenation.
+func (w *DigestWriter) CRC32() uint32 { return w.crc }
+
+// Sum returns the byte sum of the bytes written so far modulo 2^32, as
+// SumU8 would return for their concatenation.
+func (w *DigestWriter) Sum() uint32 { return w.sum }
+
+// Reset clears both digests.
+func (w *DigestWriter) Reset() { *w = DigestWriter{} }
@@ -0,0 +1,53 @@
+package algo
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDigestWriter(t *testing.T) {
+	var _ io.Writer = NewDigestWriter()
+
+	r := rand.New(rand.NewSource(24))
+	data := make([]byte, 200_000)
+	r.Read(data)
+
+	w := NewDigestWriter()
+	require.Equal(t, uint32(0), w.CRC32())
+	require.Equal(t, uint32(0), w.Sum())
+
+	// One big write.
+	n, err := w.Write(data)
+	require.NoError(t, err)
+	require.Equal(t, len(data), n)
+	require.Equal(t, CRC32(data), w.CRC32())
+	require.Equal(t, SumU8(data), w.Sum())
+	wantCRC, wantSum := w.CRC32(), w.Sum()
+
+	// Interleaved odd-sized writes straddling the CRC threshold, including
+	// empty ones, must give the same digests.
+	w.Reset()
+	sizes := []int{0, 1, 3, 7, crc32Threshold - 1, crc32Threshold, crc32Threshold + 1, 17, 4099, 65537}
+	for rest, i := data, 0; len(rest) > 0; i++ {
+		k := min(sizes[i%len(sizes)], len(rest))
+		if i%5 == 4 {
+			k = min(1+r.Intn(3*crc32Threshold), len(rest))
+		}
+		_, err := w.Write(rest[:k])
+		require.NoError(t, err)
+		rest = rest[k:]
+	}
+	require.Equal(t, wantCRC, w.CRC32())
+	require.Equal(t, wantSum, w.Sum())
+
+	// io.Copy drives it like any other writer.
+	var copied DigestWriter
+	_, err = io.Copy(&copied, bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Equal(t, wantCRC, copied.CRC32())
+	require.Equal(t, wantSum, copied.Sum())
+}
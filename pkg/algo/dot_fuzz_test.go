@@ -0,0 +1,58 @@
+package algo
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+func FuzzDotU8(f *testing.F) {
+	f.Add([]byte{}, uint8(0))
+	f.Add([]byte("the quick brown fox jumps over the lazy dog, twice over"), uint8(7))
+	f.Add(make([]byte, 300), uint8(255))
+
+	f.Fuzz(func(t *testing.T, a []byte, rot uint8) {
+		// b is a rotated copy of a, so both have the same length.
+		b := make([]byte, len(a))
+		for i := range a {
+			b[i] = a[(i+int(rot))%len(a)] ^ rot
+		}
+		var want uint64
+		for i := range a {
+			want += uint64(a[i]) * uint64(b[i])
+		}
+		if got := DotU8(a, b); got != want {
+			t.Fatalf("DotU8(len=%d) = %d, want %d", len(a), got, want)
+		}
+	})
+}
+
+func FuzzDotF32(f *testing.F) {
+	f.Add([]byte{})
+	f.Add(make([]byte, 8*100))
+	f.Add([]byte("0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"))
+
+	f.Fuzz(func(t *testing.T, raw []byte) {
+		// Each 8 raw bytes become one (a[i], b[i]) pair; non-finite and huge
+		// values are skipped so the sum stays finite.
+		var a, b []float32
+		for ; len(raw) >= 8; raw = raw[8:] {
+			x := math.Float32frombits(binary.LittleEndian.Uint32(raw))
+			y := math.Float32frombits(binary.LittleEndian.Uint32(raw[4:]))
+			if math.Abs(float64(x)) > 1e6 || math.Abs(float64(y)) > 1e6 || x != x || y != y {
+				continue
+			}
+			a, b = append(a, x), append(b, y)
+		}
+		var want, mag float64
+		for i := range a {
+			p := float64(a[i]) * float64(b[i])
+			want += p
+			mag += math.Abs(p)
+		}
+		got := DotF32(a, b)
+		if math.Abs(got-want) > 1e-12*mag {
+			t.Fatalf("DotF32(len=%d) = %v, want %v (|products| %v)", len(a), got, want, mag)
+		}
+	})
+}
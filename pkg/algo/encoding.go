@@ -0,0 +1,90 @@
+package algo
+
+import (
+	"bytes"
+	"unicode/utf8"
+)
+
+// Encoding is the text encoding guessed by SniffEncoding.
+type Encoding uint8
+
+const (
+	// EncodingASCII means every byte is 7-bit ASCII and no NUL byte occurs.
+	EncodingASCII Encoding = iota
+	// EncodingUTF8 means the data is valid UTF-8 (after an optional BOM).
+	EncodingUTF8
+	// EncodingUTF16LE means the data starts with the FF FE byte-order mark.
+	EncodingUTF16LE
+	// EncodingUTF16BE means the data starts with the FE FF byte-order mark.
+	EncodingUTF16BE
+	// EncodingBinary means the data is neither ASCII nor UTF-8 text.
+	EncodingBinary
+)
+
+var encodingNames = [...]string{
+	EncodingASCII:   "ASCII",
+	EncodingUTF8:    "UTF-8",
+	EncodingUTF16LE: "UTF-16LE",
+	EncodingUTF16BE: "UTF-16BE",
+	EncodingBinary:  "binary",
+}
+
+func (e Encoding) String() string {
+	if int(e) < len(encodingNames) {
+		return encodingNames[e]
+	}
+	return "Encoding(?)"
+}
+
+var (
+	bomUTF8    = []byte{0xEF, 0xBB, 0xBF}
+	bomUTF16LE = []byte{0xFF, 0xFE}
+	bomUTF16BE = []byte{0xFE, 0xFF}
+)
+
+// SniffEncoding guesses the encoding of a file-ingestion buffer.  The
+// heuristics, applied in order:
+//
+//   - A UTF-16 byte-order mark decides UTF16LE/UTF16BE; the payload is not
+//     inspected.
+//   - A UTF-8 BOM is stripped and the rest must be valid UTF-8, otherwise
+//     the buffer is Binary.
+//   - Any NUL byte makes the buffer Binary.  UTF-16 text without a BOM is
+//     therefore reported as Binary rather than guessed at.
+//   - All bytes < 0x80 is ASCII (empty input included), valid UTF-8 is UTF8,
+//     anything else is Binary.
+//
+// The NUL scan and the ASCII check run on the SIMD kernels, so the common
+// ASCII case never reaches the scalar UTF-8 validator; only buffers that
+// actually contain non-ASCII bytes are handed to utf8.Valid.
+func SniffEncoding(data []byte) Encoding {
+	switch {
+	case bytes.HasPrefix(data, bomUTF16LE):
+		return EncodingUTF16LE
+	case bytes.HasPrefix(data, bomUTF16BE):
+		return EncodingUTF16BE
+	case bytes.HasPrefix(data, bomUTF8):
+		if validUTF8Text(data[len(bomUTF8):]) {
+			return EncodingUTF8
+		}
+		return EncodingBinary
+	}
+	if ContainsByte(data, 0) {
+		return EncodingBinary
+	}
+	if IsASCII(data) {
+		return EncodingASCII
+	}
+	if utf8.Valid(data) {
+		return EncodingUTF8
+	}
+	return EncodingBinary
+}
+
+// validUTF8Text reports whether data is NUL-free valid UTF-8.
+func validUTF8Text(data []byte) bool {
+	if ContainsByte(data, 0) {
+		return false
+	}
+	return IsASCII(data) || utf8.Valid(data)
+}
@@ -0,0 +1,34 @@
+package algo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSniffEncoding(t *testing.T) {
+	long := strings.Repeat("the quick brown fox, ", 10)
+	for _, tc := range []struct {
+		name string
+		data string
+		want Encoding
+	}{
+		{"empty", "", EncodingASCII},
+		{"ascii short", "hello", EncodingASCII},
+		{"ascii long", long, EncodingASCII},
+		{"utf8 short", "héllo", EncodingUTF8},
+		{"utf8 long", long + "日本語", EncodingUTF8},
+		{"utf8 bom", "\xEF\xBB\xBFhello", EncodingUTF8},
+		{"utf8 bom only", "\xEF\xBB\xBF", EncodingUTF8},
+		{"utf8 bom invalid", "\xEF\xBB\xBF" + long + "\xC3", EncodingBinary},
+		{"utf16le bom", "\xFF\xFEh\x00i\x00", EncodingUTF16LE},
+		{"utf16be bom", "\xFE\xFF\x00h\x00i", EncodingUTF16BE},
+		{"utf16le no bom", "h\x00i\x00", EncodingBinary},
+		{"nul", long + "\x00" + long, EncodingBinary},
+		{"invalid utf8", long + "\xFF\xFE\xFD", EncodingBinary},
+		{"truncated utf8", "abc\xE6\x97", EncodingBinary},
+	} {
+		require.Equal(t, tc.want, SniffEncoding([]byte(tc.data)), tc.name)
+	}
+}
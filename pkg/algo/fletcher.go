@@ -0,0 +1,38 @@
+package algo
+
+import "github.com/miretskiy/simba/pkg/intrinsics"
+
+// Fletcher16 returns the Fletcher-16 checksum of data (sum2<<8 | sum1, both
+// sums over bytes modulo 255).  Inputs shorter than the SIMD threshold are
+// summed with a scalar loop.
+func Fletcher16(data []byte) uint16 {
+	if len(data) < simdThreshold {
+		var s1, s2 uint32
+		for _, b := range data {
+			s1 = (s1 + uint32(b)) % 255
+			s2 = (s2 + s1) % 255
+		}
+		return uint16(s2<<8 | s1)
+	}
+	return intrinsics.Fletcher16(data)
+}
+
+// Fletcher32 returns the Fletcher-32 checksum of data (sum2<<16 | sum1, both
+// sums over 16-bit words modulo 65535).  Words are little-endian: bytes
+// {0x01, 0x02} form the word 0x0201.  An odd trailing byte is zero-padded
+// to a full word.
+func Fletcher32(data []byte) uint32 {
+	if len(data) < 2*simdThreshold {
+		var s1, s2 uint32
+		for i := 0; i < len(data); i += 2 {
+			w := uint32(data[i])
+			if i+1 < len(data) {
+				w |= uint32(data[i+1]) << 8
+			}
+			s1 = (s1 + w) % 65535
+			s2 = (s2 + s1) % 65535
+		}
+		return s2<<16 | s1
+	}
+	return intrinsics.Fletcher32(data)
+}
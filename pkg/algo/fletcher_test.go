@@ -0,0 +1,60 @@
+package algo
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// scalarFletcher computes both checksums with the textbook per-element
+// reduction over words of the given width (1 or 2 bytes, little-endian).
+func scalarFletcher(data []byte, width int, mod uint64) (s1, s2 uint64) {
+	for i := 0; i < len(data); i += width {
+		w := uint64(data[i])
+		if width == 2 && i+1 < len(data) {
+			w |= uint64(data[i+1]) << 8
+		}
+		s1 = (s1 + w) % mod
+		s2 = (s2 + s1) % mod
+	}
+	return s1, s2
+}
+
+func TestFletcherVectors(t *testing.T) {
+	vectors := []struct {
+		in  string
+		f16 uint16
+		f32 uint32
+	}{
+		{"", 0, 0},
+		{"abcde", 0xC8F0, 0xF04FC729},
+		{"abcdef", 0x2057, 0x56502D2A},
+		{"abcdefgh", 0x0627, 0xEBE19591},
+	}
+	for _, v := range vectors {
+		require.Equal(t, v.f16, Fletcher16([]byte(v.in)), "Fletcher16(%q)", v.in)
+		require.Equal(t, v.f32, Fletcher32([]byte(v.in)), "Fletcher32(%q)", v.in)
+	}
+}
+
+func TestFletcherMatchesScalar(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	for _, n := range []int{1, 2, 15, 16, 17, 31, 32, 33, 63, 64, 65, 127, 128, 129, 1000, 70001} {
+		data := make([]byte, n)
+		r.Read(data)
+		a, b := scalarFletcher(data, 1, 255)
+		require.Equal(t, uint16(b<<8|a), Fletcher16(data), "Fletcher16 n=%d", n)
+		a, b = scalarFletcher(data, 2, 65535)
+		require.Equal(t, uint32(b<<16|a), Fletcher32(data), "Fletcher32 n=%d", n)
+	}
+
+	// All-0xFF input drives every lane accumulator to its maximum, which is
+	// where a too-long reduction interval would overflow.
+	ones := bytes.Repeat([]byte{0xFF}, 1<<20)
+	a, b := scalarFletcher(ones, 1, 255)
+	require.Equal(t, uint16(b<<8|a), Fletcher16(ones))
+	a, b = scalarFletcher(ones, 2, 65535)
+	require.Equal(t, uint32(b<<16|a), Fletcher32(ones))
+}
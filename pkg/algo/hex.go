@@ -0,0 +1,87 @@
+package algo
+
+import (
+	"strconv"
+
+	"github.com/miretskiy/simba/pkg/intrinsics"
+)
+
+const hexDigits = "0123456789abcdef"
+
+// The SIMD kernels take over once the input covers one full vector step
+// (16 source bytes when encoding, 16 digit pairs when decoding).
+const (
+	hexEncodeThreshold = 16
+	hexDecodeThreshold = 32
+)
+
+// InvalidHexError reports the offset of the first invalid byte in a hex
+// input: a byte that is not a hex digit, or the dangling last byte of an
+// odd-length input.
+type InvalidHexError int64
+
+func (e InvalidHexError) Error() string {
+	return "algo: invalid hex data at input byte " + strconv.FormatInt(int64(e), 10)
+}
+
+// HexEncode writes the lower-case hex encoding of src into dst and returns
+// the number of bytes written, 2*len(src).  The output matches
+// hex.Encode.  It panics if dst is shorter than 2*len(src).
+func HexEncode(dst, src []byte) int {
+	if len(dst) < 2*len(src) {
+		panic("algo: HexEncode dst too short")
+	}
+	i := 0
+	if len(src) >= hexEncodeThreshold {
+		intrinsics.HexEncode(dst, src)
+		i = len(src)
+	}
+	for ; i < len(src); i++ {
+		b := src[i]
+		dst[2*i], dst[2*i+1] = hexDigits[b>>4], hexDigits[b&15]
+	}
+	return 2 * len(src)
+}
+
+// HexDecode decodes hex digits of either case from src into dst and returns
+// the number of bytes written.  On malformed input it returns the bytes
+// decoded before the offending pair together with an InvalidHexError
+// holding the offset of the first non-hex byte.  An odd-length input whose
+// digits are otherwise valid is rejected with the offset of its last byte.
+// It panics if dst is shorter than len(src)/2.
+func HexDecode(dst, src []byte) (int, error) {
+	if len(dst) < len(src)/2 {
+		panic("algo: HexDecode dst too short")
+	}
+	si := 0
+	if len(src) >= hexDecodeThreshold {
+		si = intrinsics.HexDecodePairs(dst, src)
+	}
+	for ; si+2 <= len(src); si += 2 {
+		hi, ok := hexValue(src[si])
+		if !ok {
+			return si / 2, InvalidHexError(si)
+		}
+		lo, ok := hexValue(src[si+1])
+		if !ok {
+			return si / 2, InvalidHexError(si + 1)
+		}
+		dst[si/2] = hi<<4 | lo
+	}
+	if si < len(src) {
+		return si / 2, InvalidHexError(si)
+	}
+	return si / 2, nil
+}
+
+func hexValue(c byte) (byte, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, true
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
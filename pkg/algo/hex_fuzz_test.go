@@ -0,0 +1,48 @@
+package algo
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func FuzzHex(f *testing.F) {
+	f.Add([]byte(""))
+	f.Add([]byte("deadBEEF"))
+	f.Add([]byte("abc"))
+	f.Add(bytes.Repeat([]byte("0f"), 40))
+	f.Add(append(bytes.Repeat([]byte("a1"), 20), 'g'))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Encode: data is raw input.
+		enc := make([]byte, 2*len(data))
+		HexEncode(enc, data)
+		if want := hex.EncodeToString(data); string(enc) != want {
+			t.Fatalf("HexEncode(%x) = %q, want %q", data, enc, want)
+		}
+		dec := make([]byte, len(data))
+		n, err := HexDecode(dec, enc)
+		if err != nil || !bytes.Equal(dec[:n], data) {
+			t.Fatalf("round trip of %x = %x, %v", data, dec[:n], err)
+		}
+
+		// Decode: data is (possibly invalid) hex text.  encoding/hex reports
+		// an odd length as ErrLength rather than an offset, so only the
+		// decoded prefix and, for bad bytes, the offset are compared.
+		want := make([]byte, len(data)/2)
+		wn, wantErr := hex.Decode(want, data)
+		got := make([]byte, len(data)/2)
+		n, err = HexDecode(got, data)
+		if (err == nil) != (wantErr == nil) {
+			t.Fatalf("HexDecode(%q) err = %v, encoding/hex err = %v", data, err, wantErr)
+		}
+		if n != wn || !bytes.Equal(got[:n], want[:wn]) {
+			t.Fatalf("HexDecode(%q) = %x, want %x", data, got[:n], want[:wn])
+		}
+		var ibe hex.InvalidByteError
+		if errors.As(wantErr, &ibe) && data[err.(InvalidHexError)] != byte(ibe) {
+			t.Fatalf("HexDecode(%q) err = %v, encoding/hex err = %v", data, err, wantErr)
+		}
+	})
+}
@@ -0,0 +1,92 @@
+package algo
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHexRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(16))
+	for _, n := range []int{0, 1, 15, 16, 17, 31, 32, 33, 100, 1000} {
+		src := make([]byte, n)
+		r.Read(src)
+		enc := make([]byte, 2*n)
+		require.Equal(t, 2*n, HexEncode(enc, src))
+		require.Equal(t, hex.EncodeToString(src), string(enc), "n=%d", n)
+
+		for _, text := range []string{string(enc), strings.ToUpper(string(enc))} {
+			dec := make([]byte, n)
+			got, err := HexDecode(dec, []byte(text))
+			require.NoError(t, err)
+			require.Equal(t, n, got)
+			require.Equal(t, src, dec, "n=%d", n)
+		}
+	}
+}
+
+func TestHexDecodeErrors(t *testing.T) {
+	long := strings.Repeat("0123456789abcdefABCDEF", 10)
+	for _, tc := range []struct {
+		in      string
+		written int
+		offset  int64
+	}{
+		{"0", 0, 0},
+		{"g0", 0, 0},
+		{"0g", 0, 1},
+		{"abc", 1, 2}, // odd length
+		{"abg", 1, 2}, // odd length, bad last byte
+		{"zz0", 0, 0}, // bad pair reported before the odd length
+		{long[:100] + "x" + long[101:], 50, 100},
+		{long[:101] + ":" + long[102:], 50, 101},
+		{long[:32] + "/" + long[33:], 16, 32},
+		{long + "0", len(long) / 2, int64(len(long))},
+	} {
+		dst := make([]byte, len(tc.in)/2)
+		n, err := HexDecode(dst, []byte(tc.in))
+		require.Equal(t, InvalidHexError(tc.offset), err, "%q", tc.in)
+		require.Equal(t, tc.written, n, "%q", tc.in)
+	}
+}
+
+var hexSink int
+
+func BenchmarkHex(b *testing.B) {
+	for _, n := range []int{64, 4 << 10, 1 << 20} {
+		src := make([]byte, n)
+		rand.New(rand.NewSource(1)).Read(src)
+		enc := make([]byte, 2*n)
+		hex.Encode(enc, src)
+		dec := make([]byte, n)
+
+		b.Run(fmt.Sprintf("EncodeStdlib_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				hexSink = hex.Encode(enc, src)
+			}
+		})
+		b.Run(fmt.Sprintf("EncodeAlgo_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				hexSink = HexEncode(enc, src)
+			}
+		})
+		b.Run(fmt.Sprintf("DecodeStdlib_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				hexSink, _ = hex.Decode(dec, enc)
+			}
+		})
+		b.Run(fmt.Sprintf("DecodeAlgo_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				hexSink, _ = HexDecode(dec, enc)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package algo
+
+import (
+	"bytes"
+	"testing"
+)
+
+func FuzzHistogram(f *testing.F) {
+	f.Add([]byte(""))
+	f.Add([]byte("hello"))
+	f.Add(bytes.Repeat([]byte{0xFF}, 65))
+	f.Add(bytes.Repeat([]byte("0123456789abcdef"), 9))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		hist := Histogram(data)
+		var want [256]uint64
+		for _, b := range data {
+			want[b]++
+		}
+		var total uint64
+		for k, c := range hist {
+			if c != want[k] {
+				t.Fatalf("Histogram(%x)[%#02x] = %d, want %d", data, k, c, want[k])
+			}
+			total += c
+		}
+		if total != uint64(len(data)) {
+			t.Fatalf("Histogram(%x) buckets sum to %d, want %d", data, total, len(data))
+		}
+	})
+}
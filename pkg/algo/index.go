@@ -0,0 +1,93 @@
+package algo
+
+import (
+	"math/bits"
+
+	"github.com/miretskiy/simba/pkg/intrinsics"
+)
+
+// indexBlock is how many bytes LastIndexByte hands to the mask kernel at a
+// time.
+const indexBlock = 4096
+
+// IndexByte returns the offset of the first needle in data, or -1 if there is
+// none, like bytes.IndexByte.  Inputs shorter than the SIMD threshold are
+// scanned with a scalar loop; longer ones use the equality-mask kernels,
+// including for the bytes past the last whole chunk.
+func IndexByte(data []byte, needle byte) int {
+	if len(data) < simdThreshold {
+		for i, b := range data {
+			if b == needle {
+				return i
+			}
+		}
+		return -1
+	}
+	return intrinsics.IndexByte(data, needle)
+}
+
+// IndexAnyByte returns the offset of the first byte of data that is a member
+// of set, or -1 if there is none.  With a set built from ASCII delimiters it
+// matches bytes.IndexAny.  Inputs shorter than the LUT threshold are scanned
+// with a scalar loop.
+func IndexAnyByte(data []byte, set *ByteSet) int {
+	if len(data) < simdLUTThreshold {
+		for i, b := range data {
+			if (*set)[b] != 0 {
+				return i
+			}
+		}
+		return -1
+	}
+	return intrinsics.IndexAnyByte(data, (*[256]byte)(set))
+}
+
+// LastIndexByte returns the offset of the last needle in data, or -1 if there
+// is none, like bytes.LastIndexByte.
+//
+// The len%64 tail past the last whole chunk is the logical end of the buffer,
+// so it is checked first with a scalar loop.  The whole chunks are then
+// classified with the 64-lane equality-mask kernel one 4 KiB block at a time,
+// walking blocks and mask words from the end; the highest set bit of the
+// first non-zero word is the answer.
+func LastIndexByte(data []byte, needle byte) int {
+	full := len(data) &^ 63
+	if len(data) < simdThreshold {
+		full = 0
+	}
+	for i := len(data) - 1; i >= full; i-- {
+		if data[i] == needle {
+			return i
+		}
+	}
+
+	var masks [indexBlock / 64]uint64
+	for end := full; end > 0; {
+		base := max(end-indexBlock, 0)
+		block := data[base:end]
+		intrinsics.EqU8Masks64(block, needle, masks[:])
+		for w := len(block)/64 - 1; w >= 0; w-- {
+			if m := masks[w]; m != 0 {
+				return base + w*64 + 63 - bits.LeadingZeros64(m)
+			}
+		}
+		end = base
+	}
+	return -1
+}
+
+// CountByte returns the number of occurrences of needle in data, like
+// bytes.Count with a single-byte separator.  Inputs shorter than the SIMD
+// threshold are counted with a scalar loop.
+func CountByte(data []byte, needle byte) int {
+	if len(data) < simdThreshold {
+		n := 0
+		for _, b := range data {
+			if b == needle {
+				n++
+			}
+		}
+		return n
+	}
+	return intrinsics.CountByte(data, needle)
+}
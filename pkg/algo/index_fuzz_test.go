@@ -0,0 +1,78 @@
+package algo
+
+import (
+	"bytes"
+	"testing"
+)
+
+func FuzzIndexByte(f *testing.F) {
+	for _, n := range []int{0, 1, 15, 16, 17, 31, 32, 33, 63, 64, 65, 4095, 4096, 4097} {
+		data := bytes.Repeat([]byte("."), n)
+		f.Add(data, byte('x'))
+		if n > 0 {
+			edge := append([]byte(nil), data...)
+			edge[n-1] = 'x'
+			f.Add(edge, byte('x'))
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte, needle byte) {
+		want := bytes.IndexByte(data, needle)
+		if got := IndexByte(data, needle); got != want {
+			t.Fatalf("IndexByte(len=%d, %#x) = %d, want %d", len(data), needle, got, want)
+		}
+	})
+}
+
+func FuzzLastIndexByte(f *testing.F) {
+	for _, n := range []int{0, 1, 15, 16, 17, 63, 64, 65, 4095, 4096, 4097, 8300} {
+		data := bytes.Repeat([]byte("."), n)
+		f.Add(data, byte('x'))
+		if n > 0 {
+			edge := append([]byte(nil), data...)
+			edge[0] = 'x'
+			f.Add(edge, byte('x'))
+		}
+		if n > 64 {
+			edge := append([]byte(nil), data...)
+			edge[63] = 'x'
+			f.Add(edge, byte('x'))
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte, needle byte) {
+		want := bytes.LastIndexByte(data, needle)
+		if got := LastIndexByte(data, needle); got != want {
+			t.Fatalf("LastIndexByte(len=%d, %#x) = %d, want %d", len(data), needle, got, want)
+		}
+	})
+}
+
+func FuzzIndexAnyByte(f *testing.F) {
+	for _, n := range []int{0, 1, 15, 16, 17, 31, 33, 63, 64, 65, 200} {
+		data := bytes.Repeat([]byte("token "), n/6+1)[:n]
+		f.Add(data, ",;")
+		if n > 0 {
+			edge := append([]byte(nil), data...)
+			edge[n-1] = ';'
+			f.Add(edge, ",;|")
+		}
+	}
+	f.Add([]byte("a\tb\nc"), "\t\n")
+	f.Add([]byte("héllo, wörld"), ", ")
+
+	f.Fuzz(func(t *testing.T, data []byte, chars string) {
+		// Restrict the delimiters to ASCII, where byte and rune offsets agree
+		// even for data that is not valid UTF-8.
+		ascii := []byte(chars)
+		var set ByteSet
+		for i := range ascii {
+			ascii[i] &= 0x7F
+			set[ascii[i]] = 1
+		}
+		want := bytes.IndexAny(data, string(ascii))
+		if got := IndexAnyByte(data, &set); got != want {
+			t.Fatalf("IndexAnyByte(%q, %q) = %d, want %d", data, ascii, got, want)
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package algo
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLastIndexByte(t *testing.T) {
+	require.Equal(t, -1, LastIndexByte(nil, '/'))
+	require.Equal(t, -1, LastIndexByte([]byte("a"), '/'))
+	require.Equal(t, 0, LastIndexByte([]byte("/"), '/'))
+	require.Equal(t, 8, LastIndexByte([]byte("/usr/lib/x"), '/'))
+
+	// A single match at every position, with a decoy earlier in the buffer:
+	// covers the scalar tail, each mask word and each 4 KiB block edge.
+	for _, n := range []int{16, 63, 64, 65, 127, 128, 4096, 4097, 8192 + 70} {
+		data := bytes.Repeat([]byte{'.'}, n)
+		require.Equal(t, -1, LastIndexByte(data, '/'), "len=%d no match", n)
+		for at := 0; at < n; at++ {
+			data[at] = '/'
+			data[0] = '/'
+			require.Equal(t, at, LastIndexByte(data, '/'), "len=%d at=%d", n, at)
+			data[at], data[0] = '.', '.'
+		}
+	}
+}
+
+func scalarCountByte(data []byte, needle byte) int {
+	n := 0
+	for _, b := range data {
+		if b == needle {
+			n++
+		}
+	}
+	return n
+}
+
+func TestCountByte(t *testing.T) {
+	r := rand.New(rand.NewSource(9))
+	for _, n := range []int{0, 1, 15, 16, 17, 63, 64, 65, 127, 1000, 4096, 4097, 10000} {
+		data := make([]byte, n)
+		for i := range data {
+			data[i] = byte('a' + r.Intn(4))
+		}
+		require.Equal(t, scalarCountByte(data, 'a'), CountByte(data, 'a'), "len=%d", n)
+		require.Equal(t, 0, CountByte(data, 'z'), "len=%d absent", n)
+	}
+
+	// Matches only in the len%64 tail.
+	data := bytes.Repeat([]byte{'.'}, 64+63)
+	for i := 64; i < len(data); i++ {
+		data[i] = '\n'
+	}
+	require.Equal(t, 63, CountByte(data, '\n'))
+}
+
+var countSink int
+
+func BenchmarkCountByte(b *testing.B) {
+	for _, n := range []int{64, 1024, 65536} {
+		data := bytes.Repeat([]byte("0123456789abcde\n"), n/16)
+		b.Run(fmt.Sprintf("Scalar_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				countSink = scalarCountByte(data, '\n')
+			}
+		})
+		b.Run(fmt.Sprintf("BytesCount_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			sep := []byte{'\n'}
+			for i := 0; i < b.N; i++ {
+				countSink = bytes.Count(data, sep)
+			}
+		})
+		b.Run(fmt.Sprintf("Algo_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				countSink = CountByte(data, '\n')
+			}
+		})
+	}
+}
+
+func TestIndexAnyByte(t *testing.T) {
+	delims := MakeByteSet(',', ';', '\t')
+	require.Equal(t, -1, IndexAnyByte(nil, delims))
+	for _, n := range []int{1, 15, 16, 17, 31, 33, 63, 64, 65, 127, 129} {
+		data := bytes.Repeat([]byte("a"), n)
+		require.Equal(t, -1, IndexAnyByte(data, delims), "n=%d", n)
+		// Every position, including the len%lane tail, must be reported
+		// exactly; a later delimiter must not win.
+		for p := 0; p < n; p++ {
+			hit := append([]byte(nil), data...)
+			hit[p] = '\t'
+			hit[n-1] = ';'
+			require.Equal(t, p, IndexAnyByte(hit, delims), "n=%d pos=%d", n, p)
+		}
+	}
+}
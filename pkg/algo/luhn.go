@@ -0,0 +1,33 @@
+package algo
+
+import "github.com/miretskiy/simba/pkg/intrinsics"
+
+// LuhnValid reports whether digits, a string of ASCII digits such as a card
+// number, passes the Luhn (mod 10) check: doubling every second digit from
+// the right (subtracting 9 when the result exceeds 9) and summing must give a
+// multiple of 10.  Any non-digit byte, including spaces or dashes, fails the
+// check, as does empty input.  Strip separators before calling.
+//
+// Numbers shorter than the SIMD threshold are checked with a scalar loop.
+func LuhnValid(digits []byte) bool {
+	if len(digits) >= simdThreshold {
+		return intrinsics.LuhnValid(digits)
+	}
+	if len(digits) == 0 {
+		return false
+	}
+	sum := 0
+	for k := 0; k < len(digits); k++ {
+		d := int(digits[len(digits)-1-k]) - '0'
+		if d < 0 || d > 9 {
+			return false
+		}
+		if k&1 == 1 {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
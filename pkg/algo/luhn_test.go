@@ -0,0 +1,66 @@
+package algo
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func scalarLuhn(s string) bool {
+	if s == "" {
+		return false
+	}
+	sum := 0
+	double := false
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+		d := int(s[i] - '0')
+		if double {
+			d = d*2/10 + d*2%10
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+func TestLuhnValid(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want bool
+	}{
+		{"", false},
+		{"0", true},
+		{"79927398713", true},
+		{"79927398710", false},
+		{"4111111111111111", true},
+		{"4111111111111112", false},
+		{"378282246310005", true},
+		{"6011111111111117", true},
+		{"4111 1111 1111 1111", false},
+		{"4111-1111-1111-1111", false},
+		{"411111111111111a", false},
+		{strings.Repeat("0", 100) + "18", true},
+	} {
+		require.Equal(t, tc.want, LuhnValid([]byte(tc.in)), "%q", tc.in)
+		require.Equal(t, tc.want, scalarLuhn(tc.in), "scalar %q", tc.in)
+	}
+
+	r := rand.New(rand.NewSource(9))
+	for _, n := range []int{1, 12, 15, 16, 17, 19, 31, 32, 33, 63, 64, 65, 200} {
+		for trial := 0; trial < 20; trial++ {
+			b := make([]byte, n)
+			for i := range b {
+				b[i] = '0' + byte(r.Intn(10))
+			}
+			if trial == 0 && n > 1 {
+				b[r.Intn(n)] = "x /:"[r.Intn(4)]
+			}
+			require.Equal(t, scalarLuhn(string(b)), LuhnValid(b), "%s", b)
+		}
+	}
+}
@@ -10,13 +10,22 @@ const simdLUTThreshold = 16
 // lookup table. For tiny slices it uses an inlined scalar loop; for longer
 // inputs the SIMD-accelerated FFI path is used.
 func AllBytesInSet(data []byte, lut *ByteSet) bool {
+	return defaultDispatcher.Load().AllBytesInSet(data, lut)
+}
+
+// AllBytesInRange reports whether every byte in data lies in the inclusive
+// range [lo, hi], e.g. AllBytesInRange(data, 0x20, 0x7E) for printable ASCII.
+// It is cheaper than AllBytesInSet when the allowed bytes are contiguous, as
+// no lookup table is involved.  If lo > hi the range is empty and only empty
+// data passes.  Inputs shorter than the LUT threshold use a scalar loop.
+func AllBytesInRange(data []byte, lo, hi byte) bool {
 	if len(data) < simdLUTThreshold {
 		for _, b := range data {
-			if (*lut)[b] == 0 {
+			if b < lo || b > hi {
 				return false
 			}
 		}
 		return true
 	}
-	return intrinsics.AllBytesInSet(data, (*[256]byte)(lut))
+	return intrinsics.AllBytesInRange(data, lo, hi)
 }
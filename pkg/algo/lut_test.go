@@ -75,3 +75,70 @@ func BenchmarkAllBytesInSet(b *testing.B) {
 		})
 	}
 }
+
+// printableLUT admits 0x20..0x7E, the range used by the AllBytesInRange tests.
+var printableLUT = func() *ByteSet {
+	var s ByteSet
+	for i := 0x20; i <= 0x7E; i++ {
+		s[i] = 1
+	}
+	return &s
+}()
+
+func TestAllBytesInRange(t *testing.T) {
+	if !AllBytesInRange(nil, 0x20, 0x7E) || !AllBytesInRange(nil, 9, 1) {
+		t.Fatal("empty input must be in range")
+	}
+	for _, n := range []int{1, 15, 16, 31, 32, 63, 64, 65, 200} {
+		buf := make([]byte, n)
+		for i := range buf {
+			buf[i] = byte(0x20 + i%(0x7E-0x20+1))
+		}
+		buf[0], buf[n-1] = 0x20, 0x7E
+		if !AllBytesInRange(buf, 0x20, 0x7E) {
+			t.Fatalf("n=%d: boundary bytes rejected", n)
+		}
+		if AllBytesInRange(buf, 0x7E, 0x20) {
+			t.Fatalf("n=%d: lo > hi accepted", n)
+		}
+		for _, pos := range []int{0, n / 2, n - 1} {
+			for _, bad := range []byte{0x1F, 0x7F, 0x00, 0xFF} {
+				old := buf[pos]
+				buf[pos] = bad
+				got := AllBytesInRange(buf, 0x20, 0x7E)
+				if want := scalarAllBytesInSet(buf, (*[256]byte)(printableLUT)); got != want || got {
+					t.Fatalf("n=%d pos=%d bad=%#x: got %v want %v", n, pos, bad, got, want)
+				}
+				buf[pos] = old
+			}
+		}
+		full := make([]byte, n)
+		for i := range full {
+			full[i] = byte(i)
+		}
+		if !AllBytesInRange(full, 0, 0xFF) {
+			t.Fatalf("n=%d: full range rejected", n)
+		}
+	}
+}
+
+func BenchmarkAllBytesInRange(b *testing.B) {
+	for _, sz := range []int{15, 64, 1024, 1 << 16} {
+		buf := make([]byte, sz)
+		for i := range buf {
+			buf[i] = byte(0x20 + i%(0x7E-0x20+1))
+		}
+		b.Run(fmt.Sprintf("size=%d/Range", sz), func(b *testing.B) {
+			b.SetBytes(int64(sz))
+			for i := 0; i < b.N; i++ {
+				AllBytesInRange(buf, 0x20, 0x7E)
+			}
+		})
+		b.Run(fmt.Sprintf("size=%d/LUT", sz), func(b *testing.B) {
+			b.SetBytes(int64(sz))
+			for i := 0; i < b.N; i++ {
+				AllBytesInSet(buf, printableLUT)
+			}
+		})
+	}
+}
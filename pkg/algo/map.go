@@ -31,6 +31,10 @@ const simdMapThreshold = 16
 // and returns the number of bytes written, matching the semantics of the
 // built-in copy.  It processes up to min(len(src), len(dst)) bytes and never
 // panics on length mismatch.
+//
+// dst may be src itself for in-place mapping.  Unlike copy, any other overlap
+// of the processed bytes panics, on the scalar and SIMD paths alike: the
+// chunked kernel would read bytes it had already rewritten.
 func MapBytes(dst, src []byte, lut *ByteSet) int {
 	n := len(src)
 	if len(dst) < n {
@@ -39,6 +43,9 @@ func MapBytes(dst, src []byte, lut *ByteSet) int {
 	if n == 0 {
 		return 0
 	}
+	if intrinsics.PartialOverlap(dst[:n], src[:n]) {
+		panic("algo: MapBytes dst partially overlaps src")
+	}
 
 	// Fast path for tiny slices.
 	if n < simdMapThreshold {
@@ -51,3 +58,96 @@ func MapBytes(dst, src []byte, lut *ByteSet) int {
 	intrinsics.MapBytes(dst[:n], src[:n], (*[256]byte)(lut))
 	return n
 }
+
+// FilterBytes copies the bytes of src whose keep entry is non-zero into dst,
+// dropping the rest, and returns the compacted length.  It is the byte-level
+// analogue of strings.Map with a mapping that returns -1 to drop: it works on
+// individual bytes only, so multi-byte UTF-8 runes cannot be kept or dropped
+// as a unit.
+//
+// Like MapBytes it follows copy-like semantics: only the first
+// min(len(src), len(dst)) bytes of src are filtered and it never panics on a
+// length mismatch.  dst may be src itself for in-place filtering; any other
+// overlap of the processed bytes panics, as in MapBytes.
+func FilterBytes(dst, src []byte, keep *ByteSet) int {
+	n := len(src)
+	if len(dst) < n {
+		n = len(dst)
+	}
+	if n == 0 {
+		return 0
+	}
+	if intrinsics.PartialOverlap(dst[:n], src[:n]) {
+		panic("algo: FilterBytes dst partially overlaps src")
+	}
+
+	if n < simdMapThreshold {
+		out := 0
+		for _, b := range src[:n] {
+			if (*keep)[b] != 0 {
+				dst[out] = b
+				out++
+			}
+		}
+		return out
+	}
+
+	return intrinsics.FilterBytes(dst[:n], src[:n], (*[256]byte)(keep))
+}
+
+// ValidateAndLower maps src through lower into dst while checking every byte
+// against allowed, in one pass instead of an AllBytesInSet scan followed by
+// MapBytes.  lower is usually a case-folding table, but any ByteSet mapping
+// works.  It stops at the first byte whose allowed entry is zero: ok is false
+// and n is that byte's offset, with dst[:n] holding the mapped bytes before
+// it and the rest of dst untouched.  Otherwise ok is true and n bytes were
+// mapped.
+//
+// Like MapBytes it follows copy-like semantics: only the first
+// min(len(src), len(dst)) bytes of src are examined, so ok says nothing
+// about bytes past len(dst).  dst may be src itself; any other overlap of
+// the processed bytes panics.
+func ValidateAndLower(dst, src []byte, allowed, lower *ByteSet) (ok bool, n int) {
+	n = min(len(src), len(dst))
+	if n == 0 {
+		return true, 0
+	}
+	if intrinsics.PartialOverlap(dst[:n], src[:n]) {
+		panic("algo: ValidateAndLower dst partially overlaps src")
+	}
+
+	if n < simdMapThreshold {
+		for i, b := range src[:n] {
+			if (*allowed)[b] == 0 {
+				return false, i
+			}
+			dst[i] = (*lower)[b]
+		}
+		return true, n
+	}
+
+	m := intrinsics.ValidateMapBytes(dst[:n], src[:n], (*[256]byte)(allowed), (*[256]byte)(lower))
+	return m == n, m
+}
+
+// ReplaceByte rewrites every occurrence of old in data as new, in place, and
+// returns the number of bytes replaced, so a zero result means data is
+// unchanged.  Unlike MapBytes with a one-slot LUT it needs no table: the
+// kernel blends new into each chunk under the equality mask.  When old ==
+// new data is left untouched and the occurrences of old are counted.
+func ReplaceByte(data []byte, old, new byte) int {
+	if old == new {
+		return CountByte(data, old)
+	}
+	if len(data) < simdMapThreshold {
+		n := 0
+		for i, b := range data {
+			if b == old {
+				data[i] = new
+				n++
+			}
+		}
+		return n
+	}
+	return intrinsics.ReplaceByte(data, old, new)
+}
@@ -0,0 +1,80 @@
+package algo
+
+import "testing"
+
+func scalarFilterBytes(src []byte, keep *ByteSet) []byte {
+	var out []byte
+	for _, b := range src {
+		if keep[b] != 0 {
+			out = append(out, b)
+		}
+	}
+	return out
+}
+
+func FuzzFilterBytes(f *testing.F) {
+	f.Add([]byte(""), byte(' '))
+	f.Add([]byte("a b c"), byte(' '))
+	f.Add([]byte("0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef!"), byte('a'))
+	f.Add(make([]byte, 100), byte(0))
+
+	f.Fuzz(func(t *testing.T, src []byte, drop byte) {
+		var keep ByteSet
+		for i := range keep {
+			keep[i] = 1
+		}
+		keep[drop] = 0
+		keep[drop^0x20] = 0
+
+		want := scalarFilterBytes(src, &keep)
+
+		dst := make([]byte, len(src))
+		n := FilterBytes(dst, src, &keep)
+		if string(dst[:n]) != string(want) {
+			t.Fatalf("FilterBytes(%q) = %q, want %q", src, dst[:n], want)
+		}
+
+		inPlace := append([]byte(nil), src...)
+		n = FilterBytes(inPlace, inPlace, &keep)
+		if string(inPlace[:n]) != string(want) {
+			t.Fatalf("in-place FilterBytes(%q) = %q, want %q", src, inPlace[:n], want)
+		}
+	})
+}
+
+// FuzzMapBytes maps a window of buf into another window of the same buffer:
+// partial overlaps must panic, everything else must match a scalar map.
+func FuzzMapBytes(f *testing.F) {
+	f.Add([]byte("Hello, World! 0123456789 ABCDEFGHIJKLMNOPQRSTUVWXYZ abcdefghijklmnop"), uint8(0), uint8(1), uint8(40))
+	f.Add([]byte("ABCDEFGHIJKLMNOPQRSTUVWXYZ"), uint8(5), uint8(5), uint8(20))
+	f.Add(make([]byte, 200), uint8(100), uint8(0), uint8(100))
+
+	f.Fuzz(func(t *testing.T, buf []byte, dstOff, srcOff, n uint8) {
+		if int(dstOff) > len(buf) || int(srcOff) > len(buf) {
+			return
+		}
+		dst, src := buf[dstOff:], buf[srcOff:]
+		m := min(len(dst), len(src), int(n))
+		dst, src = dst[:m], src[:m]
+
+		want := make([]byte, m)
+		for i, b := range src {
+			want[i] = asciiLower[b]
+		}
+		overlap := m > 0 && dstOff != srcOff && max(dstOff, srcOff)-min(dstOff, srcOff) < uint8(m)
+
+		var panicked bool
+		func() {
+			defer func() { panicked = recover() != nil }()
+			if got := MapBytes(dst, src, asciiLower); got != m {
+				t.Fatalf("MapBytes returned %d, want %d", got, m)
+			}
+		}()
+		if panicked != overlap {
+			t.Fatalf("dstOff=%d srcOff=%d n=%d: panicked=%v, want %v", dstOff, srcOff, m, panicked, overlap)
+		}
+		if !overlap && string(dst) != string(want) {
+			t.Fatalf("dstOff=%d srcOff=%d n=%d: got %q, want %q", dstOff, srcOff, m, dst, want)
+		}
+	})
+}
@@ -1,23 +1,14 @@
 package algo
 
-import "testing"
-
-// build lower-case LUT once
-var lowerLUT = func() *[256]byte {
-	var t [256]byte
-	for i := 0; i < 256; i++ {
-		t[i] = byte(i)
-	}
-	for c := byte('A'); c <= 'Z'; c++ {
-		t[c] = c + ('a' - 'A')
-	}
-	return &t
-}()
+import (
+	"bytes"
+	"testing"
+)
 
 func TestMapBytesLowercase(t *testing.T) {
 	src := []byte("HeLLo_World123")
 	dst := make([]byte, len(src))
-	n := MapBytes(dst, src, lowerLUT)
+	n := MapBytes(dst, src, asciiLower)
 	if n != len(src) {
 		t.Fatalf("expected %d bytes written, got %d", len(src), n)
 	}
@@ -30,7 +21,7 @@ func TestMapBytesLowercase(t *testing.T) {
 func TestMapBytesShortDst(t *testing.T) {
 	src := []byte("ABCDE")
 	dst := make([]byte, 3)
-	n := MapBytes(dst, src, lowerLUT)
+	n := MapBytes(dst, src, asciiLower)
 	if n != 3 {
 		t.Fatalf("expected 3 bytes written, got %d", n)
 	}
@@ -38,3 +29,154 @@ func TestMapBytesShortDst(t *testing.T) {
 		t.Fatalf("unexpected dst %q", string(dst))
 	}
 }
+
+// mapPanics reports whether MapBytes panicked.
+func mapPanics(dst, src []byte) (panicked bool) {
+	defer func() { panicked = recover() != nil }()
+	MapBytes(dst, src, asciiLower)
+	return false
+}
+
+func TestMapBytesOverlap(t *testing.T) {
+	for _, n := range []int{8, 100} { // scalar and SIMD paths
+		buf := bytes.Repeat([]byte("AbC"), n/3+2)
+
+		// dst one byte ahead of src, and one byte behind.
+		if !mapPanics(buf[1:n+1], buf[:n]) {
+			t.Fatalf("n=%d: dst = src+1 did not panic", n)
+		}
+		if !mapPanics(buf[:n], buf[1:n+1]) {
+			t.Fatalf("n=%d: dst = src-1 did not panic", n)
+		}
+
+		// Identical slices map in place.
+		want := bytes.ToLower(buf[:n])
+		if mapPanics(buf[:n], buf[:n]) || !bytes.Equal(buf[:n], want) {
+			t.Fatalf("n=%d: in-place mapping gave %q, want %q", n, buf[:n], want)
+		}
+
+		// Adjacent, non-overlapping halves are fine, as is an overlap past
+		// the min(len(dst), len(src)) bytes actually processed.
+		if mapPanics(buf[n/2:n], buf[:n/2]) {
+			t.Fatalf("n=%d: adjacent slices panicked", n)
+		}
+		if mapPanics(buf[:2], buf[2:]) {
+			t.Fatalf("n=%d: overlap beyond the processed prefix panicked", n)
+		}
+	}
+}
+
+// filterPanics reports whether FilterBytes panicked.
+func filterPanics(dst, src []byte) (panicked bool) {
+	defer func() { panicked = recover() != nil }()
+	keep := MakeByteSet('A', 'C')
+	FilterBytes(dst, src, keep)
+	return false
+}
+
+func TestFilterBytesOverlap(t *testing.T) {
+	for _, n := range []int{8, 100} { // scalar and SIMD paths
+		buf := bytes.Repeat([]byte("AbC"), n/3+2)
+
+		if !filterPanics(buf[1:n+1], buf[:n]) {
+			t.Fatalf("n=%d: dst = src+1 did not panic", n)
+		}
+		if !filterPanics(buf[:n], buf[1:n+1]) {
+			t.Fatalf("n=%d: dst = src-1 did not panic", n)
+		}
+		if filterPanics(buf[:n], buf[:n]) {
+			t.Fatalf("n=%d: in-place filtering panicked", n)
+		}
+		if filterPanics(buf[n/2:n], buf[:n/2]) {
+			t.Fatalf("n=%d: adjacent slices panicked", n)
+		}
+	}
+}
+
+func TestFilterBytesShortDst(t *testing.T) {
+	keep := MakeByteSet('a', 'b', 'c')
+	dst := make([]byte, 4)
+	// Only the first len(dst) bytes of src are considered.
+	n := FilterBytes(dst, []byte("a-b-c-"), keep)
+	if got := string(dst[:n]); got != "ab" {
+		t.Fatalf("unexpected filtered output %q", got)
+	}
+}
+
+func TestValidateAndLower(t *testing.T) {
+	allowed := MakeByteSet([]byte("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789_.")...)
+	for _, n := range []int{1, 5, 15, 16, 17, 31, 32, 63, 64, 65, 200, 1000} {
+		src := bytes.Repeat([]byte("Host.Name_42"), n/12+1)[:n]
+		want := bytes.ToLower(src)
+
+		// Every byte allowed: the whole input is lowered.
+		dst := make([]byte, n)
+		ok, got := ValidateAndLower(dst, src, allowed, asciiLower)
+		if !ok || got != n || !bytes.Equal(dst, want) {
+			t.Fatalf("n=%d: got (%v, %d) %q, want (true, %d) %q", n, ok, got, dst, n, want)
+		}
+
+		// A disallowed byte mid-buffer stops the pass at its offset, with
+		// the prefix lowered and the rest of dst untouched.
+		for _, bad := range []int{0, n / 2, n - 1} {
+			in := bytes.Clone(src)
+			in[bad] = '-'
+			dst := bytes.Repeat([]byte{'#'}, n)
+			ok, got := ValidateAndLower(dst, in, allowed, asciiLower)
+			if ok || got != bad {
+				t.Fatalf("n=%d bad=%d: got (%v, %d)", n, bad, ok, got)
+			}
+			if !bytes.Equal(dst[:bad], want[:bad]) || bytes.Count(dst[bad:], []byte{'#'}) != n-bad {
+				t.Fatalf("n=%d bad=%d: dst %q", n, bad, dst)
+			}
+
+			// In place.
+			ok, got = ValidateAndLower(in, in, allowed, asciiLower)
+			if ok || got != bad || !bytes.Equal(in[:bad], want[:bad]) || in[bad] != '-' {
+				t.Fatalf("n=%d bad=%d: in place got (%v, %d) %q", n, bad, ok, got, in)
+			}
+		}
+	}
+
+	// Only min(len(src), len(dst)) bytes are examined.
+	dst := make([]byte, 3)
+	if ok, n := ValidateAndLower(dst, []byte("ABC-"), allowed, asciiLower); !ok || n != 3 || string(dst) != "abc" {
+		t.Fatalf("short dst: got (%v, %d) %q", ok, n, dst)
+	}
+	if ok, n := ValidateAndLower(nil, []byte("-"), allowed, asciiLower); !ok || n != 0 {
+		t.Fatalf("empty dst: got (%v, %d)", ok, n)
+	}
+}
+
+func TestReplaceByte(t *testing.T) {
+	// Lengths straddle the scalar threshold and leave len%lane tails for
+	// every kernel width.
+	for _, n := range []int{0, 1, 15, 16, 17, 31, 33, 63, 64, 65, 127, 255*64 + 7} {
+		src := make([]byte, n)
+		for i := range src {
+			src[i] = byte(i * 7 % 11)
+		}
+		want := bytes.ReplaceAll(src, []byte{3}, []byte{'x'})
+		got := append([]byte(nil), src...)
+		if c := ReplaceByte(got, 3, 'x'); c != bytes.Count(src, []byte{3}) {
+			t.Fatalf("n=%d: replaced %d, want %d", n, c, bytes.Count(src, []byte{3}))
+		}
+		if !bytes.Equal(got, want) {
+			t.Fatalf("n=%d: got %v, want %v", n, got, want)
+		}
+
+		// No match leaves the data unchanged.
+		if c := ReplaceByte(got, 3, 'y'); c != 0 || !bytes.Equal(got, want) {
+			t.Fatalf("n=%d: second pass replaced %d", n, c)
+		}
+
+		// All match.
+		all := bytes.Repeat([]byte{'a'}, n)
+		if c := ReplaceByte(all, 'a', 'b'); c != n || !bytes.Equal(all, bytes.Repeat([]byte{'b'}, n)) {
+			t.Fatalf("n=%d: all-match replaced %d, data %q", n, c, all)
+		}
+		if c := ReplaceByte(all, 'b', 'b'); c != n {
+			t.Fatalf("n=%d: old == new counted %d", n, c)
+		}
+	}
+}
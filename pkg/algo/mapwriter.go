@@ -0,0 +1,50 @@
+package algo
+
+import (
+	"io"
+	"sync"
+)
+
+// mapWriterBufSize is the size of the scratch buffer a MapWriter maps into;
+// longer writes are mapped and forwarded in pieces of this size.
+const mapWriterBufSize = 32 << 10
+
+var mapWriterBufPool = sync.Pool{New: func() any { return new([mapWriterBufSize]byte) }}
+
+type mapWriter struct {
+	w   io.Writer
+	lut *ByteSet
+}
+
+// NewMapWriter returns an io.Writer that maps every byte written to it
+// through lut, as MapBytes does, and writes the result to w, e.g. to turn
+// control characters into '.' on the way to a terminal.  p itself is never
+// modified: each Write maps into a scratch buffer taken from a shared pool,
+// so steady-state writes do not allocate.
+//
+// The output depends only on the concatenated input, not on how it was split
+// into writes.  Write follows io.Writer: it reports how many bytes of p were
+// mapped and accepted by w, and a short write by w without an error is
+// returned as io.ErrShortWrite.
+func NewMapWriter(w io.Writer, lut *ByteSet) io.Writer {
+	return &mapWriter{w: w, lut: lut}
+}
+
+func (m *mapWriter) Write(p []byte) (int, error) {
+	buf := mapWriterBufPool.Get().(*[mapWriterBufSize]byte)
+	defer mapWriterBufPool.Put(buf)
+
+	written := 0
+	for written < len(p) {
+		n := MapBytes(buf[:], p[written:], m.lut)
+		wn, err := m.w.Write(buf[:n])
+		written += wn
+		if err != nil {
+			return written, err
+		}
+		if wn != n {
+			return written, io.ErrShortWrite
+		}
+	}
+	return written, nil
+}
@@ -0,0 +1,87 @@
+package algo
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// limitWriter accepts up to n bytes and then fails with err, or reports a
+// short write if err is nil.
+type limitWriter struct {
+	buf bytes.Buffer
+	n   int
+	err error
+}
+
+func (w *limitWriter) Write(p []byte) (int, error) {
+	if len(p) <= w.n {
+		w.n -= len(p)
+		return w.buf.Write(p)
+	}
+	k := w.n
+	w.n = 0
+	w.buf.Write(p[:k])
+	return k, w.err
+}
+
+func TestMapWriter(t *testing.T) {
+	// Control characters become '.', everything else passes through.
+	var lut ByteSet
+	for i := range lut {
+		lut[i] = byte(i)
+		if i < ' ' || i == 0x7f {
+			lut[i] = '.'
+		}
+	}
+
+	r := rand.New(rand.NewSource(31))
+	data := make([]byte, 3*mapWriterBufSize+1000)
+	r.Read(data)
+	want := make([]byte, len(data))
+	MapBytes(want, data, &lut)
+	orig := bytes.Clone(data)
+
+	var out bytes.Buffer
+	w := NewMapWriter(&out, &lut)
+	for rest := data; len(rest) > 0; {
+		// Sizes below the SIMD threshold, around a chunk, and past the
+		// scratch buffer.
+		k := min(len(rest), []int{0, 1, 7, 64, 100, 4096, mapWriterBufSize + 3}[r.Intn(7)])
+		n, err := w.Write(rest[:k])
+		require.NoError(t, err)
+		require.Equal(t, k, n)
+		rest = rest[k:]
+	}
+	require.Equal(t, want, out.Bytes())
+	require.Equal(t, orig, data, "input must not be modified")
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = NewMapWriter(io.Discard, &lut).Write(data[:5000])
+	})
+	require.LessOrEqual(t, allocs, 1.0, "only the writer itself may allocate")
+
+	errBoom := errors.New("boom")
+	for _, tc := range []struct {
+		limit int
+		err   error
+	}{
+		{10, errBoom},
+		{mapWriterBufSize + 10, errBoom},
+		{10, nil},
+	} {
+		lw := &limitWriter{n: tc.limit, err: tc.err}
+		n, err := NewMapWriter(lw, &lut).Write(data)
+		require.Equal(t, tc.limit, n)
+		require.Equal(t, want[:tc.limit], lw.buf.Bytes())
+		if tc.err != nil {
+			require.ErrorIs(t, err, tc.err)
+		} else {
+			require.ErrorIs(t, err, io.ErrShortWrite)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package algo
+
+import (
+	"math/bits"
+
+	"github.com/miretskiy/simba/pkg/intrinsics"
+)
+
+// stripCRBlock is how many bytes StripCR feeds to the mask kernels at a time;
+// 4 KiB keeps the two mask arrays (64 words each) on the stack.
+const stripCRBlock = 4096
+
+// StripCR copies src into dst with every '\r' that immediately precedes a
+// '\n' removed, normalising CRLF line endings to LF.  A lone '\r' is kept.
+// It returns the number of bytes written.
+//
+// Like FilterBytes it follows copy-like semantics: only the first
+// min(len(src), len(dst)) bytes of src are processed, so a '\r' that ends
+// that prefix is kept even if src continues with '\n'.  dst may be src itself
+// for in-place normalisation.
+//
+// Inputs of 64 bytes or more are scanned with the 64-lane equality-mask
+// kernel: a CR bit is dropped when the LF mask has the following bit set,
+// with the LF test for bit 63 looking at the first byte of the next chunk.
+// Runs of kept bytes are moved with a single copy each.
+func StripCR(dst, src []byte) int {
+	n := len(src)
+	if len(dst) < n {
+		n = len(dst)
+	}
+	src = src[:n]
+
+	out, run := 0, 0
+	drop := func(pos int) {
+		out += copy(dst[out:], src[run:pos])
+		run = pos + 1
+	}
+
+	var crMasks, lfMasks [stripCRBlock / 64]uint64
+	full := n &^ 63
+	for base := 0; base < full; base += stripCRBlock {
+		block := src[base:min(base+stripCRBlock, full)]
+		intrinsics.EqU8Masks64(block, '\r', crMasks[:])
+		intrinsics.EqU8Masks64(block, '\n', lfMasks[:])
+		for w := 0; w < len(block)/64; w++ {
+			cr := crMasks[w]
+			if cr == 0 {
+				continue
+			}
+			next := base + (w+1)*64
+			lf := lfMasks[w] >> 1
+			if next < n && src[next] == '\n' {
+				lf |= 1 << 63
+			}
+			for m := cr & lf; m != 0; m &= m - 1 {
+				drop(base + w*64 + bits.TrailingZeros64(m))
+			}
+		}
+	}
+
+	for i := full; i+1 < n; i++ {
+		if src[i] == '\r' && src[i+1] == '\n' {
+			drop(i)
+		}
+	}
+	out += copy(dst[out:], src[run:])
+	return out
+}
+
+// CountLines returns the number of '\n' bytes in data, the convention of
+// wc -l: a final line without a trailing newline is not counted.  Use
+// CountLinesPartial to count it as well.  The count kernel covers the whole
+// buffer, tail bytes included.
+func CountLines(data []byte) int {
+	return CountByte(data, '\n')
+}
+
+// CountLinesPartial returns the number of lines in data counting an
+// unterminated final line, and reports whether there is one.  Empty data has
+// no lines; "a\nb" has two, the second partial.
+func CountLinesPartial(data []byte) (lines int, partial bool) {
+	lines = CountLines(data)
+	if len(data) > 0 && data[len(data)-1] != '\n' {
+		return lines + 1, true
+	}
+	return lines, false
+}
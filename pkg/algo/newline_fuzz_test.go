@@ -0,0 +1,46 @@
+package algo
+
+import (
+	"bytes"
+	"testing"
+)
+
+func scalarStripCR(src []byte) []byte {
+	out := make([]byte, 0, len(src))
+	for i, b := range src {
+		if b == '\r' && i+1 < len(src) && src[i+1] == '\n' {
+			continue
+		}
+		out = append(out, b)
+	}
+	return out
+}
+
+func FuzzStripCR(f *testing.F) {
+	f.Add([]byte(""))
+	f.Add([]byte("a\r\nb\rc\n\r"))
+	f.Add(bytes.Repeat([]byte("line\r\n"), 30))
+	// CR as the last byte of a 64-byte chunk with its LF in the next one.
+	edge := bytes.Repeat([]byte("x"), 130)
+	edge[63], edge[64] = '\r', '\n'
+	edge[127], edge[128] = '\r', '\n'
+	f.Add(edge)
+	f.Add(bytes.Repeat([]byte("\r"), 64))
+	f.Add(bytes.Repeat([]byte("\r\n"), 2100))
+
+	f.Fuzz(func(t *testing.T, src []byte) {
+		want := scalarStripCR(src)
+
+		dst := make([]byte, len(src))
+		n := StripCR(dst, src)
+		if !bytes.Equal(dst[:n], want) {
+			t.Fatalf("StripCR(%q) = %q, want %q", src, dst[:n], want)
+		}
+
+		inPlace := append([]byte(nil), src...)
+		n = StripCR(inPlace, inPlace)
+		if !bytes.Equal(inPlace[:n], want) {
+			t.Fatalf("in-place StripCR(%q) = %q, want %q", src, inPlace[:n], want)
+		}
+	})
+}
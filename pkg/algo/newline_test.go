@@ -0,0 +1,82 @@
+package algo
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStripCR(t *testing.T) {
+	for _, tc := range []struct{ in, want string }{
+		{"", ""},
+		{"a\r\nb", "a\nb"},
+		{"lone\rcr", "lone\rcr"},
+		{"\r\r\n", "\r\n"},
+		{"end\r", "end\r"},
+	} {
+		dst := make([]byte, len(tc.in))
+		n := StripCR(dst, []byte(tc.in))
+		require.Equal(t, tc.want, string(dst[:n]), "%q", tc.in)
+	}
+
+	// CRLF pairs split across every 64-byte chunk edge and the 4 KiB block
+	// edge, plus one whose LF falls in the scalar tail.
+	src := bytes.Repeat([]byte("y"), 4096+100)
+	for _, edge := range []int{63, 127, 4095, 4095 + 64} {
+		src[edge], src[edge+1] = '\r', '\n'
+	}
+	dst := make([]byte, len(src))
+	n := StripCR(dst, src)
+	require.Equal(t, scalarStripCR(src), dst[:n])
+	require.Equal(t, len(src)-4, n)
+
+	// Copy semantics: a CR ending the processed prefix is kept.
+	short := make([]byte, 3)
+	require.Equal(t, 3, StripCR(short, []byte("ab\r\n")))
+	require.Equal(t, "ab\r", string(short))
+}
+
+func TestCountLines(t *testing.T) {
+	for _, tc := range []struct {
+		in      string
+		n, all  int
+		partial bool
+	}{
+		{"", 0, 0, false},
+		{"\n", 1, 1, false},
+		{"a", 0, 1, true},
+		{"a\nb", 1, 2, true},
+		{"a\nb\n", 2, 2, false},
+		{"\n\n\n", 3, 3, false},
+	} {
+		require.Equal(t, tc.n, CountLines([]byte(tc.in)), "%q", tc.in)
+		all, partial := CountLinesPartial([]byte(tc.in))
+		require.Equal(t, tc.all, all, "%q", tc.in)
+		require.Equal(t, tc.partial, partial, "%q", tc.in)
+	}
+
+	// Newlines in the bytes past the last whole 64-byte chunk are counted.
+	for _, n := range []int{15, 16, 63, 64, 65, 127, 1000} {
+		data := bytes.Repeat([]byte("ab\n"), n)[:n]
+		require.Equal(t, bytes.Count(data, []byte{'\n'}), CountLines(data), "n=%d", n)
+		data[n-1] = '\n'
+		require.Equal(t, bytes.Count(data, []byte{'\n'}), CountLines(data), "n=%d", n)
+	}
+}
+
+func BenchmarkCountLines(b *testing.B) {
+	data := bytes.Repeat([]byte("a log line of moderate length, about eighty bytes or so ...........\n"), 1<<14)
+	b.Run("simba", func(b *testing.B) {
+		b.SetBytes(int64(len(data)))
+		for i := 0; i < b.N; i++ {
+			_ = CountLines(data)
+		}
+	})
+	b.Run("bytes.Count", func(b *testing.B) {
+		b.SetBytes(int64(len(data)))
+		for i := 0; i < b.N; i++ {
+			_ = bytes.Count(data, []byte{'\n'})
+		}
+	})
+}
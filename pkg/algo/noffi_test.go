@@ -0,0 +1,155 @@
+package algo
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/miretskiy/simba/internal/ffi"
+	"github.com/stretchr/testify/require"
+)
+
+// algoResultsEnv names the file TestAlgoResults writes its report to.
+const algoResultsEnv = "SIMBA_ALGO_RESULTS"
+
+// algoResults runs a fixed set of inputs, straddling every SIMD threshold and
+// lane width, through the algo entry points and returns one line per call.
+// The report must not depend on the backend: TestNoFFIMatchesSIMD compares
+// the one from a simba_noffi build against the default one line by line.
+func algoResults() []string {
+	r := rand.New(rand.NewSource(1550))
+	var lines []string
+	add := func(name string, n int, v any) {
+		lines = append(lines, fmt.Sprintf("%s/%d: %v", name, n, v))
+	}
+
+	set := MakeByteSet([]byte("aeiou,;\n\x80\xff")...)
+	lower := MakeByteSet([]byte("abcdefghijklmnopqrstuvwxyz")...)
+	var upper ByteSet
+	for i := range upper {
+		upper[i] = byte(i)
+	}
+	for c := 'a'; c <= 'z'; c++ {
+		upper[c] = byte(c - 'a' + 'A')
+	}
+
+	for _, n := range []int{0, 1, 15, 16, 17, 31, 32, 33, 63, 64, 65, 100, 255, 256, 257, 1000, 4099} {
+		bin := make([]byte, n)
+		r.Read(bin)
+		text := make([]byte, n)
+		for i := range text {
+			text[i] = "abc de,f;g\nhi_j:k.0123-"[r.Intn(23)]
+		}
+		other := bytes.Clone(text)
+		if n > 0 {
+			other[r.Intn(n)] = 'Z'
+		}
+
+		for _, d := range []struct {
+			kind string
+			data []byte
+		}{{"bin", bin}, {"text", text}} {
+			data, k := d.data, d.kind
+			add(k+"/SumU8", n, SumU8(data))
+			add(k+"/SumU8Wide", n, SumU8Wide(data))
+			mn, ok := MinU8(data)
+			add(k+"/MinU8", n, fmt.Sprint(mn, ok))
+			mx, ok := MaxU8(data)
+			add(k+"/MaxU8", n, fmt.Sprint(mx, ok))
+			add(k+"/Histogram", n, *Histogram(data))
+			add(k+"/IsASCII", n, IsASCII(data))
+			add(k+"/FirstNonASCII", n, FirstNonASCII(data))
+			add(k+"/AllBytesInSet", n, AllBytesInSet(data, lower))
+			add(k+"/AllBytesInRange", n, AllBytesInRange(data, ' ', '~'))
+			add(k+"/ContainsAnyByte", n, ContainsAnyByte(data, set))
+			add(k+"/IndexAnyByte", n, IndexAnyByte(data, set))
+			add(k+"/IndexByte", n, IndexByte(data, ','))
+			add(k+"/LastIndexByte", n, LastIndexByte(data, ','))
+			add(k+"/CountByte", n, CountByte(data, ' '))
+			add(k+"/PopCount", n, PopCount(data))
+			add(k+"/LeadingRun", n, LeadingRun(data, 'a'))
+			add(k+"/TrailingRun", n, TrailingRun(data, 'a'))
+			add(k+"/CountLines", n, CountLines(data))
+			add(k+"/CRC32", n, CRC32(data))
+			add(k+"/CRC32IEEE", n, CRC32IEEE(data))
+			add(k+"/Fletcher16", n, Fletcher16(data))
+			add(k+"/Fletcher32", n, Fletcher32(data))
+			add(k+"/LuhnValid", n, LuhnValid(data))
+			add(k+"/SniffEncoding", n, SniffEncoding(data))
+			add(k+"/ValidateTagASCII", n, ValidateTagASCII(string(data)))
+
+			dst := make([]byte, Base64EncodedLen(n))
+			add(k+"/Base64Encode", n, string(dst[:Base64Encode(dst, data)]))
+			dst = make([]byte, 2*n)
+			add(k+"/HexEncode", n, string(dst[:HexEncode(dst, data)]))
+			dst = make([]byte, n)
+			add(k+"/MapBytes", n, dst[:MapBytes(dst, data, &upper)])
+			add(k+"/FilterBytes", n, dst[:FilterBytes(dst, data, lower)])
+			ok, m := ValidateAndLower(dst, data, lower, &upper)
+			add(k+"/ValidateAndLower", n, fmt.Sprint(ok, dst[:m]))
+			add(k+"/CollapseWhitespace", n, dst[:CollapseWhitespace(dst, data)])
+			add(k+"/XorBytes", n, dst[:XorBytes(dst, data, bin)])
+			add(k+"/AddU8Saturating", n, dst[:AddU8Saturating(dst, data, bin)])
+			add(k+"/DotU8", n, DotU8(data, bin))
+			add(k+"/Compare", n, Compare(data, other))
+			add(k+"/HasPrefix", n, HasPrefix(data, other[:n/2]))
+			add(k+"/EqualFoldASCII", n, fmt.Sprint(EqualFoldASCII(data, upperASCII(data)), EqualFoldASCII(data, other)))
+			rep := bytes.Clone(data)
+			add(k+"/ReplaceByte", n, fmt.Sprint(ReplaceByte(rep, ' ', '_'), rep))
+		}
+
+		// Small integers keep the float sums exact, so summation order does
+		// not matter.
+		fa, fb := make([]float32, n), make([]float32, n)
+		for i := range fa {
+			fa[i], fb[i] = float32(r.Intn(64)-32), float32(r.Intn(16))
+		}
+		add("DotF32", n, DotF32(fa, fb))
+	}
+	return lines
+}
+
+// TestAlgoResults writes the algoResults report to the file named by
+// SIMBA_ALGO_RESULTS; TestNoFFIMatchesSIMD runs it in a simba_noffi build.
+func TestAlgoResults(t *testing.T) {
+	path := os.Getenv(algoResultsEnv)
+	if path == "" {
+		t.Skip(algoResultsEnv + " not set")
+	}
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(algoResults(), "\n")), 0o644))
+}
+
+// TestNoFFIMatchesSIMD checks that the pure-Go simba_noffi build computes
+// exactly what the Rust kernels do, by rebuilding this package's tests with
+// the tag and diffing the two reports.
+func TestNoFFIMatchesSIMD(t *testing.T) {
+	if testing.Short() {
+		t.Skip("rebuilds the package with -tags simba_noffi")
+	}
+	if ffi.Backend == "go" {
+		t.Skip("already running the simba_noffi build")
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+
+	path := filepath.Join(t.TempDir(), "noffi.txt")
+	cmd := exec.Command(goBin, "test", "-count=1", "-tags", "simba_noffi", "-run", "^TestAlgoResults$", ".")
+	cmd.Env = append(os.Environ(), algoResultsEnv+"="+path)
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "%s", out)
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	got, want := strings.Split(string(raw), "\n"), algoResults()
+	require.Len(t, got, len(want))
+	for i := range want {
+		require.Equal(t, want[i], got[i], "line %d", i)
+	}
+}
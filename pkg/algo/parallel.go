@@ -0,0 +1,133 @@
+package algo
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// parallelMinSegment is the smallest segment handed to a worker.  Below it
+// the goroutine start-up cost outweighs the kernel time, so short inputs use
+// fewer workers than requested, down to a serial call.
+const parallelMinSegment = 256 << 10
+
+// parallelBlock is how many bytes a worker processes between checks of its
+// context.  It bounds the latency of a cancellation to roughly one kernel
+// call over 1 MiB.
+const parallelBlock = 1 << 20
+
+// segmentLen returns the length of each of the segments that split n bytes
+// across at most workers goroutines: a multiple of 64, so every segment but
+// the last covers whole chunks of the widest kernel, and no shorter than
+// parallelMinSegment.  workers <= 0 means GOMAXPROCS.
+func segmentLen(n, workers int) int {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	seg := (n + workers - 1) / workers
+	seg = (seg + 63) &^ 63
+	return max(seg, parallelMinSegment)
+}
+
+// segmentCount returns how many segments of length seg cover n bytes; empty
+// input still forms one (empty) segment.
+func segmentCount(n, seg int) int {
+	return max((n+seg-1)/seg, 1)
+}
+
+// forEachBlock feeds segment i of data (segments of length seg) to fn in
+// order, in blocks of at most parallelBlock bytes, with one goroutine per
+// segment; a single segment runs on the calling goroutine.  ctx is checked
+// before every block, and once it is done the remaining blocks are skipped
+// and its error returned.
+func forEachBlock(ctx context.Context, data []byte, seg int, fn func(i int, block []byte)) error {
+	var cancelled atomic.Bool
+	run := func(i int, part []byte) {
+		for len(part) > 0 {
+			if ctx.Err() != nil {
+				cancelled.Store(true)
+				return
+			}
+			n := min(len(part), parallelBlock)
+			fn(i, part[:n])
+			part = part[n:]
+		}
+	}
+
+	if n := segmentCount(len(data), seg); n == 1 {
+		run(0, data)
+	} else {
+		var wg sync.WaitGroup
+		for i := 0; i < n; i++ {
+			part := data[i*seg : min((i+1)*seg, len(data))]
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				run(i, part)
+			}()
+		}
+		wg.Wait()
+	}
+	if cancelled.Load() {
+		return ctx.Err()
+	}
+	return nil
+}
+
+// SumU8Parallel returns the same wrapping uint32 sum as SumU8, computed by
+// up to workers goroutines, each summing its own 64-byte-aligned segment of
+// data.  workers <= 0 uses GOMAXPROCS.  It pays off for inputs of many
+// megabytes; inputs shorter than two segments of 256 KiB are summed on the
+// calling goroutine.
+func SumU8Parallel(data []byte, workers int) uint32 {
+	sum, _ := SumU8ParallelCtx(context.Background(), data, workers)
+	return sum
+}
+
+// SumU8ParallelCtx is SumU8Parallel with cancellation: every worker checks
+// ctx between 1 MiB blocks.  If ctx is done before the scan completes it
+// returns 0 and ctx.Err(); the partial sum is discarded.
+func SumU8ParallelCtx(ctx context.Context, data []byte, workers int) (uint32, error) {
+	seg := segmentLen(len(data), workers)
+	partial := make([]uint32, segmentCount(len(data), seg))
+	err := forEachBlock(ctx, data, seg, func(i int, block []byte) {
+		partial[i] += SumU8(block)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var total uint32
+	for _, s := range partial {
+		total += s
+	}
+	return total, nil
+}
+
+// CRC32Parallel returns the same CRC32C as CRC32, computed by up to workers
+// goroutines over segments split as for SumU8Parallel.  The segment
+// digests are folded left to right with CRC32Combine, each shifted past the
+// length of the segment that follows it.  workers <= 0 uses GOMAXPROCS.
+func CRC32Parallel(data []byte, workers int) uint32 {
+	crc, _ := CRC32ParallelCtx(context.Background(), data, workers)
+	return crc
+}
+
+// CRC32ParallelCtx is CRC32Parallel with cancellation, with the same
+// semantics as SumU8ParallelCtx: on cancellation it returns 0 and
+// ctx.Err().
+func CRC32ParallelCtx(ctx context.Context, data []byte, workers int) (uint32, error) {
+	seg := segmentLen(len(data), workers)
+	n := segmentCount(len(data), seg)
+	crcs := make([]uint32, n)
+	lens := make([]int, n)
+	err := forEachBlock(ctx, data, seg, func(i int, block []byte) {
+		crcs[i] = CRC32Update(block, crcs[i])
+		lens[i] += len(block)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return CRC32CombineAll(crcs, lens), nil
+}
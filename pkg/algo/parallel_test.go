@@ -0,0 +1,138 @@
+package algo
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSegmentLen(t *testing.T) {
+	require.Equal(t, parallelMinSegment, segmentLen(100, 4))
+	seg := segmentLen(10<<20+1, 3)
+	require.Zero(t, seg%64)
+	require.GreaterOrEqual(t, 3*seg, 10<<20+1)
+	require.Less(t, 3*(seg-64), 10<<20+1)
+	require.Positive(t, segmentLen(64<<20, 0))
+}
+
+func TestSumU8Parallel(t *testing.T) {
+	r := rand.New(rand.NewSource(44))
+	data := make([]byte, 3<<20+777)
+	r.Read(data)
+
+	for _, n := range []int{0, 1, 63, 1000, parallelMinSegment + 1, 2*parallelMinSegment + 5, len(data)} {
+		want := SumU8(data[:n])
+		for _, workers := range []int{-1, 0, 1, 2, 3, 7, 64} {
+			require.Equal(t, want, SumU8Parallel(data[:n], workers), "n=%d workers=%d", n, workers)
+		}
+	}
+
+	// The uint32 result wraps exactly like SumU8.
+	big := make([]byte, 17<<20)
+	for i := range big {
+		big[i] = 0xFF
+	}
+	require.Equal(t, SumU8(big), SumU8Parallel(big, 5))
+}
+
+func TestCRC32Parallel(t *testing.T) {
+	r := rand.New(rand.NewSource(45))
+	data := make([]byte, 3<<20+123)
+	r.Read(data)
+
+	for _, n := range []int{0, 1, 255, 4096, parallelMinSegment, parallelMinSegment + 1, 2*parallelMinSegment + 77, len(data)} {
+		want := CRC32(data[:n])
+		// 64 workers exceeds the number of 256 KiB segments for every n.
+		for _, workers := range []int{0, 1, 2, 3, 5, 64} {
+			require.Equal(t, want, CRC32Parallel(data[:n], workers), "n=%d workers=%d", n, workers)
+		}
+	}
+}
+
+// cancelAfter is a context that reports context.Canceled once Err has been
+// called more than n times, cancelling a scan part-way through
+// deterministically.
+type cancelAfter struct {
+	context.Context
+	n atomic.Int64
+}
+
+func (c *cancelAfter) Err() error {
+	if c.n.Add(-1) < 0 {
+		return context.Canceled
+	}
+	return nil
+}
+
+func TestParallelCtxCancel(t *testing.T) {
+	data := make([]byte, 20<<20)
+	for i := range data {
+		data[i] = byte(i)
+	}
+
+	for _, workers := range []int{1, 4} {
+		ctx := &cancelAfter{Context: context.Background()}
+		ctx.n.Store(3)
+		sum, err := SumU8ParallelCtx(ctx, data, workers)
+		require.ErrorIs(t, err, context.Canceled, "workers=%d", workers)
+		require.Zero(t, sum)
+
+		ctx = &cancelAfter{Context: context.Background()}
+		ctx.n.Store(3)
+		crc, err := CRC32ParallelCtx(ctx, data, workers)
+		require.ErrorIs(t, err, context.Canceled, "workers=%d", workers)
+		require.Zero(t, crc)
+	}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := SumU8ParallelCtx(cancelled, data, 0)
+	require.ErrorIs(t, err, context.Canceled)
+
+	sum, err := SumU8ParallelCtx(context.Background(), data, 3)
+	require.NoError(t, err)
+	require.Equal(t, SumU8(data), sum)
+	crc, err := CRC32ParallelCtx(context.Background(), data, 3)
+	require.NoError(t, err)
+	require.Equal(t, CRC32(data), crc)
+}
+
+func BenchmarkSumU8Parallel(b *testing.B) {
+	data := make([]byte, 256<<20)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	b.Run("serial", func(b *testing.B) {
+		b.SetBytes(int64(len(data)))
+		for i := 0; i < b.N; i++ {
+			SumU8(data)
+		}
+	})
+	for _, workers := range []int{1, 2, 4, 8, 16} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				SumU8Parallel(data, workers)
+			}
+		})
+	}
+}
+
+func BenchmarkCRC32Parallel(b *testing.B) {
+	data := make([]byte, 256<<20)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	for _, workers := range []int{1, 2, 4, 8, 16} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				CRC32Parallel(data, workers)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package algo
+
+// LengthPolicy bounds the accepted length of an input, in bytes.  Validators
+// consult a policy instead of hard-coding their limits so callers can enforce
+// stricter (or looser) bounds without forking the validator.
+type LengthPolicy struct {
+	Min, Max int
+}
+
+// TagLengthPolicy is the default policy for tag validators: 1..200 bytes, as
+// per Datadog's tagging guidelines.
+var TagLengthPolicy = LengthPolicy{Min: 1, Max: 200}
+
+// Check reports whether n lies within [p.Min, p.Max].  A Max of 0 or less
+// means no upper bound, as with TagSpec.MaxLen.
+func (p LengthPolicy) Check(n int) bool {
+	return n >= p.Min && (p.Max <= 0 || n <= p.Max)
+}
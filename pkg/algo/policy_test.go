@@ -0,0 +1,32 @@
+package algo
+
+import "testing"
+
+func TestLengthPolicy(t *testing.T) {
+	cases := []struct {
+		n    int
+		want bool
+	}{
+		{0, false},
+		{1, true},
+		{200, true},
+		{201, false},
+	}
+	for _, c := range cases {
+		if got := TagLengthPolicy.Check(c.n); got != c.want {
+			t.Errorf("TagLengthPolicy.Check(%d) = %v, want %v", c.n, got, c.want)
+		}
+	}
+
+	strict := LengthPolicy{Min: 2, Max: 8}
+	if strict.Check(1) || !strict.Check(2) || !strict.Check(8) || strict.Check(9) {
+		t.Errorf("custom policy %+v mis-classifies boundaries", strict)
+	}
+
+	// A Max of 0 or less means no upper bound.
+	for _, unbounded := range []LengthPolicy{{Min: 1}, {Min: 1, Max: -1}} {
+		if unbounded.Check(0) || !unbounded.Check(1) || !unbounded.Check(1<<20) {
+			t.Errorf("policy %+v should accept any length >= 1", unbounded)
+		}
+	}
+}
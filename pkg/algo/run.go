@@ -0,0 +1,128 @@
+package algo
+
+import (
+	"math/bits"
+
+	"github.com/miretskiy/simba/pkg/intrinsics"
+)
+
+// runBlock is how many bytes IndexRun hands to the mask kernel at a time.
+const runBlock = 4096
+
+// IndexRun returns the offset of the first run of at least n consecutive
+// val bytes in data, or -1 if there is none.  n <= 0 matches at offset 0.
+//
+// Inputs of 64 bytes or more are scanned with the 64-lane equality-mask
+// kernel.  Runs are measured on the mask words with trailing-zero counts; a
+// run that reaches bit 63 is carried into the next word, so runs spanning
+// chunk (and block) boundaries are found.
+func IndexRun(data []byte, val byte, n int) int {
+	if n <= 0 {
+		return 0
+	}
+	if n > len(data) {
+		return -1
+	}
+
+	// run is the length of the val run ending just before the next byte to
+	// inspect and start its offset.
+	run, start := 0, 0
+	var masks [runBlock / 64]uint64
+	full := len(data) &^ 63
+	for base := 0; base < full; base += runBlock {
+		block := data[base:min(base+runBlock, full)]
+		intrinsics.EqU8Masks64(block, val, masks[:])
+		for w, m := range masks[:len(block)/64] {
+			off := base + w*64
+			if run > 0 {
+				t := bits.TrailingZeros64(^m)
+				run += t
+				if run >= n {
+					return start
+				}
+				if t == 64 {
+					continue
+				}
+				m &^= 1<<t - 1
+				run = 0
+			}
+			for m != 0 {
+				s := bits.TrailingZeros64(m)
+				l := min(bits.TrailingZeros64(^(m >> s)), 64-s)
+				if l >= n {
+					return off + s
+				}
+				if s+l == 64 {
+					run, start = l, off+s
+					break
+				}
+				m &^= (1<<l - 1) << s
+			}
+		}
+	}
+
+	for i := full; i < len(data); i++ {
+		if data[i] != val {
+			run = 0
+			continue
+		}
+		if run == 0 {
+			start = i
+		}
+		run++
+		if run >= n {
+			return start
+		}
+	}
+	return -1
+}
+
+// LeadingRun returns how many bytes at the start of data equal val: len(data)
+// if every byte does and 0 if the first one differs.
+//
+// Inputs shorter than the SIMD threshold are counted with a scalar loop;
+// longer ones use the not-equal kernel, which finds the first mismatch with a
+// trailing-zero count on the lane mask.
+func LeadingRun(data []byte, val byte) int {
+	if len(data) < simdThreshold {
+		for i, b := range data {
+			if b != val {
+				return i
+			}
+		}
+		return len(data)
+	}
+	return intrinsics.CountLeadingByte(data, val)
+}
+
+// TrailingRun returns how many bytes at the end of data equal val: len(data)
+// if every byte does and 0 if the last one differs.
+//
+// The bytes past the last whole 64-byte chunk are counted with a scalar loop.
+// Chunks are then walked backwards through the equality-mask kernel, and the
+// first chunk that is not all val ends the run at its highest clear mask bit.
+func TrailingRun(data []byte, val byte) int {
+	full := len(data) &^ 63
+	if len(data) < simdThreshold {
+		full = 0
+	}
+	for i := len(data) - 1; i >= full; i-- {
+		if data[i] != val {
+			return len(data) - 1 - i
+		}
+	}
+
+	var masks [runBlock / 64]uint64
+	for end := full; end > 0; {
+		base := max(end-runBlock, 0)
+		block := data[base:end]
+		intrinsics.EqU8Masks64(block, val, masks[:])
+		for w := len(block)/64 - 1; w >= 0; w-- {
+			if m := ^masks[w]; m != 0 {
+				return len(data) - 1 - (base + w*64 + 63 - bits.LeadingZeros64(m))
+			}
+		}
+		end = base
+	}
+	return len(data)
+}
@@ -0,0 +1,41 @@
+package algo
+
+import (
+	"bytes"
+	"testing"
+)
+
+func FuzzLeadingTrailingRun(f *testing.F) {
+	// Runs ending on either side of each 64-byte chunk edge and the 4 KiB
+	// block edge, measured from both ends.
+	for _, n := range []int{0, 1, 15, 16, 63, 64, 65, 128, 200, 4096, 4097, 4200} {
+		for _, run := range []int{0, 1, 63, 64, 65, n - 1, n, 4096, 4097} {
+			if run < 0 || run > n {
+				continue
+			}
+			data := bytes.Repeat([]byte{'-'}, n)
+			if run < n {
+				data[run] = '+'
+				data[n-1-run] = '+'
+			}
+			f.Add(data, byte('-'))
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte, val byte) {
+		lead := 0
+		for lead < len(data) && data[lead] == val {
+			lead++
+		}
+		trail := 0
+		for trail < len(data) && data[len(data)-1-trail] == val {
+			trail++
+		}
+		if got := LeadingRun(data, val); got != lead {
+			t.Fatalf("LeadingRun(len=%d, %#x) = %d, want %d", len(data), val, got, lead)
+		}
+		if got := TrailingRun(data, val); got != trail {
+			t.Fatalf("TrailingRun(len=%d, %#x) = %d, want %d", len(data), val, got, trail)
+		}
+	})
+}
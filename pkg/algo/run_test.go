@@ -0,0 +1,45 @@
+package algo
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func scalarIndexRun(data []byte, val byte, n int) int {
+	if n <= 0 {
+		return 0
+	}
+	return bytes.Index(data, bytes.Repeat([]byte{val}, n))
+}
+
+func TestIndexRun(t *testing.T) {
+	// A qualifying run straddling each 64-byte chunk edge and the 4 KiB
+	// block edge, preceded by shorter decoy runs.
+	for _, n := range []int{1, 2, 5, 63, 64, 65, 130} {
+		for _, at := range []int{0, 10, 60, 64, 120, 4090, 4096 - n/2} {
+			data := bytes.Repeat([]byte{'x'}, 4096+200)
+			if at > n {
+				copy(data[at-n:], bytes.Repeat([]byte{0}, n-1))
+			}
+			copy(data[at:], bytes.Repeat([]byte{0}, n))
+			want := scalarIndexRun(data, 0, n)
+			require.Equal(t, want, IndexRun(data, 0, n), "n=%d at=%d", n, at)
+		}
+	}
+
+	r := rand.New(rand.NewSource(5))
+	for _, size := range []int{0, 1, 15, 63, 64, 65, 200, 5000} {
+		data := make([]byte, size)
+		for i := range data {
+			if r.Intn(4) != 0 {
+				data[i] = 'a'
+			}
+		}
+		for _, n := range []int{-1, 0, 1, 3, 8, 64, 100} {
+			require.Equal(t, scalarIndexRun(data, 0, n), IndexRun(data, 0, n), "size=%d n=%d", size, n)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package algo
+
+import (
+	"math/bits"
+
+	"github.com/miretskiy/simba/pkg/intrinsics"
+)
+
+// scanBlock is how many bytes a Scanner hands to the mask kernel at a time.
+const scanBlock = 4096
+
+// Scanner runs kernels over windows of one base buffer.  Parsers that probe
+// many overlapping windows of the same input pay the setup cost once: the
+// scalar-versus-SIMD decision is made when the Scanner is created, and the
+// mask scratch space is allocated once and reused by every call.
+//
+// Offsets passed to and returned from Scanner methods are relative to the
+// base buffer.  A Scanner is not safe for concurrent use; give each goroutine
+// its own.
+type Scanner struct {
+	buf   []byte
+	simd  bool
+	masks []uint64
+}
+
+// NewScanner returns a Scanner over buf.  The Scanner retains buf; callers
+// must not modify it while the Scanner is in use.
+func NewScanner(buf []byte) *Scanner {
+	s := &Scanner{buf: buf, simd: len(buf) >= 64}
+	if s.simd {
+		s.masks = make([]uint64, min(len(buf), scanBlock)/64)
+	}
+	return s
+}
+
+// Len returns the length of the base buffer.
+func (s *Scanner) Len() int { return len(s.buf) }
+
+// IndexByte returns the offset of the first needle at or after from, or -1
+// if there is none.  It panics if from is outside [0, Len()].
+func (s *Scanner) IndexByte(from int, needle byte) int {
+	data := s.buf[from:]
+	full := 0
+	if s.simd {
+		full = len(data) &^ 63
+	}
+	for base := 0; base < full; base += scanBlock {
+		block := data[base:min(base+scanBlock, full)]
+		intrinsics.EqU8Masks64(block, needle, s.masks)
+		for w, m := range s.masks[:len(block)/64] {
+			if m != 0 {
+				return from + base + w*64 + bits.TrailingZeros64(m)
+			}
+		}
+	}
+	for i := full; i < len(data); i++ {
+		if data[i] == needle {
+			return from + i
+		}
+	}
+	return -1
+}
+
+// Sum returns the sum of buf[from:to] without wrapping, like SumU8Wide.  It
+// panics if the window is out of range.
+func (s *Scanner) Sum(from, to int) uint64 {
+	data := s.buf[from:to]
+	if !s.simd || len(data) < simdThreshold {
+		var acc uint64
+		for _, b := range data {
+			acc += uint64(b)
+		}
+		return acc
+	}
+	var total uint64
+	for len(data) > sumU8WideBlock {
+		total += uint64(intrinsics.SumU8(data[:sumU8WideBlock]))
+		data = data[sumU8WideBlock:]
+	}
+	return total + uint64(intrinsics.SumU8(data))
+}
@@ -0,0 +1,38 @@
+package algo
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScannerMatchesStandalone(t *testing.T) {
+	r := rand.New(rand.NewSource(6))
+	for _, size := range []int{0, 1, 15, 63, 64, 65, 200, 4096 + 100, 10000} {
+		buf := make([]byte, size)
+		for i := range buf {
+			buf[i] = byte('a' + r.Intn(8))
+		}
+		s := NewScanner(buf)
+		require.Equal(t, size, s.Len())
+
+		for i := 0; i < 200; i++ {
+			from := r.Intn(size + 1)
+			to := from + r.Intn(size-from+1)
+			needle := byte('a' + r.Intn(9)) // 'i' never occurs
+
+			want := bytes.IndexByte(buf[from:], needle)
+			if want >= 0 {
+				want += from
+			}
+			require.Equal(t, want, s.IndexByte(from, needle), "size=%d from=%d needle=%q", size, from, needle)
+			require.Equal(t, SumU8Wide(buf[from:to]), s.Sum(from, to), "size=%d window=[%d:%d]", size, from, to)
+		}
+	}
+
+	s := NewScanner([]byte("abc"))
+	require.Panics(t, func() { s.IndexByte(4, 'a') })
+	require.Panics(t, func() { s.Sum(2, 1) })
+}
@@ -0,0 +1,118 @@
+package algo
+
+// setShape is the cheapest representation CompileSet found for a set.
+type setShape uint8
+
+const (
+	// setTable needs the 256-byte lookup table.
+	setTable setShape = iota
+	// setEmpty has no members.
+	setEmpty
+	// setByte has the single member lo.
+	setByte
+	// setRange has exactly the members lo..hi, so membership is two
+	// compares instead of a table gather.
+	setRange
+)
+
+// CompiledSet is a byte set prepared once for repeated scans.  CompileSet
+// copies the table, normalises its entries, and picks the cheapest kernel
+// for its shape: a single member is searched with the equality kernels and
+// a contiguous range such as '0'..'9' is validated with AllBytesInRange,
+// while other sets use the LUT kernels.  The answers are always those of
+// AllBytesInSet, ContainsAnyByte and IndexAnyByte on the original set.
+//
+// The private copy makes the set immune to later changes of the caller's
+// ByteSet.  It does not need pinning: Go's collector never moves heap
+// objects, so the table keeps its address across kernel calls either way.
+// A CompiledSet is immutable and safe for concurrent use.
+type CompiledSet struct {
+	table  ByteSet
+	shape  setShape
+	lo, hi byte
+}
+
+// CompileSet returns the compiled form of set.  A nil set has no members.
+func CompileSet(set *ByteSet) *CompiledSet {
+	s := &CompiledSet{shape: setEmpty}
+	if set == nil {
+		return s
+	}
+	members, first, last := 0, -1, -1
+	for i, v := range set {
+		if v == 0 {
+			continue
+		}
+		s.table[i] = 1
+		members++
+		if first < 0 {
+			first = i
+		}
+		last = i
+	}
+	switch {
+	case members == 0:
+	case members == 1:
+		s.shape, s.lo, s.hi = setByte, byte(first), byte(first)
+	case last-first+1 == members:
+		s.shape, s.lo, s.hi = setRange, byte(first), byte(last)
+	default:
+		s.shape = setTable
+	}
+	return s
+}
+
+// CompileBytes returns the compiled set whose members are bytes.
+func CompileBytes(bytes ...byte) *CompiledSet {
+	return CompileSet(MakeByteSet(bytes...))
+}
+
+// Contains reports whether b is a member of s.
+func (s *CompiledSet) Contains(b byte) bool {
+	return s.table[b] != 0
+}
+
+// Set returns a copy of the set's lookup table, with members marked 1.
+func (s *CompiledSet) Set() *ByteSet {
+	t := s.table
+	return &t
+}
+
+// AllIn reports whether every byte of data is a member of s, like
+// AllBytesInSet.  Empty input returns true.
+func (s *CompiledSet) AllIn(data []byte) bool {
+	switch s.shape {
+	case setEmpty:
+		return len(data) == 0
+	case setByte, setRange:
+		return AllBytesInRange(data, s.lo, s.hi)
+	default:
+		return AllBytesInSet(data, &s.table)
+	}
+}
+
+// ContainsAny reports whether any byte of data is a member of s, like
+// ContainsAnyByte.  Empty input returns false.
+func (s *CompiledSet) ContainsAny(data []byte) bool {
+	switch s.shape {
+	case setEmpty:
+		return false
+	case setByte:
+		return ContainsByte(data, s.lo)
+	default:
+		return ContainsAnyByte(data, &s.table)
+	}
+}
+
+// IndexAny returns the offset of the first byte of data that is a member of
+// s, or -1 if there is none, like IndexAnyByte.
+func (s *CompiledSet) IndexAny(data []byte) int {
+	switch s.shape {
+	case setEmpty:
+		return -1
+	case setByte:
+		return IndexByte(data, s.lo)
+	default:
+		return IndexAnyByte(data, &s.table)
+	}
+}
@@ -0,0 +1,91 @@
+package algo
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompiledSetMatchesLUT(t *testing.T) {
+	var full, weighted ByteSet
+	for i := range full {
+		full[i] = 1
+	}
+	weighted['a'], weighted['q'], weighted[0xff] = 7, 1, 200 // any non-zero entry is a member
+
+	sets := map[string]*ByteSet{
+		"empty":    new(ByteSet),
+		"single":   MakeByteSet(','),
+		"digits":   MakeByteSet('0', '1', '2', '3', '4', '5', '6', '7', '8', '9'),
+		"gapped":   MakeByteSet('0', '1', '2', '4'),
+		"full":     &full,
+		"weighted": &weighted,
+		"delims":   MakeByteSet(' ', '\t', ',', ';', '\n'),
+	}
+
+	r := rand.New(rand.NewSource(1554))
+	const alphabet = "0123456789,; \tabcq\xff\x00-"
+	for name, set := range sets {
+		cs := CompileSet(set)
+		for i := range set {
+			require.Equal(t, set[i] != 0, cs.Contains(byte(i)), "%s: Contains(%#x)", name, i)
+		}
+		for _, n := range []int{0, 1, 15, 16, 17, 63, 64, 65, 300} {
+			for trial := 0; trial < 20; trial++ {
+				// Draw from a shrinking prefix of the alphabet so some
+				// inputs lie entirely inside the narrower sets.
+				chars := alphabet[:1+trial%len(alphabet)]
+				data := make([]byte, n)
+				for i := range data {
+					data[i] = chars[r.Intn(len(chars))]
+				}
+				require.Equal(t, AllBytesInSet(data, set), cs.AllIn(data), "%s n=%d %q", name, n, data)
+				require.Equal(t, ContainsAnyByte(data, set), cs.ContainsAny(data), "%s n=%d %q", name, n, data)
+				require.Equal(t, IndexAnyByte(data, set), cs.IndexAny(data), "%s n=%d %q", name, n, data)
+			}
+		}
+	}
+}
+
+func TestCompiledSetShapes(t *testing.T) {
+	require.Equal(t, setEmpty, CompileSet(nil).shape)
+	require.Equal(t, setEmpty, CompileBytes().shape)
+	require.Equal(t, setByte, CompileBytes('x').shape)
+	require.Equal(t, setRange, CompileBytes('c', 'a', 'b').shape)
+	require.Equal(t, setTable, CompileBytes('a', 'c').shape)
+
+	require.True(t, CompileSet(nil).AllIn(nil))
+	require.False(t, CompileSet(nil).AllIn([]byte("a")))
+	require.Equal(t, -1, CompileBytes().IndexAny([]byte("abc")))
+
+	// The compiled form owns its table.
+	set := MakeByteSet('a', 'b')
+	cs := CompileSet(set)
+	set['z'] = 1
+	require.False(t, cs.Contains('z'))
+	cs.Set()['z'] = 1
+	require.False(t, cs.Contains('z'))
+	require.Equal(t, MakeByteSet('a', 'b'), cs.Set())
+}
+
+func BenchmarkCompiledSet(b *testing.B) {
+	data := make([]byte, 4096)
+	for i := range data {
+		data[i] = '0' + byte(i%10)
+	}
+	digits := MakeByteSet('0', '1', '2', '3', '4', '5', '6', '7', '8', '9')
+	cs := CompileSet(digits)
+	b.Run("LUT", func(b *testing.B) {
+		b.SetBytes(int64(len(data)))
+		for i := 0; i < b.N; i++ {
+			AllBytesInSet(data, digits)
+		}
+	})
+	b.Run("Compiled", func(b *testing.B) {
+		b.SetBytes(int64(len(data)))
+		for i := 0; i < b.N; i++ {
+			cs.AllIn(data)
+		}
+	})
+}
@@ -1,6 +1,11 @@
 package algo
 
-import "github.com/miretskiy/simba/pkg/intrinsics"
+import (
+	"math/bits"
+	"unsafe"
+
+	"github.com/miretskiy/simba/pkg/intrinsics"
+)
 
 // asciiThreshold is tuned specifically for IsASCII. Benchmarks show that the
 // SIMD kernel overtakes the scalar loop once the slice length reaches 32
@@ -16,13 +21,43 @@ const asciiThreshold = 32
 // scalar loop at around 64 bytes; for smaller inputs the scalar path is
 // cheaper despite the ~0.3 ns FFI cost.
 func IsASCII(data []byte) bool {
-	if len(data) < asciiThreshold {
-		for _, b := range data {
-			if b&0x80 != 0 {
-				return false
+	return defaultDispatcher.Load().IsASCII(data)
+}
+
+// FirstNonASCII returns the offset of the first byte of data that is >= 0x80,
+// or -1 if data is all ASCII.  It answers what a failed IsASCII leaves open in
+// one pass: whole 64-byte chunks are classified with the InSetMasks64 kernel,
+// a block at a time, and the first set bit of the first non-zero mask word is
+// the answer; the bytes past the last whole chunk are scanned in Go.
+func FirstNonASCII(data []byte) int {
+	var masks [indexBlock / 64]uint64
+	full := len(data) &^ 63
+	for base := 0; base < full; base += indexBlock {
+		block := data[base:min(base+indexBlock, full)]
+		intrinsics.InSetMasks64(block, nonASCII, masks[:])
+		for w, m := range masks[:len(block)/64] {
+			if m != 0 {
+				return base + w*64 + bits.TrailingZeros64(m)
 			}
 		}
-		return true
 	}
-	return intrinsics.IsASCII(data)
+	for i := full; i < len(data); i++ {
+		if data[i] >= 0x80 {
+			return i
+		}
+	}
+	return -1
+}
+
+// IsASCIIString is IsASCII for a string.  It views s as a byte slice in
+// place instead of converting it, so it never allocates.
+func IsASCIIString(s string) bool {
+	return IsASCII(stringBytes(s))
+}
+
+// stringBytes returns the bytes of s without copying.  The result aliases
+// the string's immutable backing array and must only be read; every string
+// handed to a kernel goes through it.
+func stringBytes(s string) []byte {
+	return unsafe.Slice(unsafe.StringData(s), len(s))
 }
@@ -0,0 +1,38 @@
+package algo
+
+import (
+	"bytes"
+	"testing"
+)
+
+func FuzzFirstNonASCII(f *testing.F) {
+	for _, n := range []int{0, 1, 63, 64, 65, 127, 128, 200, 4095, 4096, 4097, 4160} {
+		data := bytes.Repeat([]byte("ascii "), n/6+1)[:n]
+		f.Add(data)
+		for _, at := range []int{0, 63, 64, n - 1, n &^ 63, 4096} {
+			// n-1 past a whole chunk, and n&^63 itself, fall in the scalar tail.
+			if at >= 0 && at < n {
+				bad := bytes.Clone(data)
+				bad[at] = 0x80
+				f.Add(bad)
+			}
+		}
+	}
+	f.Add([]byte("héllo wörld"))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		want := -1
+		for i, b := range data {
+			if b >= 0x80 {
+				want = i
+				break
+			}
+		}
+		if got := FirstNonASCII(data); got != want {
+			t.Fatalf("FirstNonASCII(len=%d) = %d, want %d", len(data), got, want)
+		}
+		if ok := IsASCII(data); ok != (want < 0) {
+			t.Fatalf("IsASCII(len=%d) = %v, but first non-ASCII byte is at %d", len(data), ok, want)
+		}
+	})
+}
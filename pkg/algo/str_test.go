@@ -1,6 +1,10 @@
 package algo
 
-import "testing"
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
 
 func TestAlgoIsASCII(t *testing.T) {
 	cases := []struct {
@@ -18,3 +22,47 @@ func TestAlgoIsASCII(t *testing.T) {
 		}
 	}
 }
+
+func TestIsASCIIString(t *testing.T) {
+	for _, n := range []int{0, 1, asciiThreshold - 1, asciiThreshold, asciiThreshold + 1, 64, 1000} {
+		s := strings.Repeat("a", n)
+		if !IsASCIIString(s) {
+			t.Errorf("IsASCIIString(%d x 'a') = false", n)
+		}
+		if n > 0 && IsASCIIString(s[:n-1]+"\x80") {
+			t.Errorf("IsASCIIString(%d bytes ending in 0x80) = true", n)
+		}
+	}
+}
+
+// TestStringEntryPointsDoNotAllocate covers both sides of the SIMD
+// thresholds; run BenchmarkStringEntryPoints with -benchmem for timings.
+func TestStringEntryPointsDoNotAllocate(t *testing.T) {
+	for _, n := range []int{1, asciiThreshold - 1, asciiThreshold, 150, 4096} {
+		s := strings.Repeat("a", n)
+		if allocs := testing.AllocsPerRun(100, func() { IsASCIIString(s) }); allocs != 0 {
+			t.Errorf("IsASCIIString(len %d): %v allocs per call", n, allocs)
+		}
+		if allocs := testing.AllocsPerRun(100, func() { ValidateTagASCII(s) }); allocs != 0 {
+			t.Errorf("ValidateTagASCII(len %d): %v allocs per call", n, allocs)
+		}
+	}
+}
+
+func BenchmarkStringEntryPoints(b *testing.B) {
+	for _, n := range []int{16, asciiThreshold, 128} {
+		s := "env:" + strings.Repeat("x", n-4)
+		b.Run(fmt.Sprintf("IsASCIIString/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				IsASCIIString(s)
+			}
+		})
+		b.Run(fmt.Sprintf("ValidateTagASCII/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				ValidateTagASCII(s)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package algo
+
+import (
+	"errors"
+	"io"
+	"strconv"
+)
+
+// streamBufSize is the block size used by the io.Reader helpers.  32 KiB
+// matches io.Copy and is far above every SIMD threshold, so each block runs
+// through the widest kernel.
+const streamBufSize = 32 << 10
+
+// SumU8Reader consumes r until EOF and returns the byte-sum of everything it
+// read, as SumU8Wide would for the concatenated stream.  Data is read into a
+// single reusable buffer, so the stream is never held in memory.  An empty
+// stream sums to 0; any read error other than io.EOF is returned together
+// with the sum of the bytes read so far.
+func SumU8Reader(r io.Reader) (uint64, error) {
+	buf := make([]byte, streamBufSize)
+	var total uint64
+	for {
+		n, err := r.Read(buf)
+		total += SumU8Wide(buf[:n])
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return total, nil
+			}
+			return total, err
+		}
+	}
+}
+
+// ErrNotASCII is matched, via errors.Is, by the NotASCIIError an
+// ASCII-validating reader returns.
+var ErrNotASCII = errors.New("algo: non-ASCII byte")
+
+// NotASCIIError reports the stream offset of the first byte >= 0x80 seen by
+// a reader from NewASCIIValidatingReader.
+type NotASCIIError int64
+
+func (e NotASCIIError) Error() string {
+	return "algo: non-ASCII byte at input byte " + strconv.FormatInt(int64(e), 10)
+}
+
+// Is reports whether target is ErrNotASCII.
+func (e NotASCIIError) Is(target error) bool { return target == ErrNotASCII }
+
+// nonASCII is the set of bytes >= 0x80.
+var nonASCII = func() *ByteSet {
+	var s ByteSet
+	for i := 0x80; i < 256; i++ {
+		s[i] = 1
+	}
+	return &s
+}()
+
+type asciiReader struct {
+	r   io.Reader
+	off int64 // stream offset of the next byte read from r
+	err error // sticky NotASCIIError
+}
+
+// NewASCIIValidatingReader returns a reader that passes r's bytes through
+// unchanged until the first non-ASCII byte.  The Read that reaches it returns
+// only the bytes before it, together with a NotASCIIError holding its stream
+// offset; that error, which matches ErrNotASCII, is returned by every later
+// Read.  Each filled buffer is checked with IsASCII, so valid data costs one
+// kernel call per Read; nothing is buffered.
+func NewASCIIValidatingReader(r io.Reader) io.Reader {
+	return &asciiReader{r: r}
+}
+
+func (a *asciiReader) Read(p []byte) (int, error) {
+	if a.err != nil {
+		return 0, a.err
+	}
+	n, err := a.r.Read(p)
+	if !IsASCII(p[:n]) {
+		i := IndexAnyByte(p[:n], nonASCII)
+		a.err = NotASCIIError(a.off + int64(i))
+		a.off += int64(i)
+		return i, a.err
+	}
+	a.off += int64(n)
+	return n, err
+}
@@ -0,0 +1,103 @@
+package algo
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSumU8Reader(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 15, 16, 100, streamBufSize - 1, streamBufSize, 3*streamBufSize + 7} {
+		data := make([]byte, n)
+		for i := range data {
+			data[i] = byte(r.Intn(256))
+		}
+		got, err := SumU8Reader(iotest.HalfReader(bytes.NewReader(data)))
+		require.NoError(t, err, "len=%d", n)
+		require.Equal(t, SumU8Wide(data), got, "len=%d", n)
+	}
+}
+
+func TestSumU8ReaderError(t *testing.T) {
+	boom := errors.New("boom")
+	r := iotest.TimeoutReader(strings.NewReader("abc")) // 2nd read fails
+	_, err := SumU8Reader(r)
+	require.ErrorIs(t, err, iotest.ErrTimeout)
+
+	_, err = SumU8Reader(iotest.ErrReader(boom))
+	require.ErrorIs(t, err, boom)
+}
+
+func TestSumU8Wide(t *testing.T) {
+	const n = sumU8WideBlock + 1000
+	data := bytes.Repeat([]byte{0xFF}, n)
+	require.Equal(t, uint64(255*n), SumU8Wide(data))
+}
+
+// chunkReader returns at most size bytes per Read.
+type chunkReader struct {
+	data []byte
+	size int
+}
+
+func (c *chunkReader) Read(p []byte) (int, error) {
+	if len(c.data) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(p[:min(len(p), c.size)], c.data)
+	c.data = c.data[n:]
+	return n, nil
+}
+
+func TestASCIIValidatingReader(t *testing.T) {
+	valid := strings.Repeat("plain ascii text\n", 5000)
+	for _, size := range []int{1, 3, 7, 64, 4096, len(valid)} {
+		got, err := io.ReadAll(NewASCIIValidatingReader(&chunkReader{[]byte(valid), size}))
+		require.NoError(t, err, "size=%d", size)
+		require.Equal(t, valid, string(got), "size=%d", size)
+	}
+
+	// "é" is 0xC3 0xA9.  With 3-byte reads it straddles the 2nd and 3rd reads
+	// at offset 5; the larger sizes put it mid-buffer and past the SIMD
+	// threshold.
+	for _, tc := range []struct {
+		prefix string
+		size   int
+	}{
+		{"hello", 3},
+		{"hello", 1},
+		{"hello", 100},
+		{strings.Repeat("x", 1000), 256},
+		{strings.Repeat("x", 1000), 1 << 20},
+	} {
+		data := tc.prefix + "é and more"
+		got, err := io.ReadAll(NewASCIIValidatingReader(&chunkReader{[]byte(data), tc.size}))
+		require.ErrorIs(t, err, ErrNotASCII, "size=%d", tc.size)
+		var nae NotASCIIError
+		require.ErrorAs(t, err, &nae)
+		require.Equal(t, NotASCIIError(len(tc.prefix)), nae, "size=%d", tc.size)
+		require.Equal(t, tc.prefix, string(got), "bytes before the violation pass through")
+	}
+	require.EqualError(t, NotASCIIError(5), "algo: non-ASCII byte at input byte 5")
+
+	// The error is sticky.
+	r := NewASCIIValidatingReader(strings.NewReader("a\x80b"))
+	buf := make([]byte, 10)
+	n, err := r.Read(buf)
+	require.Equal(t, 1, n)
+	require.ErrorIs(t, err, ErrNotASCII)
+	n, err = r.Read(buf)
+	require.Zero(t, n)
+	require.ErrorIs(t, err, ErrNotASCII)
+
+	// Underlying errors pass through.
+	_, err = io.ReadAll(NewASCIIValidatingReader(iotest.TimeoutReader(strings.NewReader("abc"))))
+	require.ErrorIs(t, err, iotest.ErrTimeout)
+}
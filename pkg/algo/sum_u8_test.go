@@ -1,6 +1,7 @@
 package algo
 
 import (
+	"bytes"
 	"fmt"
 	"math/rand"
 	"testing"
@@ -36,3 +37,163 @@ func BenchmarkSumU8Algo(b *testing.B) {
 		})
 	}
 }
+
+func TestXorChecksum(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 15, 16, 17, 33, 64, 65, 1000} {
+		data := make([]byte, n)
+		for i := range data {
+			data[i] = byte(r.Intn(256))
+		}
+		var want byte
+		for _, b := range data {
+			want ^= b
+		}
+		require.Equal(t, want, XorChecksum(data), "n=%d", n)
+	}
+}
+
+func TestSumU16U32(t *testing.T) {
+	r := rand.New(rand.NewSource(12))
+	for _, n := range []int{0, 1, 15, 16, 17, 31, 32, 63, 64, 65, 1000} {
+		a := make([]uint16, n)
+		b := make([]uint32, n)
+		var wantA, wantB uint64
+		for i := range a {
+			a[i] = uint16(r.Uint32())
+			b[i] = r.Uint32()
+			wantA += uint64(a[i])
+			wantB += uint64(b[i])
+		}
+		require.Equal(t, wantA, SumU16(a), "SumU16 n=%d", n)
+		require.Equal(t, wantB, SumU32(b), "SumU32 n=%d", n)
+		require.Equal(t, wantA, intrinsics.SumU16(a), "intrinsics.SumU16 n=%d", n)
+		require.Equal(t, wantB, intrinsics.SumU32(b), "intrinsics.SumU32 n=%d", n)
+	}
+}
+
+func TestSumU16U32PastUint32(t *testing.T) {
+	// 65537 * 0xFFFF and 2 * 0xFFFFFFFF both exceed 2^32.
+	u16 := make([]uint16, 65537)
+	for i := range u16 {
+		u16[i] = 0xFFFF
+	}
+	require.Equal(t, uint64(65537)*0xFFFF, SumU16(u16))
+
+	for _, n := range []int{2, 16, 17, 1000} {
+		u32 := make([]uint32, n)
+		for i := range u32 {
+			u32[i] = 0xFFFFFFFF
+		}
+		require.Equal(t, uint64(n)*0xFFFFFFFF, SumU32(u32), "n=%d", n)
+	}
+}
+
+func TestMinMaxU8(t *testing.T) {
+	_, ok := MinU8(nil)
+	require.False(t, ok)
+	_, ok = MaxU8([]byte{})
+	require.False(t, ok)
+
+	lo, ok := MinU8([]byte{0x42})
+	require.True(t, ok)
+	require.Equal(t, byte(0x42), lo)
+	hi, ok := MaxU8([]byte{0x42})
+	require.True(t, ok)
+	require.Equal(t, byte(0x42), hi)
+
+	r := rand.New(rand.NewSource(13))
+	for _, n := range []int{1, 2, 15, 16, 17, 31, 32, 33, 63, 64, 65, 1000} {
+		data := make([]byte, n)
+		for i := range data {
+			data[i] = byte(0x20 + r.Intn(0x60))
+		}
+		wantLo, wantHi := data[0], data[0]
+		for _, b := range data {
+			wantLo, wantHi = min(wantLo, b), max(wantHi, b)
+		}
+		lo, _ := MinU8(data)
+		hi, _ := MaxU8(data)
+		require.Equal(t, wantLo, lo, "min n=%d", n)
+		require.Equal(t, wantHi, hi, "max n=%d", n)
+		require.Equal(t, wantLo, intrinsics.MinU8(data), "intrinsics.MinU8 n=%d", n)
+		require.Equal(t, wantHi, intrinsics.MaxU8(data), "intrinsics.MaxU8 n=%d", n)
+	}
+
+	require.Equal(t, byte(0xFF), intrinsics.MinU8(nil))
+	require.Equal(t, byte(0), intrinsics.MaxU8(nil))
+}
+
+func TestHistogram(t *testing.T) {
+	require.Equal(t, [256]uint64{}, *Histogram(nil))
+
+	r := rand.New(rand.NewSource(19))
+	for _, n := range []int{1, 15, 16, 17, 63, 64, 65, 1000, 1 << 16} {
+		data := make([]byte, n)
+		r.Read(data)
+		var want [256]uint64
+		for _, b := range data {
+			want[b]++
+		}
+		require.Equal(t, want, *Histogram(data), "n=%d", n)
+
+		// intrinsics.Histogram adds to the existing counts.
+		acc := want
+		intrinsics.Histogram(data, &acc)
+		for k := range want {
+			require.Equal(t, 2*want[k], acc[k], "n=%d byte=%d", n, k)
+		}
+	}
+}
+
+func TestAddU8(t *testing.T) {
+	// Pairs straddling the 255 boundary: 127+128 fits exactly, 128+128 and
+	// 255+1 overflow.
+	a := []byte{0, 1, 127, 128, 200, 254, 255, 255}
+	b := []byte{0, 254, 128, 128, 55, 1, 1, 255}
+	sat := []byte{0, 255, 255, 255, 255, 255, 255, 255}
+	wrap := []byte{0, 255, 255, 0, 255, 255, 0, 254}
+
+	for _, reps := range []int{1, 2, 5, 20} { // 8 to 160 bytes: scalar and SIMD
+		ra, rb := bytes.Repeat(a, reps), bytes.Repeat(b, reps)
+		dst := make([]byte, len(ra))
+		require.Equal(t, len(ra), AddU8Saturating(dst, ra, rb))
+		require.Equal(t, bytes.Repeat(sat, reps), dst, "saturating reps=%d", reps)
+		require.Equal(t, len(ra), AddU8Wrapping(dst, ra, rb))
+		require.Equal(t, bytes.Repeat(wrap, reps), dst, "wrapping reps=%d", reps)
+
+		// In place, with dst aliasing a.
+		AddU8Saturating(ra, ra, rb)
+		require.Equal(t, bytes.Repeat(sat, reps), ra, "in-place reps=%d", reps)
+	}
+
+	// Copy-like lengths: the shortest slice wins.
+	dst := make([]byte, 100)
+	require.Equal(t, 40, AddU8Saturating(dst, make([]byte, 40), make([]byte, 70)))
+	require.Equal(t, 0, AddU8Wrapping(nil, a, b))
+}
+
+func TestDotU8(t *testing.T) {
+	for _, n := range []int{0, 1, 15, 16, 17, 63, 64, 65, 1000} {
+		a := bytes.Repeat([]byte{255}, n)
+		require.Equal(t, uint64(n)*255*255, DotU8(a, a), "n=%d", n)
+	}
+	require.Equal(t, uint64(1*4+2*5+3*6), DotU8([]byte{1, 2, 3}, []byte{4, 5, 6}))
+	require.Panics(t, func() { DotU8(make([]byte, 3), make([]byte, 4)) })
+}
+
+func TestDotF32(t *testing.T) {
+	for _, n := range []int{0, 1, 15, 16, 17, 63, 64, 65, 1000} {
+		a := make([]float32, n)
+		b := make([]float32, n)
+		for i := range a {
+			a[i], b[i] = 0.5, float32(i%4) // exactly representable products
+		}
+		var want float64
+		for i := range a {
+			want += 0.5 * float64(i%4)
+		}
+		require.Equal(t, want, DotF32(a, b), "n=%d", n)
+	}
+	require.Panics(t, func() { DotF32(make([]float32, 3), nil) })
+}
@@ -0,0 +1,222 @@
+package algo
+
+import (
+	"strconv"
+
+	"github.com/miretskiy/simba/pkg/intrinsics"
+)
+
+// TagSpec describes a tag or label naming scheme for NewTagValidator.
+type TagSpec struct {
+	// StartSet holds the bytes allowed as the first byte.
+	StartSet *ByteSet
+	// MidSet holds the bytes allowed after the first one.
+	MidSet *ByteSet
+	// MinLen is the minimum length in bytes.  Empty tags are always
+	// rejected, so values below 1 mean 1.
+	MinLen int
+	// MaxLen is the maximum length in bytes; 0 means unbounded.
+	MaxLen int
+	// ForbidDoubleRune, if non-zero, may not appear twice in a row.
+	ForbidDoubleRune byte
+	// ForbidTrailingRune, if non-zero, may not be the last byte.
+	ForbidTrailingRune byte
+}
+
+// TagValidator checks strings against a TagSpec.  The character sets are
+// checked with the AllBytesInSet kernel and the ForbidDoubleRune rule with
+// the IndexByte kernel, so long inputs are scanned with SIMD.  A
+// TagValidator is immutable and safe for concurrent use.
+type TagValidator struct {
+	start, mid     ByteSet
+	minLen, maxLen int
+	double         byte
+	trailing       byte
+}
+
+// NewTagValidator returns a validator for spec.  The sets are copied, so
+// later changes to them do not affect the validator.  A nil set admits no
+// bytes.
+func NewTagValidator(spec TagSpec) *TagValidator {
+	v := &TagValidator{
+		minLen:   spec.MinLen,
+		maxLen:   spec.MaxLen,
+		double:   spec.ForbidDoubleRune,
+		trailing: spec.ForbidTrailingRune,
+	}
+	if spec.StartSet != nil {
+		v.start = *spec.StartSet
+	}
+	if spec.MidSet != nil {
+		v.mid = *spec.MidSet
+	}
+	return v
+}
+
+// datadogStart and datadogMid are the Datadog tag character sets: a-z or ':'
+// first, then a-z, 0-9, ':', '.', '/', '-' or '_'.
+var datadogStart, datadogMid = func() (start, mid ByteSet) {
+	for c := 'a'; c <= 'z'; c++ {
+		start[c], mid[c] = 1, 1
+	}
+	for c := '0'; c <= '9'; c++ {
+		mid[c] = 1
+	}
+	for _, c := range []byte(":./-_") {
+		mid[c] = 1
+	}
+	start[':'] = 1
+	return start, mid
+}()
+
+// datadogTags checks the Datadog character and underscore rules for
+// ValidateTagASCII, which checks the length against TagLengthPolicy itself.
+var datadogTags = NewTagValidator(TagSpec{
+	StartSet:           &datadogStart,
+	MidSet:             &datadogMid,
+	ForbidDoubleRune:   '_',
+	ForbidTrailingRune: '_',
+})
+
+// DatadogTagValidator returns a validator for the Datadog tag rules
+// documented on ValidateTagsASCII, bounded by TagLengthPolicy as it stands
+// at the time of the call.  ValidateTagASCII applies the same rules with the
+// bounds in force on each call; the validator is for fixing the bounds or
+// deriving related specs via StartSet and MidSet.
+func DatadogTagValidator() *TagValidator {
+	return NewTagValidator(TagSpec{
+		StartSet:           &datadogStart,
+		MidSet:             &datadogMid,
+		MinLen:             TagLengthPolicy.Min,
+		MaxLen:             TagLengthPolicy.Max,
+		ForbidDoubleRune:   '_',
+		ForbidTrailingRune: '_',
+	})
+}
+
+// StartSet returns a copy of the bytes allowed first, for deriving a spec
+// from an existing validator.
+func (v *TagValidator) StartSet() *ByteSet {
+	s := v.start
+	return &s
+}
+
+// MidSet returns a copy of the bytes allowed after the first one.
+func (v *TagValidator) MidSet() *ByteSet {
+	s := v.mid
+	return &s
+}
+
+// Validate reports whether s satisfies the validator's spec.
+func (v *TagValidator) Validate(s string) bool {
+	n := len(s)
+	if n == 0 || n < v.minLen || v.maxLen > 0 && n > v.maxLen || v.start[s[0]] == 0 {
+		return false
+	}
+	data := stringBytes(s)
+	if !AllBytesInSet(data[1:], &v.mid) {
+		return false
+	}
+	if v.trailing != 0 && data[n-1] == v.trailing {
+		return false
+	}
+	if r := v.double; r != 0 {
+		for i := 0; i < n; {
+			j := IndexByte(data[i:], r)
+			if j < 0 {
+				break
+			}
+			i += j + 1
+			if i < n && data[i] == r {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// ValidateTagsASCII reports, for each tag, whether it is a valid Datadog
+// tag: its length satisfies TagLengthPolicy, it starts with a-z or ':', the
+// remaining bytes are a-z, 0-9, ':', '.', '/', '-' or '_', it contains no
+// "__" and it does not end in '_'.  Non-ASCII bytes are never valid.
+//
+// The whole slice is validated with one kernel call, which amortises the FFI
+// overhead across many short tags where a per-tag call would not pay off.
+// The kernel reads the string headers in place; the result slice is the
+// only allocation.
+func ValidateTagsASCII(tags []string) []bool {
+	out := make([]bool, len(tags))
+	intrinsics.ValidateTagBatch(tags, TagLengthPolicy.Min, TagLengthPolicy.Max, out)
+	return out
+}
+
+// ValidateTagASCII reports whether tag is valid under the rules of
+// ValidateTagsASCII.  Like ValidateTagsASCII it checks the length with
+// TagLengthPolicy.Check at the time of the call and always rejects an empty
+// tag; the remaining rules run on the Datadog-configured TagValidator.  It is
+// allocation-free: the tag is viewed in place rather than converted to a
+// byte slice.
+func ValidateTagASCII(tag string) bool {
+	return tag != "" && TagLengthPolicy.Check(len(tag)) && datadogTags.Validate(tag)
+}
+
+// Reasons reported in TagError.Reason, one per tag rule.
+const (
+	TagReasonLength             = "length out of range"
+	TagReasonBadStart           = "must start with a-z or ':'"
+	TagReasonDisallowed         = "disallowed character"
+	TagReasonDoubleUnderscore   = "double underscore"
+	TagReasonTrailingUnderscore = "trailing underscore"
+)
+
+// TagError describes why ValidateTagASCIIDetailed rejected a tag.  Offset is
+// the first byte that breaks a rule.  For a length violation that is the
+// first byte outside the accepted length: TagLengthPolicy.Max for an
+// overlong tag, and len(tag) for one shorter than TagLengthPolicy.Min (0 for
+// an empty tag).
+type TagError struct {
+	Offset int
+	Reason string
+}
+
+func (e *TagError) Error() string {
+	return "algo: invalid tag: " + e.Reason + " at input byte " + strconv.Itoa(e.Offset)
+}
+
+// ValidateTagASCIIDetailed is ValidateTagASCII with a diagnosis: it returns
+// nil for a valid tag and a *TagError otherwise.  ValidateTagASCII answers
+// first, so valid tags cost the same; only rejected tags are rescanned, with
+// a scalar loop, to find the offending byte.
+func ValidateTagASCIIDetailed(tag string) error {
+	if ValidateTagASCII(tag) {
+		return nil
+	}
+	return diagnoseTag(tag)
+}
+
+// diagnoseTag returns the first rule tag breaks, scanning left to right.
+func diagnoseTag(tag string) *TagError {
+	// An empty tag is rejected even when TagLengthPolicy.Min is 0.
+	if n := len(tag); n == 0 || !TagLengthPolicy.Check(n) {
+		if n < max(TagLengthPolicy.Min, 1) {
+			return &TagError{Offset: n, Reason: TagReasonLength}
+		}
+		return &TagError{Offset: TagLengthPolicy.Max, Reason: TagReasonLength}
+	}
+	if datadogStart[tag[0]] == 0 {
+		return &TagError{Offset: 0, Reason: TagReasonBadStart}
+	}
+	for i := 1; i < len(tag); i++ {
+		switch c := tag[i]; {
+		case datadogMid[c] == 0:
+			return &TagError{Offset: i, Reason: TagReasonDisallowed}
+		case c == '_' && tag[i-1] == '_':
+			return &TagError{Offset: i, Reason: TagReasonDoubleUnderscore}
+		case c == '_' && i == len(tag)-1:
+			return &TagError{Offset: i, Reason: TagReasonTrailingUnderscore}
+		}
+	}
+	// Unreachable while the validator and this scan agree; report the whole
+	// tag rather than claim it is valid.
+	return &TagError{Offset: 0, Reason: TagReasonDisallowed}
+}
@@ -0,0 +1,305 @@
+package algo
+
+import (
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagValidatorCustomCharset(t *testing.T) {
+	// Datadog-like rules, but with uppercase allowed and '.' forbidden.
+	dd := DatadogTagValidator()
+	start, mid := *dd.StartSet(), *dd.MidSet()
+	for c := 'A'; c <= 'Z'; c++ {
+		start[c], mid[c] = 1, 1
+	}
+	mid['.'] = 0
+	v := NewTagValidator(TagSpec{
+		StartSet:           &start,
+		MidSet:             &mid,
+		MaxLen:             32,
+		ForbidDoubleRune:   '_',
+		ForbidTrailingRune: '_',
+	})
+
+	// The spec is copied at construction.
+	mid['.'] = 1
+	require.False(t, v.Validate("a.b"))
+
+	for _, tag := range []string{"Env:Prod", "a", "A_b", "service:Web-1/x", strings.Repeat("X", 32)} {
+		require.True(t, v.Validate(tag), tag)
+	}
+	for _, tag := range []string{
+		"", "a.b", "1abc", "_abc", "a__b", "abc_", "h\xc3\xa9llo", strings.Repeat("X", 33),
+		strings.Repeat("Ab-", 10) + "__",
+	} {
+		require.False(t, v.Validate(tag), tag)
+	}
+}
+
+func TestTagValidatorPrometheusLabel(t *testing.T) {
+	// Prometheus label names: [a-zA-Z_][a-zA-Z0-9_]*, no length limit.
+	var start, mid ByteSet
+	for c := 0; c < 256; c++ {
+		letter := c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_'
+		if letter {
+			start[c] = 1
+		}
+		if letter || c >= '0' && c <= '9' {
+			mid[c] = 1
+		}
+	}
+	v := NewTagValidator(TagSpec{StartSet: &start, MidSet: &mid})
+
+	for _, name := range []string{"job", "__name__", "http_requests_total", "_", strings.Repeat("a1", 300)} {
+		require.True(t, v.Validate(name), name)
+	}
+	for _, name := range []string{"", "1job", "job-name", "job.name", "job:x", strings.Repeat("a1", 300) + "-"} {
+		require.False(t, v.Validate(name), name)
+	}
+}
+
+func TestTagValidatorMatchesKernel(t *testing.T) {
+	// DatadogTagValidator, a validator rebuilt from its sets,
+	// ValidateTagASCII and the batch kernel behind ValidateTagsASCII must
+	// all agree.
+	dd := DatadogTagValidator()
+	generic := NewTagValidator(TagSpec{
+		StartSet:           dd.StartSet(),
+		MidSet:             dd.MidSet(),
+		MinLen:             TagLengthPolicy.Min,
+		MaxLen:             TagLengthPolicy.Max,
+		ForbidDoubleRune:   '_',
+		ForbidTrailingRune: '_',
+	})
+	const alphabet = "abcxyz019:./-_A\x80 "
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 5000; i++ {
+		b := make([]byte, r.Intn(220))
+		for j := range b {
+			b[j] = alphabet[r.Intn(len(alphabet))]
+		}
+		tag := string(b)
+		want := validateTagScalar(tag)
+		require.Equal(t, want, generic.Validate(tag), tag)
+		require.Equal(t, want, dd.Validate(tag), tag)
+		require.Equal(t, want, ValidateTagASCII(tag), tag)
+		require.Equal(t, want, ValidateTagsASCII([]string{tag})[0], tag)
+	}
+}
+
+func TestValidateTagASCIIAroundThreshold(t *testing.T) {
+	// Tags below simdLUTThreshold take AllBytesInSet's scalar path, longer
+	// ones the kernel; both must give the same answers, rule by rule.
+	for n := 1; n <= 2*simdLUTThreshold; n++ {
+		valid := []byte("e" + strings.Repeat("a:/.-_9", n)[:n-1])
+		if valid[n-1] == '_' {
+			valid[n-1] = 'z'
+		}
+		variants := []string{string(valid)}
+		for _, edit := range []struct {
+			at int
+			c  byte
+		}{{0, '1'}, {n - 1, '_'}, {n / 2, 'A'}, {n / 2, 0x80}, {n - 1, ' '}} {
+			b := append([]byte(nil), valid...)
+			b[edit.at] = edit.c
+			variants = append(variants, string(b))
+		}
+		if n >= 3 {
+			b := append([]byte(nil), valid...)
+			b[1], b[2] = '_', '_'
+			variants = append(variants, string(b))
+		}
+		for _, tag := range variants {
+			require.Equal(t, validateTagScalar(tag), ValidateTagASCII(tag), "%q", tag)
+		}
+	}
+	require.False(t, ValidateTagASCII(""))
+}
+
+func TestDatadogTagValidatorLengthPolicy(t *testing.T) {
+	prev := TagLengthPolicy
+	t.Cleanup(func() { TagLengthPolicy = prev })
+
+	// A validator takes the bounds in force when it is built, the same ones
+	// ValidateTagASCII reads on every call.
+	TagLengthPolicy = LengthPolicy{Min: 3, Max: 10}
+	dd := DatadogTagValidator()
+	for _, tag := range []string{"ab", "abc", "abcdefghij", "abcdefghijk"} {
+		require.Equal(t, ValidateTagASCII(tag), dd.Validate(tag), tag)
+		require.Equal(t, validateTagScalar(tag), dd.Validate(tag), tag)
+	}
+
+	TagLengthPolicy = prev
+	require.False(t, dd.Validate("ab"), "bounds are fixed at construction")
+	require.True(t, DatadogTagValidator().Validate("ab"))
+}
+
+// validateTagScalar is the per-tag reference for ValidateTagsASCII.
+func validateTagScalar(tag string) bool {
+	if !TagLengthPolicy.Check(len(tag)) {
+		return false
+	}
+	start := tag[0] >= 'a' && tag[0] <= 'z' || tag[0] == ':'
+	if !start || tag[len(tag)-1] == '_' || strings.Contains(tag, "__") {
+		return false
+	}
+	for i := 0; i < len(tag); i++ {
+		c := tag[i]
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || strings.IndexByte(":./-_", c) >= 0) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestValidateTagsASCII(t *testing.T) {
+	tags := []string{
+		"", "a", ":", "env:prod", "1abc", "_abc", "abc_", "a_b", "a__b", "Env:prod", "héllo",
+		strings.Repeat("x", 200), strings.Repeat("x", 201),
+		"service:" + strings.Repeat("web-", 30), "service:" + strings.Repeat("web-", 30) + "__x",
+	}
+	const alphabet = "abcxyz019:./-_A\x80 "
+	r := rand.New(rand.NewSource(25))
+	for i := 0; i < 2000; i++ {
+		b := make([]byte, r.Intn(60))
+		for j := range b {
+			b[j] = alphabet[r.Intn(len(alphabet))]
+		}
+		tags = append(tags, string(b))
+	}
+
+	for _, tag := range tags {
+		if got, want := ValidateTagASCII(tag), validateTagScalar(tag); got != want {
+			t.Errorf("ValidateTagASCII(%q) = %v, want %v", tag, got, want)
+		}
+	}
+
+	got := ValidateTagsASCII(tags)
+	if len(got) != len(tags) {
+		t.Fatalf("got %d results for %d tags", len(got), len(tags))
+	}
+	for i, tag := range tags {
+		if want := validateTagScalar(tag); got[i] != want {
+			t.Errorf("ValidateTagsASCII(%q) = %v, want %v", tag, got[i], want)
+		}
+	}
+	if len(ValidateTagsASCII(nil)) != 0 {
+		t.Errorf("ValidateTagsASCII(nil) returned results")
+	}
+}
+
+func TestValidateTagASCIIDetailed(t *testing.T) {
+	long := strings.Repeat("x", 201)
+	cases := []struct {
+		tag    string
+		offset int
+		reason string
+	}{
+		{"", 0, TagReasonLength},
+		{long, 200, TagReasonLength},
+		{"1abc", 0, TagReasonBadStart},
+		{"_abc", 0, TagReasonBadStart},
+		{"Env:prod", 0, TagReasonBadStart},
+		{"env:Prod", 4, TagReasonDisallowed},
+		{"h\xc3\xa9llo", 1, TagReasonDisallowed},
+		{"env prod", 3, TagReasonDisallowed},
+		{"a__b", 2, TagReasonDoubleUnderscore},
+		{"a__", 2, TagReasonDoubleUnderscore},
+		{"abc_", 3, TagReasonTrailingUnderscore},
+		{"service:" + strings.Repeat("web-", 30) + "__x", 129, TagReasonDoubleUnderscore},
+		{"service:" + strings.Repeat("web-", 30) + "!", 128, TagReasonDisallowed},
+	}
+	for _, c := range cases {
+		err := ValidateTagASCIIDetailed(c.tag)
+		var te *TagError
+		if !errors.As(err, &te) {
+			t.Errorf("ValidateTagASCIIDetailed(%q) = %v, want *TagError", c.tag, err)
+			continue
+		}
+		if te.Offset != c.offset || te.Reason != c.reason {
+			t.Errorf("ValidateTagASCIIDetailed(%q) = {%d, %q}, want {%d, %q}",
+				c.tag, te.Offset, te.Reason, c.offset, c.reason)
+		}
+	}
+
+	for _, tag := range []string{"a", ":", "env:prod", "a_b", strings.Repeat("x", 200)} {
+		if err := ValidateTagASCIIDetailed(tag); err != nil {
+			t.Errorf("ValidateTagASCIIDetailed(%q) = %v, want nil", tag, err)
+		}
+	}
+
+	// TagLengthPolicy is mutable; a zero Min must not let the empty tag
+	// through to the start-byte check.
+	prev := TagLengthPolicy
+	TagLengthPolicy.Min = 0
+	err := ValidateTagASCIIDetailed("")
+	TagLengthPolicy = prev
+	var te *TagError
+	if !errors.As(err, &te) || te.Offset != 0 || te.Reason != TagReasonLength {
+		t.Errorf("with Min 0, ValidateTagASCIIDetailed(\"\") = %v, want {0, %q}", err, TagReasonLength)
+	}
+
+	// A tag shorter than Min is reported at its end, where the first missing
+	// byte would go.
+	TagLengthPolicy.Min = 4
+	err = ValidateTagASCIIDetailed("abc")
+	TagLengthPolicy = prev
+	if !errors.As(err, &te) || te.Offset != 3 || te.Reason != TagReasonLength {
+		t.Errorf("with Min 4, ValidateTagASCIIDetailed(\"abc\") = %v, want {3, %q}", err, TagReasonLength)
+	}
+
+	// The diagnosis must agree with the boolean validator on every input.
+	const alphabet = "abcxyz019:./-_A\x80 "
+	r := rand.New(rand.NewSource(41))
+	for i := 0; i < 2000; i++ {
+		b := make([]byte, r.Intn(60))
+		for j := range b {
+			b[j] = alphabet[r.Intn(len(alphabet))]
+		}
+		tag := string(b)
+		if got, want := ValidateTagASCIIDetailed(tag) == nil, validateTagScalar(tag); got != want {
+			t.Errorf("ValidateTagASCIIDetailed(%q) valid = %v, want %v", tag, got, want)
+		}
+	}
+}
+
+func TestValidateTagsASCIIUnboundedMax(t *testing.T) {
+	prev := TagLengthPolicy
+	t.Cleanup(func() { TagLengthPolicy = prev })
+
+	// With Max 0 or less the batch kernel and the single-tag validator both
+	// treat the length as unbounded.
+	long := "service:" + strings.Repeat("web-", 100)
+	tags := []string{"", "a", "env:prod", "a__b", "abc_", long, long + "__x"}
+	for _, maxLen := range []int{0, -1} {
+		TagLengthPolicy = LengthPolicy{Min: 1, Max: maxLen}
+		got := ValidateTagsASCII(tags)
+		for i, tag := range tags {
+			require.Equal(t, ValidateTagASCII(tag), got[i], "Max %d: %q", maxLen, tag)
+		}
+		require.True(t, got[5], "Max %d: long tag", maxLen)
+	}
+}
+
+func TestValidateTagASCIILengthPolicy(t *testing.T) {
+	prev := TagLengthPolicy
+	t.Cleanup(func() { TagLengthPolicy = prev })
+
+	// ValidateTagASCII and ValidateTagsASCII both judge length with
+	// TagLengthPolicy.Check, whatever the policy.
+	tags := []string{"", "a", "ab", "abc", "abcd", "abcdefghij", "abcdefghijk"}
+	for _, p := range []LengthPolicy{{Min: 0, Max: 4}, {Min: -3, Max: 4}, {Min: 3, Max: 10}, {Min: 5}, {Min: 2, Max: 2}} {
+		TagLengthPolicy = p
+		got := ValidateTagsASCII(tags)
+		for i, tag := range tags {
+			want := tag != "" && p.Check(len(tag))
+			require.Equal(t, want, ValidateTagASCII(tag), "%+v: %q", p, tag)
+			require.Equal(t, want, got[i], "%+v: %q", p, tag)
+			require.Equal(t, want, ValidateTagASCIIDetailed(tag) == nil, "%+v: %q", p, tag)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package algo
+
+// arm64 thresholds, kept apart from the other architectures so that server
+// cores (Graviton, Ampere, Neoverse in general) and the Apple M-series can
+// be tuned without touching the amd64 numbers in threshold_nocgo.go.
+//
+// The values below were measured on an Apple M2 Max.  Graviton is
+// unmeasured: no Graviton crossover has been recorded, so arm64 servers run
+// on the M2 values until someone records theirs.  To do so, run on the
+// target machine
+//
+//	go test -run '^$' -bench Crossover -count 10 ./pkg/algo | tee arm64.txt
+//
+// and pick, per kernel, the first length at which the simd rows are no
+// slower than the scalar ones (benchstat -col /path arm64.txt).  Note the CPU,
+// the Go version and the numbers here when a constant changes.
+
+// simdThreshold is the generic scalar/SIMD crossover for arm64.  With the
+// syso trampoline the per-call overhead on an M2 Max is ~0.3 ns, so a
+// quarter of a cache line (16 bytes) is already competitive.
+const simdThreshold = 16
+
+// crc32Threshold is the length from which CRC32 uses the SIMD kernels
+// instead of hash/crc32 on arm64.  Go's arm64 crc32 already uses the CRC32C
+// instructions, so the FFI path only wins once its per-call cost is
+// amortised: on an M2 Max (July 2025) break-even is ≈ 800 B and SIMD is
+// still ~9 % slower at 512 B.  A clean 1 KiB stays conservative.
+const crc32Threshold = 1024
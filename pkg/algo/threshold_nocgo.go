@@ -1,7 +1,22 @@
+//go:build !arm64
+
 package algo
 
-// Recent benchmarks with the syso trampoline show a per-call overhead of
-// just ~0.3 ns on an Apple M2 Max, meaning a **quarter** of a cache line
-// (16 bytes) is already competitive.  We drop the crossover to 16 B to favour
-// SIMD more aggressively on modern CPUs.
+// Thresholds for every architecture other than arm64, which reads its own
+// from threshold_arm64.go.
+//
+// No crossover has been recorded on x86 yet: these values are the arm64
+// defaults carried over, not tuned.  To measure them, run
+//
+//	go test -run '^$' -bench Crossover -count 10 ./pkg/algo
+//
+// on the target machine and note the CPU, the Go version and the numbers
+// here when a constant changes.
+
+// simdThreshold is the generic scalar/SIMD crossover.
 const simdThreshold = 16
+
+// crc32Threshold is the length from which CRC32 uses the SIMD kernels
+// instead of hash/crc32.  Go's amd64 crc32 uses PCLMULQDQ for large
+// buffers, so the FFI path needs a similar run to amortise its call.
+const crc32Threshold = 1024
@@ -0,0 +1,49 @@
+package algo
+
+import (
+	"fmt"
+	"testing"
+)
+
+var crossoverSink uint32
+
+// BenchmarkCrossover times the kernels behind the per-architecture thresholds
+// on both paths across a sweep of lengths, to locate the crossovers recorded
+// in threshold_*.go.  For each kernel the first length at which the simd
+// rows are no slower than the scalar rows is the crossover.
+func BenchmarkCrossover(b *testing.B) {
+	simd := NewWith(Config{ASCIIThreshold: 1, SumThreshold: 1, CRC32Threshold: 1})
+	scalar := NewWith(Config{ASCIIThreshold: 1 << 30, SumThreshold: 1 << 30, CRC32Threshold: 1 << 30})
+
+	data := make([]byte, 64<<10)
+	for i := range data {
+		data[i] = 'a' + byte(i%26)
+	}
+
+	for _, k := range []struct {
+		name string
+		fn   func(d *Dispatcher, b []byte)
+	}{
+		{"SumU8", func(d *Dispatcher, b []byte) { crossoverSink += d.SumU8(b) }},
+		{"IsASCII", func(d *Dispatcher, b []byte) {
+			if d.IsASCII(b) {
+				crossoverSink++
+			}
+		}},
+		{"CRC32", func(d *Dispatcher, b []byte) { crossoverSink += d.CRC32(b) }},
+	} {
+		for n := 8; n <= len(data); n *= 2 {
+			for _, p := range []struct {
+				name string
+				d    *Dispatcher
+			}{{"scalar", scalar}, {"simd", simd}} {
+				b.Run(fmt.Sprintf("%s/n=%d/path=%s", k.name, n, p.name), func(b *testing.B) {
+					b.SetBytes(int64(n))
+					for i := 0; i < b.N; i++ {
+						k.fn(p.d, data[:n])
+					}
+				})
+			}
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package algo
+
+import (
+	"math/bits"
+
+	"github.com/miretskiy/simba/pkg/intrinsics"
+)
+
+// asciiNonSpace is the complement of asciiWhitespace.
+var asciiNonSpace = func() *ByteSet {
+	var s ByteSet
+	for i, v := range asciiWhitespace {
+		if v == 0 {
+			s[i] = 1
+		}
+	}
+	return &s
+}()
+
+// trimBlock is how many leading bytes TrimASCIISpace classifies per kernel
+// call.  Leading whitespace is usually short, so small blocks avoid
+// classifying far past the first non-space byte.
+const trimBlock = 256
+
+// TrimASCIISpace returns the subslice of data with leading and trailing ASCII
+// whitespace (space, \t, \n, \v, \f, \r) removed, without copying.  Input
+// without surrounding whitespace is returned unchanged; all-whitespace input
+// yields data[:0].  Unlike bytes.TrimSpace it ignores Unicode spaces such as
+// U+0085 and U+00A0.
+//
+// The first non-space byte is found with the InSetMasks64 kernel (the first
+// mask word that is not all ones), the last one with the LastIndexAnyByte
+// kernel on the complement set, which scans chunks from the end.
+func TrimASCIISpace(data []byte) []byte {
+	start := indexNonSpace(data)
+	if start < 0 {
+		return data[:0]
+	}
+	rest := data[start:]
+	var end int
+	if len(rest) < simdLUTThreshold {
+		end = len(rest) - 1
+		for asciiWhitespace[rest[end]] != 0 {
+			end--
+		}
+	} else {
+		end = intrinsics.LastIndexAnyByte(rest, asciiNonSpace)
+	}
+	return rest[:end+1]
+}
+
+// indexNonSpace returns the offset of the first byte of data that is not
+// ASCII whitespace, or -1 if there is none.
+func indexNonSpace(data []byte) int {
+	var masks [trimBlock / 64]uint64
+	full := len(data) &^ 63
+	for base := 0; base < full; base += trimBlock {
+		block := data[base:min(base+trimBlock, full)]
+		intrinsics.InSetMasks64(block, asciiWhitespace, masks[:])
+		for w, m := range masks[:len(block)/64] {
+			if m != ^uint64(0) {
+				return base + w*64 + bits.TrailingZeros64(^m)
+			}
+		}
+	}
+	for i := full; i < len(data); i++ {
+		if asciiWhitespace[data[i]] == 0 {
+			return i
+		}
+	}
+	return -1
+}
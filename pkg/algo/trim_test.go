@@ -0,0 +1,66 @@
+package algo
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrimASCIISpace(t *testing.T) {
+	require.Empty(t, TrimASCIISpace(nil))
+	require.Empty(t, TrimASCIISpace([]byte(" \t\r\n\v\f")))
+	require.Empty(t, TrimASCIISpace(bytes.Repeat([]byte(" \n"), 300)))
+
+	// No surrounding whitespace: the same slice comes back.
+	for _, s := range []string{"x", "a b", "word\twith inner  spaces", string(bytes.Repeat([]byte("ab "), 100)) + "z"} {
+		data := []byte(s)
+		got := TrimASCIISpace(data)
+		require.Equal(t, len(data), len(got))
+		require.Same(t, &data[0], &got[0])
+	}
+
+	// Unicode spaces (here U+00A0) are not trimmed.
+	require.Equal(t, "\u00a0x\u00a0", string(TrimASCIISpace([]byte(" \u00a0x\u00a0\n"))))
+
+	r := rand.New(rand.NewSource(15))
+	ws := []byte(" \t\n\v\f\r")
+	for i := 0; i < 500; i++ {
+		pad := func() []byte {
+			p := make([]byte, r.Intn(300))
+			for j := range p {
+				p[j] = ws[r.Intn(len(ws))]
+			}
+			return p
+		}
+		core := make([]byte, r.Intn(200))
+		for j := range core {
+			core[j] = byte('!' + r.Intn(90))
+			if r.Intn(5) == 0 {
+				core[j] = ws[r.Intn(len(ws))]
+			}
+		}
+		data := append(append(pad(), core...), pad()...)
+		require.Equal(t, string(bytes.TrimSpace(data)), string(TrimASCIISpace(data)), "case %d", i)
+	}
+}
+
+var trimSink []byte
+
+func BenchmarkTrimASCIISpace(b *testing.B) {
+	for _, pad := range []int{4, 64, 1024} {
+		data := append(append(bytes.Repeat([]byte(" \t"), pad/2), bytes.Repeat([]byte("log line "), 100)...), bytes.Repeat([]byte("\r\n"), pad/2)...)
+		b.Run(fmt.Sprintf("BytesTrimSpace_pad%d", pad), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				trimSink = bytes.TrimSpace(data)
+			}
+		})
+		b.Run(fmt.Sprintf("Algo_pad%d", pad), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				trimSink = TrimASCIISpace(data)
+			}
+		})
+	}
+}
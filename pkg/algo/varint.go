@@ -0,0 +1,49 @@
+package algo
+
+import "github.com/miretskiy/simba/pkg/intrinsics"
+
+// varintCont is the LEB128 continuation bit: every byte of a varint except
+// the last has it set.
+const varintCont = 0x80
+
+// ScanVarints splits data into consecutive LEB128 varints without decoding
+// them.  offsets[i] receives the exclusive end offset of the i-th varint, so
+// varint i spans data[offsets[i-1]:offsets[i]] (with offsets[-1] == 0).
+//
+// Scanning stops when offsets is full or the input is exhausted.  It returns
+// the number of varints found and the number of bytes they cover; a trailing
+// run of continuation bytes without a terminator is left unconsumed, so
+// consumed < len(data) signals either a full offsets slice or a truncated
+// (malformed) final varint.  Varint length is not capped at the 10 bytes
+// Protobuf allows; callers that care must check the spans themselves.
+func ScanVarints(data []byte, offsets []int) (count int, consumed int) {
+	for count < len(offsets) && consumed < len(data) {
+		end := indexVarintEnd(data[consumed:])
+		if end < 0 {
+			break
+		}
+		consumed += end + 1
+		offsets[count] = consumed
+		count++
+	}
+	return count, consumed
+}
+
+// indexVarintEnd returns the offset of the terminating byte of the varint at
+// the start of data, or -1 if data ends mid-varint.  Single-byte varints are
+// by far the most common, so the first byte is checked inline before paying
+// for the SIMD scan.
+func indexVarintEnd(data []byte) int {
+	if data[0] < varintCont {
+		return 0
+	}
+	if len(data) < simdThreshold {
+		for i := 1; i < len(data); i++ {
+			if data[i] < varintCont {
+				return i
+			}
+		}
+		return -1
+	}
+	return intrinsics.IndexByteLess(data, varintCont)
+}
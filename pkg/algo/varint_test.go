@@ -0,0 +1,76 @@
+package algo
+
+import (
+	"encoding/binary"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// scalarScanVarints is the byte-at-a-time reference boundary scanner.
+func scalarScanVarints(data []byte, offsets []int) (count int, consumed int) {
+	for i, b := range data {
+		if count == len(offsets) {
+			break
+		}
+		if b < 0x80 {
+			offsets[count] = i + 1
+			count++
+			consumed = i + 1
+		}
+	}
+	return count, consumed
+}
+
+func TestScanVarints(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var data []byte
+	var ends []int
+	for i := 0; i < 500; i++ {
+		// Mix short and long varints so both the inline and SIMD paths run.
+		v := r.Uint64() >> uint(r.Intn(64))
+		data = binary.AppendUvarint(data, v)
+		ends = append(ends, len(data))
+	}
+
+	offsets := make([]int, len(ends)+1)
+	count, consumed := ScanVarints(data, offsets)
+	require.Equal(t, len(ends), count)
+	require.Equal(t, len(data), consumed)
+	require.Equal(t, ends, offsets[:count])
+
+	// Truncated trailing varint: 20 continuation bytes without a terminator.
+	bad := append(append([]byte(nil), data...), make([]byte, 20)...)
+	for i := len(data); i < len(bad); i++ {
+		bad[i] = 0xFF
+	}
+	wantOff := make([]int, len(offsets))
+	wantCount, wantConsumed := scalarScanVarints(bad, wantOff)
+	count, consumed = ScanVarints(bad, offsets)
+	require.Equal(t, wantCount, count)
+	require.Equal(t, wantConsumed, consumed)
+	require.Less(t, consumed, len(bad))
+	require.Equal(t, wantOff[:wantCount], offsets[:count])
+
+	// Offsets capacity limits the scan.
+	small := make([]int, 7)
+	count, consumed = ScanVarints(data, small)
+	require.Equal(t, 7, count)
+	require.Equal(t, ends[6], consumed)
+}
+
+func TestScanVarintsLong(t *testing.T) {
+	// Continuation runs long enough to exercise every lane width.
+	for _, n := range []int{1, 15, 16, 17, 33, 64, 65, 130} {
+		data := make([]byte, n)
+		for i := range data[:n-1] {
+			data[i] = 0x80 | byte(i)
+		}
+		data = append(data, 0x80) // dangling continuation byte
+		offsets := make([]int, 2)
+		count, consumed := ScanVarints(data, offsets)
+		require.Equal(t, 1, count, "n=%d", n)
+		require.Equal(t, n, consumed, "n=%d", n)
+	}
+}
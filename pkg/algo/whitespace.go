@@ -0,0 +1,89 @@
+package algo
+
+import (
+	"math/bits"
+
+	"github.com/miretskiy/simba/pkg/intrinsics"
+)
+
+// asciiWhitespace is the ByteSet of ASCII whitespace: space, \t, \n, \v, \f
+// and \r.
+var asciiWhitespace = MakeByteSet(' ', '\t', '\n', '\v', '\f', '\r')
+
+// collapseBlock is how many bytes CollapseWhitespace classifies per kernel
+// call; 4 KiB keeps the mask array on the stack.
+const collapseBlock = 4096
+
+// CollapseWhitespace copies src into dst with every maximal run of ASCII
+// whitespace (space, \t, \n, \v, \f, \r) replaced by a single ' ', and
+// returns the number of bytes written.  Leading and trailing runs are
+// collapsed too, not trimmed.
+//
+// Like FilterBytes it follows copy-like semantics: only the first
+// min(len(src), len(dst)) bytes of src are processed.  dst may be src itself
+// for in-place use.
+//
+// Inputs of 64 bytes or more are classified with the InSetMasks64 kernel and
+// whitespace runs are located on the mask words with trailing-zero counts; a
+// run that reaches the end of a word is carried into the next one.  Non-space
+// stretches between runs are moved with a single copy each.
+func CollapseWhitespace(dst, src []byte) int {
+	n := len(src)
+	if len(dst) < n {
+		n = len(dst)
+	}
+	src = src[:n]
+
+	// next is the first src byte not yet emitted; inSpace reports whether
+	// the bytes just before next form a run that has already produced its ' '.
+	out, next, inSpace := 0, 0, false
+	startRun := func(pos int) {
+		out += copy(dst[out:], src[next:pos])
+		dst[out] = ' '
+		out++
+	}
+
+	var masks [collapseBlock / 64]uint64
+	full := n &^ 63
+	for base := 0; base < full; base += collapseBlock {
+		block := src[base:min(base+collapseBlock, full)]
+		intrinsics.InSetMasks64(block, asciiWhitespace, masks[:])
+		for w, m := range masks[:len(block)/64] {
+			off := base + w*64
+			if inSpace {
+				t := bits.TrailingZeros64(^m)
+				if t == 64 {
+					continue
+				}
+				next, inSpace = off+t, false
+				m &^= 1<<t - 1
+			}
+			for m != 0 {
+				s := bits.TrailingZeros64(m)
+				l := min(bits.TrailingZeros64(^(m >> s)), 64-s)
+				startRun(off + s)
+				if s+l == 64 {
+					inSpace = true
+					break
+				}
+				next = off + s + l
+				m &^= (1<<l - 1) << s
+			}
+		}
+	}
+
+	for i := full; i < n; i++ {
+		if asciiWhitespace[src[i]] != 0 {
+			if !inSpace {
+				startRun(i)
+				inSpace = true
+			}
+		} else if inSpace {
+			next, inSpace = i, false
+		}
+	}
+	if !inSpace {
+		out += copy(dst[out:], src[next:])
+	}
+	return out
+}
@@ -0,0 +1,52 @@
+package algo
+
+import (
+	"bytes"
+	"testing"
+)
+
+func scalarCollapseWhitespace(src []byte) []byte {
+	out := make([]byte, 0, len(src))
+	inSpace := false
+	for _, b := range src {
+		switch b {
+		case ' ', '\t', '\n', '\v', '\f', '\r':
+			if !inSpace {
+				out = append(out, ' ')
+			}
+			inSpace = true
+		default:
+			out = append(out, b)
+			inSpace = false
+		}
+	}
+	return out
+}
+
+func FuzzCollapseWhitespace(f *testing.F) {
+	f.Add([]byte(""))
+	f.Add([]byte("  leading and trailing \t\n"))
+	f.Add(bytes.Repeat([]byte("word \t "), 40))
+	// A run straddling the first 64-byte chunk edge and one filling a chunk.
+	edge := bytes.Repeat([]byte("x"), 200)
+	copy(edge[60:], "\t\t\t\t\t\t\t\t")
+	copy(edge[128:], bytes.Repeat([]byte(" "), 64))
+	f.Add(edge)
+	f.Add(bytes.Repeat([]byte(" "), 4200))
+
+	f.Fuzz(func(t *testing.T, src []byte) {
+		want := scalarCollapseWhitespace(src)
+
+		dst := make([]byte, len(src))
+		n := CollapseWhitespace(dst, src)
+		if !bytes.Equal(dst[:n], want) {
+			t.Fatalf("CollapseWhitespace(%q) = %q, want %q", src, dst[:n], want)
+		}
+
+		inPlace := append([]byte(nil), src...)
+		n = CollapseWhitespace(inPlace, inPlace)
+		if !bytes.Equal(inPlace[:n], want) {
+			t.Fatalf("in-place CollapseWhitespace(%q) = %q, want %q", src, inPlace[:n], want)
+		}
+	})
+}
@@ -0,0 +1,182 @@
+package codec
+
+import (
+	"strconv"
+
+	"github.com/miretskiy/simba/pkg/intrinsics"
+)
+
+// base32Alphabet is the RFC 4648 standard alphabet.
+const base32Alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567"
+
+const base32Pad = '='
+
+// The SIMD kernels take over once the input covers one full 8-group step
+// (40 source bytes when encoding, 64 encoded bytes when decoding).
+const (
+	base32EncodeThreshold = 40
+	base32DecodeThreshold = 64
+)
+
+var base32DecodeMap = func() (m [256]byte) {
+	for i := range m {
+		m[i] = 0xFF
+	}
+	for i := 0; i < len(base32Alphabet); i++ {
+		m[base32Alphabet[i]] = byte(i)
+	}
+	return m
+}()
+
+// CorruptInputError reports the offset of the first invalid byte in a
+// base32 input: a byte outside the alphabet, a misplaced or miscounted '='
+// or a truncated final group.
+type CorruptInputError int64
+
+func (e CorruptInputError) Error() string {
+	return "codec: illegal base32 data at input byte " + strconv.FormatInt(int64(e), 10)
+}
+
+// Base32EncodedLen returns the length of the base32 encoding of n bytes,
+// with or without '=' padding to a multiple of 8.
+func Base32EncodedLen(n int, pad bool) int {
+	if pad {
+		return (n + 4) / 5 * 8
+	}
+	return (n*8 + 4) / 5
+}
+
+// Base32DecodedLen returns the maximum number of bytes decoded from n bytes
+// of base32 input.
+func Base32DecodedLen(n int, pad bool) int {
+	if pad {
+		return n / 8 * 5
+	}
+	return n * 5 / 8
+}
+
+// Base32Encode encodes src with the RFC 4648 standard alphabet into dst and
+// returns Base32EncodedLen(len(src), pad), the number of bytes written.  With
+// pad set the output is padded with '=' to a multiple of 8 bytes, matching
+// base32.StdEncoding; without it the output matches
+// base32.StdEncoding.WithPadding(base32.NoPadding).  It panics if dst is
+// shorter than the encoded length.
+func Base32Encode(dst, src []byte, pad bool) int {
+	n := Base32EncodedLen(len(src), pad)
+	if len(dst) < n {
+		panic("codec: Base32Encode dst too short")
+	}
+
+	di := 0
+	if len(src) >= base32EncodeThreshold {
+		di = intrinsics.Base32EncodeBlocks(dst, src)
+	}
+	si := di / 8 * 5
+	for ; si+5 <= len(src); si, di = si+5, di+8 {
+		v := uint64(src[si])<<32 | uint64(src[si+1])<<24 | uint64(src[si+2])<<16 |
+			uint64(src[si+3])<<8 | uint64(src[si+4])
+		for k := 0; k < 8; k++ {
+			dst[di+k] = base32Alphabet[(v>>(35-5*k))&31]
+		}
+	}
+
+	if rem := len(src) - si; rem > 0 {
+		var v uint64
+		for i := 0; i < 5; i++ {
+			v <<= 8
+			if i < rem {
+				v |= uint64(src[si+i])
+			}
+		}
+		chars := (rem*8 + 4) / 5
+		for k := 0; k < chars; k++ {
+			dst[di+k] = base32Alphabet[(v>>(35-5*k))&31]
+		}
+		di += chars
+		for ; di < n; di++ {
+			dst[di] = base32Pad
+		}
+	}
+	return n
+}
+
+// Base32Decode decodes RFC 4648 base32 from src into dst and returns the
+// number of bytes written.  With pad set src must be '='-padded to a
+// multiple of 8 bytes, as produced by base32.StdEncoding; without it '=' is
+// not accepted at all.  Unlike encoding/base32, line breaks are not skipped,
+// and a final group of 1, 3 or 6 characters, which no encoder produces, is
+// rejected instead of being silently dropped.
+//
+// On malformed input it returns the bytes decoded before the first bad group
+// together with a CorruptInputError holding the offset of the offending
+// byte.  It panics if dst is shorter than Base32DecodedLen(len(src), pad).
+func Base32Decode(dst, src []byte, pad bool) (int, error) {
+	if len(dst) < Base32DecodedLen(len(src), pad) {
+		panic("codec: Base32Decode dst too short")
+	}
+
+	body := src
+	if pad {
+		if len(src)%8 != 0 {
+			return 0, CorruptInputError(len(src) &^ 7)
+		}
+		padding := 0
+		for padding < len(src) && padding < 8 && src[len(src)-1-padding] == base32Pad {
+			padding++
+		}
+		switch padding {
+		case 0, 1, 3, 4, 6:
+		default:
+			return 0, CorruptInputError(len(src) - padding)
+		}
+		body = src[:len(src)-padding]
+	}
+
+	si := 0
+	if len(body) >= base32DecodeThreshold {
+		si = intrinsics.Base32DecodeBlocks(dst, body)
+	}
+	di := si / 8 * 5
+	for ; si+8 <= len(body); si, di = si+8, di+5 {
+		v, bad := base32DecodeBits(body[si : si+8])
+		if bad >= 0 {
+			return di, CorruptInputError(si + bad)
+		}
+		for j := 0; j < 5; j++ {
+			dst[di+j] = byte(v >> (32 - 8*j))
+		}
+	}
+
+	rem := len(body) - si
+	switch rem {
+	case 0:
+		return di, nil
+	case 2, 4, 5, 7:
+	default:
+		return di, CorruptInputError(si + rem - 1)
+	}
+	v, bad := base32DecodeBits(body[si:])
+	if bad >= 0 {
+		return di, CorruptInputError(si + bad)
+	}
+	v <<= 5 * uint(8-rem)
+	out := rem * 5 / 8
+	for j := 0; j < out; j++ {
+		dst[di+j] = byte(v >> (32 - 8*j))
+	}
+	return di + out, nil
+}
+
+// base32DecodeBits packs up to 8 base32 characters into the low 5*len(chars)
+// bits of a uint64.  bad is the index of the first byte outside the
+// alphabet, or -1.
+func base32DecodeBits(chars []byte) (v uint64, bad int) {
+	for i, c := range chars {
+		d := base32DecodeMap[c]
+		if d == 0xFF {
+			return 0, i
+		}
+		v = v<<5 | uint64(d)
+	}
+	return v, -1
+}
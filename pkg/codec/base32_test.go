@@ -0,0 +1,57 @@
+package codec
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBase32RFC4648(t *testing.T) {
+	for _, tc := range []struct{ in, padded, raw string }{
+		{"", "", ""},
+		{"f", "MY======", "MY"},
+		{"fo", "MZXQ====", "MZXQ"},
+		{"foo", "MZXW6===", "MZXW6"},
+		{"foob", "MZXW6YQ=", "MZXW6YQ"},
+		{"fooba", "MZXW6YTB", "MZXW6YTB"},
+		{"foobar", "MZXW6YTBOI======", "MZXW6YTBOI"},
+	} {
+		for _, pad := range []bool{true, false} {
+			want := tc.raw
+			if pad {
+				want = tc.padded
+			}
+			enc := make([]byte, Base32EncodedLen(len(tc.in), pad))
+			require.Equal(t, len(want), Base32Encode(enc, []byte(tc.in), pad))
+			require.Equal(t, want, string(enc), "encode %q pad=%v", tc.in, pad)
+
+			dec := make([]byte, Base32DecodedLen(len(want), pad))
+			n, err := Base32Decode(dec, []byte(want), pad)
+			require.NoError(t, err)
+			require.Equal(t, tc.in, string(dec[:n]), "decode %q pad=%v", want, pad)
+		}
+	}
+}
+
+func TestBase32DecodeErrors(t *testing.T) {
+	long := strings.Repeat("MZXW6YTB", 16)
+	for _, tc := range []struct {
+		in     string
+		pad    bool
+		offset int64
+	}{
+		{"MZXW6YT1", true, 7},
+		{"mzxw6ytb", false, 0},
+		{long[:100] + "!" + long[101:], false, 100},
+		{long + "MY=====", true, 128},  // not a multiple of 8
+		{long + "M=======", true, 129}, // 7 pad bytes
+		{long + "MY=A====", true, 130}, // '=' inside the group
+		{"MY======", false, 2},
+		{"MZXW6YTBM", false, 8}, // dangling single char
+	} {
+		dst := make([]byte, Base32DecodedLen(len(tc.in), tc.pad))
+		_, err := Base32Decode(dst, []byte(tc.in), tc.pad)
+		require.Equal(t, CorruptInputError(tc.offset), err, "%q pad=%v", tc.in, tc.pad)
+	}
+}
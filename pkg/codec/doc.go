@@ -0,0 +1,4 @@
+// Package codec provides binary-to-text encodings whose bulk work runs on the
+// SIMD kernels in the intrinsics package.  Complete groups are handed to the
+// kernels; partial trailing groups, padding and error reporting stay in Go.
+package codec
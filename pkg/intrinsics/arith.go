@@ -1,6 +1,10 @@
 package intrinsics
 
-import "github.com/miretskiy/simba/internal/ffi"
+import (
+	"math"
+
+	"github.com/miretskiy/simba/internal/ffi"
+)
 
 // SumU8 adds all bytes modulo 2^32.  intrinsics always delegate to SIMD; they
 // never fall back to scalar—that choice is made at the algo layer.
@@ -16,3 +20,251 @@ func SumU8(data []byte) uint32 {
 		return ffi.SumU8_16(data)
 	}
 }
+
+// SumU16 returns the sum of data widened to uint64, so unlike SumU8 it never
+// wraps.  The kernel accumulates in 32-bit lanes and drains them into a
+// 64-bit total before any lane can overflow.
+func SumU16(data []uint16) uint64 {
+	switch n := len(data); {
+	case n == 0:
+		return 0
+	case n >= 64:
+		return ffi.SumU16_64(data)
+	case n >= 32:
+		return ffi.SumU16_32(data)
+	default:
+		return ffi.SumU16_16(data)
+	}
+}
+
+// SumU32 returns the sum of data widened to uint64.  Each value is widened
+// into 64-bit lanes, which cannot overflow for any slice that fits in memory.
+func SumU32(data []uint32) uint64 {
+	switch n := len(data); {
+	case n == 0:
+		return 0
+	case n >= 64:
+		return ffi.SumU32_64(data)
+	case n >= 32:
+		return ffi.SumU32_32(data)
+	default:
+		return ffi.SumU32_16(data)
+	}
+}
+
+// MinF32 returns the smallest element of data.  NaN handling follows
+// math.Min: if any element is NaN the result is NaN, and -0 is considered
+// smaller than +0.  The kernel normalises this in software because hardware
+// SIMD min instructions differ across platforms.  An empty slice yields +Inf.
+func MinF32(data []float32) float32 {
+	switch n := len(data); {
+	case n == 0:
+		return float32(math.Inf(1))
+	case n >= 64:
+		return ffi.MinF32_64(data)
+	case n >= 32:
+		return ffi.MinF32_32(data)
+	default:
+		return ffi.MinF32_16(data)
+	}
+}
+
+// MaxF32 returns the largest element of data.  NaN handling follows
+// math.Max: if any element is NaN the result is NaN, and +0 is considered
+// larger than -0.  An empty slice yields -Inf.
+func MaxF32(data []float32) float32 {
+	switch n := len(data); {
+	case n == 0:
+		return float32(math.Inf(-1))
+	case n >= 64:
+		return ffi.MaxF32_64(data)
+	case n >= 32:
+		return ffi.MaxF32_32(data)
+	default:
+		return ffi.MaxF32_16(data)
+	}
+}
+
+// MinU8 returns the smallest byte of data.  An empty slice yields 0xFF, the
+// identity for min.
+func MinU8(data []byte) byte {
+	switch n := len(data); {
+	case n == 0:
+		return 0xFF
+	case n >= 64:
+		return ffi.MinU8_64(data)
+	case n >= 32:
+		return ffi.MinU8_32(data)
+	default:
+		return ffi.MinU8_16(data)
+	}
+}
+
+// MaxU8 returns the largest byte of data.  An empty slice yields 0, the
+// identity for max.
+func MaxU8(data []byte) byte {
+	switch n := len(data); {
+	case n == 0:
+		return 0
+	case n >= 64:
+		return ffi.MaxU8_64(data)
+	case n >= 32:
+		return ffi.MaxU8_32(data)
+	default:
+		return ffi.MaxU8_16(data)
+	}
+}
+
+// XorReduce returns the XOR of all bytes in data (0 for empty input), the
+// BSD-style XOR-fold checksum used by some serial and embedded protocols.
+func XorReduce(data []byte) byte {
+	switch n := len(data); {
+	case n == 0:
+		return 0
+	case n >= 64:
+		return ffi.XorReduce64(data)
+	case n >= 32:
+		return ffi.XorReduce32(data)
+	default:
+		return ffi.XorReduce16(data)
+	}
+}
+
+// IsSortedU8 reports whether data is in non-decreasing order, i.e.
+// data[i] <= data[i+1] for every i.  Each chunk is compared against itself
+// shifted by one lane, with the last byte of the previous chunk carried in,
+// and the scan stops at the first chunk containing an inversion.  Empty and
+// single-byte inputs are sorted.
+func IsSortedU8(data []byte) bool {
+	switch n := len(data); {
+	case n < 2:
+		return true
+	case n >= 64:
+		return ffi.IsSortedU8_64(data)
+	case n >= 32:
+		return ffi.IsSortedU8_32(data)
+	default:
+		return ffi.IsSortedU8_16(data)
+	}
+}
+
+// PopCount returns the number of set bits in data, the cardinality of a
+// byte-packed bitmap.  Each chunk is counted lane-wise (CNT on NEON) into
+// 32-bit lane counters that are drained into a 64-bit total, so the result
+// does not wrap however long data is.
+func PopCount(data []byte) uint64 {
+	switch n := len(data); {
+	case n >= 64:
+		return ffi.PopCount64(data)
+	case n >= 32:
+		return ffi.PopCount32(data)
+	default:
+		return ffi.PopCount16(data)
+	}
+}
+
+// PopCountAnd returns the number of bits set in both a and b, i.e. the sum of
+// bits.OnesCount8(a[i] & b[i]).  This is the intersection cardinality of two
+// byte-packed bitmaps.  a and b must have equal length; PopCountAnd panics
+// otherwise.
+func PopCountAnd(a, b []byte) uint64 {
+	switch n := len(a); {
+	case n >= 64:
+		return ffi.PopCountAnd64(a, b)
+	case n >= 32:
+		return ffi.PopCountAnd32(a, b)
+	default:
+		return ffi.PopCountAnd16(a, b)
+	}
+}
+
+// PopCountXor returns the number of bit positions where a and b differ, i.e.
+// the Hamming distance between the two buffers.  a and b must have equal
+// length; PopCountXor panics otherwise.
+func PopCountXor(a, b []byte) uint64 {
+	switch n := len(a); {
+	case n >= 64:
+		return ffi.PopCountXor64(a, b)
+	case n >= 32:
+		return ffi.PopCountXor32(a, b)
+	default:
+		return ffi.PopCountXor16(a, b)
+	}
+}
+
+// Histogram adds the frequency of every byte value in data to hist, so a
+// histogram can be accumulated over several buffers.  The kernel counts
+// straight into hist, which keeps its stack frame small enough to run on a
+// goroutine stack.
+func Histogram(data []byte, hist *[256]uint64) {
+	switch n := len(data); {
+	case n == 0:
+		return
+	case n >= 64:
+		ffi.HistogramU8_64(data, hist)
+	case n >= 32:
+		ffi.HistogramU8_32(data, hist)
+	default:
+		ffi.HistogramU8_16(data, hist)
+	}
+}
+
+// AddU8Saturating sets dst[i] = min(a[i]+b[i], 255) for the first
+// min(len(dst), len(a), len(b)) bytes, which it returns.  dst may alias a or
+// b exactly.
+func AddU8Saturating(dst, a, b []byte) int {
+	n := min(len(dst), len(a), len(b))
+	switch {
+	case n == 0:
+	case n >= 64:
+		ffi.AddU8Saturating64(dst[:n], a[:n], b[:n])
+	case n >= 32:
+		ffi.AddU8Saturating32(dst[:n], a[:n], b[:n])
+	default:
+		ffi.AddU8Saturating16(dst[:n], a[:n], b[:n])
+	}
+	return n
+}
+
+// AddU8Wrapping is AddU8Saturating with wrap-around: dst[i] = a[i] + b[i]
+// modulo 256.
+func AddU8Wrapping(dst, a, b []byte) int {
+	n := min(len(dst), len(a), len(b))
+	switch {
+	case n == 0:
+	case n >= 64:
+		ffi.AddU8Wrapping64(dst[:n], a[:n], b[:n])
+	case n >= 32:
+		ffi.AddU8Wrapping32(dst[:n], a[:n], b[:n])
+	default:
+		ffi.AddU8Wrapping16(dst[:n], a[:n], b[:n])
+	}
+	return n
+}
+
+// DotU8 returns the sum of a[i]*b[i] widened to uint64.  The kernel sums
+// products in 32-bit lanes and drains them before they can overflow.  a and b
+// must have equal length; DotU8 panics otherwise.
+func DotU8(a, b []byte) uint64 {
+	switch n := len(a); {
+	case n >= 64:
+		return ffi.DotU8_64(a, b)
+	case n >= 32:
+		return ffi.DotU8_32(a, b)
+	default:
+		return ffi.DotU8_16(a, b)
+	}
+}
+
+// DotF32 returns the sum of a[i]*b[i] with every product formed and summed in
+// float64.  a and b must have equal length; DotF32 panics otherwise.
+func DotF32(a, b []float32) float64 {
+	switch n := len(a); {
+	case n >= 64:
+		return ffi.DotF32_64(a, b)
+	case n >= 32:
+		return ffi.DotF32_32(a, b)
+	default:
+		return ffi.DotF32_16(a, b)
+	}
+}
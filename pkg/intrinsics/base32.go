@@ -0,0 +1,19 @@
+package intrinsics
+
+import "github.com/miretskiy/simba/internal/ffi"
+
+// Base32EncodeBlocks encodes the complete 5-byte groups of src with the
+// RFC 4648 standard alphabet into dst and returns the number of bytes
+// written, len(src)/5*8.  Trailing bytes and padding are left to the caller
+// (see codec.Base32Encode).  dst must hold len(src)/5*8 bytes.
+func Base32EncodeBlocks(dst, src []byte) int {
+	return ffi.Base32EncodeBlocks(dst, src)
+}
+
+// Base32DecodeBlocks decodes the complete 8-char groups of src into dst and
+// returns the number of src bytes consumed.  Decoding stops before the first
+// group containing a byte outside the alphabet ('=' included), leaving the
+// caller to locate the offending byte.  dst must hold len(src)/8*5 bytes.
+func Base32DecodeBlocks(dst, src []byte) int {
+	return ffi.Base32DecodeBlocks(dst, src)
+}
@@ -0,0 +1,20 @@
+package intrinsics
+
+import "github.com/miretskiy/simba/internal/ffi"
+
+// Base64EncodeBlocks encodes the complete 3-byte groups of src into dst and
+// returns the number of bytes written, len(src)/3*4.  url selects the
+// RFC 4648 URL-safe alphabet instead of the standard one.  Trailing bytes
+// and padding are left to the caller (see algo.Base64Encode).  dst must
+// hold len(src)/3*4 bytes.
+func Base64EncodeBlocks(dst, src []byte, url bool) int {
+	return ffi.Base64EncodeBlocks(dst, src, url)
+}
+
+// Base64DecodeBlocks decodes the complete 4-char groups of src into dst and
+// returns the number of src bytes consumed.  Decoding stops before the first
+// group containing a byte outside the alphabet ('=' included), leaving the
+// caller to locate the offending byte.  dst must hold len(src)/4*3 bytes.
+func Base64DecodeBlocks(dst, src []byte, url bool) int {
+	return ffi.Base64DecodeBlocks(dst, src, url)
+}
@@ -0,0 +1,53 @@
+package intrinsics
+
+import "github.com/miretskiy/simba/internal/ffi"
+
+// XorBytes sets dst[i] = a[i] ^ b[i] for i < n, where n is the length of the
+// shortest of the three slices, and returns n like copy.  dst may alias a or
+// b exactly (in-place XOR); partially overlapping slices give unspecified
+// results.
+func XorBytes(dst, a, b []byte) int {
+	n := min(len(dst), len(a), len(b))
+	switch {
+	case n == 0:
+	case n >= 64:
+		ffi.XorBytes64(dst[:n], a[:n], b[:n])
+	case n >= 32:
+		ffi.XorBytes32(dst[:n], a[:n], b[:n])
+	default:
+		ffi.XorBytes16(dst[:n], a[:n], b[:n])
+	}
+	return n
+}
+
+// AndBytes is XorBytes with &: dst[i] = a[i] & b[i] for the first
+// min(len(dst), len(a), len(b)) bytes, which it returns.
+func AndBytes(dst, a, b []byte) int {
+	n := min(len(dst), len(a), len(b))
+	switch {
+	case n == 0:
+	case n >= 64:
+		ffi.AndBytes64(dst[:n], a[:n], b[:n])
+	case n >= 32:
+		ffi.AndBytes32(dst[:n], a[:n], b[:n])
+	default:
+		ffi.AndBytes16(dst[:n], a[:n], b[:n])
+	}
+	return n
+}
+
+// OrBytes is XorBytes with |: dst[i] = a[i] | b[i] for the first
+// min(len(dst), len(a), len(b)) bytes, which it returns.
+func OrBytes(dst, a, b []byte) int {
+	n := min(len(dst), len(a), len(b))
+	switch {
+	case n == 0:
+	case n >= 64:
+		ffi.OrBytes64(dst[:n], a[:n], b[:n])
+	case n >= 32:
+		ffi.OrBytes32(dst[:n], a[:n], b[:n])
+	default:
+		ffi.OrBytes16(dst[:n], a[:n], b[:n])
+	}
+	return n
+}
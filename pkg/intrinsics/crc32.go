@@ -5,6 +5,11 @@ import "github.com/miretskiy/simba/internal/ffi"
 // Crc32Update updates CRC32 checksum with additional data using SIMD kernels.
 // The function never falls back to scalar – call the algo layer if you want
 // automatic fallback for short buffers.
+//
+// Buffers of 64 bytes or more go to the 64-lane entry point whole; there is
+// no point splitting off the non-aligned remainder for the 32-lane one, since
+// both entry points wrap the same hardware CRC32C routine and a split would
+// only add a second FFI call (see BenchmarkCrc32Split).
 func Crc32Update(data []byte, init uint32) uint32 {
 	switch n := len(data); {
 	case n == 0:
@@ -15,3 +20,19 @@ func Crc32Update(data []byte, init uint32) uint32 {
 		return ffi.Crc32Update32(data, init)
 	}
 }
+
+// Crc32IEEEUpdate is Crc32Update for the IEEE polynomial (CRC-32 as used by
+// zlib, gzip and PNG).  The kernel selects a carry-less-multiply or table
+// implementation at runtime; like Crc32Update it never falls back to Go.
+func Crc32IEEEUpdate(data []byte, init uint32) uint32 {
+	return ffi.Crc32IEEEUpdate(data, init)
+}
+
+// CrcAndSum returns the CRC32C of data continued from crcInit together with
+// the byte sum of data (not wrapped at 2^32), in a single pass over memory.
+// The kernel walks data in L1-sized blocks, feeding each block to the CRC
+// routine and then summing it while it is still cached, which saves the
+// second trip to memory that separate Crc32Update and SumU8 calls would make.
+func CrcAndSum(data []byte, crcInit uint32) (crc uint32, sum uint64) {
+	return ffi.Crc32AndSum(data, crcInit)
+}
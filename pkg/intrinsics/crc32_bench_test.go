@@ -153,3 +153,54 @@ func BenchmarkSecureCRC32(b *testing.B) {
 		})
 	}
 }
+
+// BenchmarkCrc32Split checks whether running the 64-lane entry point over the
+// 64-byte-aligned prefix and the 32-lane one over the remainder beats handing
+// the whole buffer to a single call, on sizes that are not multiples of 64.
+func BenchmarkCrc32Split(b *testing.B) {
+	data := make([]byte, 500)
+	_, _ = rand.Read(data)
+
+	for _, sz := range []int{100, 200, 500} {
+		buf := data[:sz]
+		full := sz &^ 63
+
+		b.Run(fmt.Sprintf("impl=single/%dB", sz), func(sb *testing.B) {
+			sb.SetBytes(int64(sz))
+			for i := 0; i < sb.N; i++ {
+				crc32Sink = Crc32Update(buf, 0)
+			}
+		})
+
+		b.Run(fmt.Sprintf("impl=split/%dB", sz), func(sb *testing.B) {
+			sb.SetBytes(int64(sz))
+			for i := 0; i < sb.N; i++ {
+				crc32Sink = ffi.Crc32Update32(buf[full:], ffi.Crc32Update64(buf[:full], 0))
+			}
+		})
+	}
+}
+
+var sumSink uint64
+
+// BenchmarkCrcAndSum compares the fused CRC+sum kernel with two separate
+// passes (Crc32Update followed by SumU8) over a 64 KiB buffer.
+func BenchmarkCrcAndSum(b *testing.B) {
+	data := make([]byte, 64<<10)
+	_, _ = rand.Read(data)
+
+	b.Run("impl=sequential", func(sb *testing.B) {
+		sb.SetBytes(int64(len(data)))
+		for i := 0; i < sb.N; i++ {
+			crc32Sink = Crc32Update(data, 0)
+			sumSink = uint64(SumU8(data))
+		}
+	})
+
+	b.Run("impl=fused", func(sb *testing.B) {
+		sb.SetBytes(int64(len(data)))
+		for i := 0; i < sb.N; i++ {
+			crc32Sink, sumSink = CrcAndSum(data, 0)
+		}
+	})
+}
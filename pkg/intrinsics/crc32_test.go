@@ -0,0 +1,38 @@
+package intrinsics
+
+import (
+	"hash/crc32"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCrc32UpdateUnaligned(t *testing.T) {
+	tbl := crc32.MakeTable(crc32.Castagnoli)
+	data := make([]byte, 1100)
+	for i := range data {
+		data[i] = byte(i*13 + i>>7)
+	}
+	for _, n := range []int{1, 31, 32, 63, 64, 65, 100, 127, 128, 129, 200, 500, 1100} {
+		buf := data[:n]
+		require.Equal(t, crc32.Checksum(buf, tbl), Crc32Update(buf, 0), "n=%d", n)
+		require.Equal(t, crc32.Update(0xdeadbeef, tbl, buf), Crc32Update(buf, 0xdeadbeef), "n=%d seeded", n)
+	}
+}
+
+func TestCrcAndSum(t *testing.T) {
+	data := make([]byte, 3*4096+17)
+	for i := range data {
+		data[i] = byte(i*131 + i>>9)
+	}
+	for _, n := range []int{0, 1, 63, 64, 4095, 4096, 4097, len(data)} {
+		buf := data[:n]
+		var want uint64
+		for _, b := range buf {
+			want += uint64(b)
+		}
+		crc, sum := CrcAndSum(buf, 0x1234)
+		require.Equal(t, Crc32Update(buf, 0x1234), crc, "crc n=%d", n)
+		require.Equal(t, want, sum, "sum n=%d", n)
+	}
+}
@@ -26,5 +26,7 @@
 //
 // In short, sticking to a single, portable implementation keeps the API small
 // and yields robust performance across CPUs while leaving room to add wider
-// variants later if evidence demands it.
+// variants later if evidence demands it.  The one exception is LaneWidth and
+// the *Lane functions (SumU8Lane, IsASCIILane, CountByteLane), which pin the
+// 16-, 32- or 64-lane kernel explicitly for benchmarks and fixed-size buffers.
 package intrinsics
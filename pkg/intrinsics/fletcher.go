@@ -0,0 +1,37 @@
+package intrinsics
+
+import "github.com/miretskiy/simba/internal/ffi"
+
+// Fletcher16 returns the Fletcher-16 checksum of data: two running byte sums
+// modulo 255 packed as sum2<<8 | sum1.  The kernel keeps both sums in vector
+// lanes and reduces them modulo 255 once per block, before any lane can
+// overflow.
+func Fletcher16(data []byte) uint16 {
+	switch n := len(data); {
+	case n == 0:
+		return 0
+	case n >= 64:
+		return ffi.Fletcher16_64(data)
+	case n >= 32:
+		return ffi.Fletcher16_32(data)
+	default:
+		return ffi.Fletcher16_16(data)
+	}
+}
+
+// Fletcher32 returns the Fletcher-32 checksum of data: two running sums of
+// 16-bit words modulo 65535 packed as sum2<<16 | sum1.  Words are read
+// little-endian regardless of the host byte order, and an odd trailing byte
+// is treated as a word with a zero high byte.
+func Fletcher32(data []byte) uint32 {
+	switch n := len(data); {
+	case n == 0:
+		return 0
+	case n >= 128:
+		return ffi.Fletcher32_64(data)
+	case n >= 64:
+		return ffi.Fletcher32_32(data)
+	default:
+		return ffi.Fletcher32_16(data)
+	}
+}
@@ -0,0 +1,18 @@
+package intrinsics
+
+import "github.com/miretskiy/simba/internal/ffi"
+
+// HexEncode writes the lower-case hex encoding of src into dst and returns
+// the number of bytes written, 2*len(src).  dst must hold 2*len(src) bytes.
+func HexEncode(dst, src []byte) int {
+	return ffi.HexEncode(dst, src)
+}
+
+// HexDecodePairs decodes the complete digit pairs of src (either case) into
+// dst and returns the number of src bytes consumed.  Decoding stops before
+// the first pair holding a byte that is not a hex digit, leaving the caller
+// to locate the offending byte; a trailing odd byte is never consumed.  dst
+// must hold len(src)/2 bytes.
+func HexDecodePairs(dst, src []byte) int {
+	return ffi.HexDecode(dst, src)
+}
@@ -0,0 +1,195 @@
+package intrinsics
+
+import (
+	"math/bits"
+
+	"github.com/miretskiy/simba/internal/ffi"
+)
+
+// IndexByteLess returns the offset of the first byte in data that is strictly
+// less than threshold, or -1 if there is none.  With threshold 0x80 it finds
+// the last byte of a LEB128 varint (the first byte without the continuation
+// bit).  intrinsics always use SIMD; scalar fallback lives in the algo layer.
+func IndexByteLess(data []byte, threshold byte) int {
+	switch n := len(data); {
+	case n == 0:
+		return -1
+	case n >= 64:
+		return ffi.IndexByteLess64(data, threshold)
+	case n >= 32:
+		return ffi.IndexByteLess32(data, threshold)
+	default:
+		return ffi.IndexByteLess16(data, threshold)
+	}
+}
+
+// IndexNotByte returns the offset of the first byte in data that differs from
+// val, or -1 if every byte equals val.
+func IndexNotByte(data []byte, val byte) int {
+	switch n := len(data); {
+	case n == 0:
+		return -1
+	case n >= 64:
+		return ffi.IndexNotByte64(data, val)
+	case n >= 32:
+		return ffi.IndexNotByte32(data, val)
+	default:
+		return ffi.IndexNotByte16(data, val)
+	}
+}
+
+// IndexBytePair returns the first offset i with data[i] == b0 and
+// data[i+1] == b1, or -1 if the pair does not occur, e.g. the position of a
+// CRLF.  Each chunk is compared with b0 and the window one byte further on
+// with b1; the two masks are ANDed, so a pair straddling two chunks is found
+// by the chunk holding its first byte.
+func IndexBytePair(data []byte, b0, b1 byte) int {
+	switch n := len(data); {
+	case n < 2:
+		return -1
+	case n >= 64:
+		return ffi.IndexBytePair64(data, b0, b1)
+	case n >= 32:
+		return ffi.IndexBytePair32(data, b0, b1)
+	default:
+		return ffi.IndexBytePair16(data, b0, b1)
+	}
+}
+
+// IndexDiff returns the offset of the first i < min(len(a), len(b)) with
+// a[i] != b[i], or -1 if the common prefix is equal.  Each chunk of both
+// inputs is compared lane-wise and the first mismatch is located with a
+// trailing-zero count on the inequality mask.
+func IndexDiff(a, b []byte) int {
+	n := min(len(a), len(b))
+	a, b = a[:n], b[:n]
+	switch {
+	case n == 0:
+		return -1
+	case n >= 64:
+		return ffi.IndexDiff64(a, b)
+	case n >= 32:
+		return ffi.IndexDiff32(a, b)
+	default:
+		return ffi.IndexDiff16(a, b)
+	}
+}
+
+// IndexDiffFold is IndexDiff with ASCII case folded: 'A'..'Z' compare equal
+// to 'a'..'z', while every other byte, including those >= 0x80, must match
+// exactly.  Both chunks are lowered in registers before the lane-wise
+// compare, so neither input is copied.
+func IndexDiffFold(a, b []byte) int {
+	n := min(len(a), len(b))
+	a, b = a[:n], b[:n]
+	switch {
+	case n == 0:
+		return -1
+	case n >= 64:
+		return ffi.IndexDiffFold64(a, b)
+	case n >= 32:
+		return ffi.IndexDiffFold32(a, b)
+	default:
+		return ffi.IndexDiffFold16(a, b)
+	}
+}
+
+// CountLeadingByte returns the length of the run of val at the start of data,
+// e.g. the indentation width of a line when val is ' '.  It returns len(data)
+// if every byte equals val and 0 if the first byte differs.
+func CountLeadingByte(data []byte, val byte) int {
+	if i := IndexNotByte(data, val); i >= 0 {
+		return i
+	}
+	return len(data)
+}
+
+// IndexByte hands the mask kernel indexFirstBlock bytes first and doubles
+// the block after each miss up to indexBlock, so an early match costs a small
+// scan while long searches still amortise the call over 4 KiB.
+const (
+	indexFirstBlock = 256
+	indexBlock      = 4096
+)
+
+// IndexByte returns the offset of the first needle in data, or -1 if there is
+// none.  Whole chunks are classified with the equality-mask kernels and the
+// first match is located with a trailing-zero count on the first non-zero
+// mask word.  Blocks start at 256 bytes and double up to 4 KiB, so a match
+// near the start is found without classifying a whole 4 KiB block.  A
+// partial last chunk is covered by re-running the kernel on
+// the final full-width window and discarding the bits that overlap bytes
+// already scanned; inputs shorter than 16 bytes are padded to one chunk.
+func IndexByte(data []byte, needle byte) int {
+	n := len(data)
+	switch {
+	case n == 0:
+		return -1
+	case n < 16:
+		pad := [16]byte{}
+		for i := range pad {
+			pad[i] = ^needle
+		}
+		copy(pad[:], data)
+		var m [1]uint16
+		ffi.EqU8Masks16(pad[:], needle, m[:])
+		if m[0] == 0 {
+			return -1
+		}
+		return bits.TrailingZeros16(m[0])
+	case n < 64:
+		var masks [4]uint16
+		full := n &^ 15
+		ffi.EqU8Masks16(data[:full], needle, masks[:])
+		for w, m := range masks[:full/16] {
+			if m != 0 {
+				return w*16 + bits.TrailingZeros16(m)
+			}
+		}
+		if full == n {
+			return -1
+		}
+		ffi.EqU8Masks16(data[n-16:], needle, masks[:1])
+		if m := masks[0] >> (full + 16 - n); m != 0 {
+			return full + bits.TrailingZeros16(m)
+		}
+		return -1
+	}
+
+	var masks [indexBlock / 64]uint64
+	full := n &^ 63
+	for base, size := 0, indexFirstBlock; base < full; size = min(2*size, indexBlock) {
+		block := data[base:min(base+size, full)]
+		ffi.EqU8Masks64(block, needle, masks[:])
+		for w, m := range masks[:len(block)/64] {
+			if m != 0 {
+				return base + w*64 + bits.TrailingZeros64(m)
+			}
+		}
+		base += len(block)
+	}
+	if full == n {
+		return -1
+	}
+	ffi.EqU8Masks64(data[n-64:], needle, masks[:1])
+	if m := masks[0] >> (full + 64 - n); m != 0 {
+		return full + bits.TrailingZeros64(m)
+	}
+	return -1
+}
+
+// CountByte returns the number of bytes in data equal to needle.  The kernel
+// sums the equality comparisons in vector lanes and returns the total, so no
+// mask words are materialised or popcounted on the Go side.
+func CountByte(data []byte, needle byte) int {
+	switch n := len(data); {
+	case n == 0:
+		return 0
+	case n >= 64:
+		return ffi.CountByte64(data, needle)
+	case n >= 32:
+		return ffi.CountByte32(data, needle)
+	default:
+		return ffi.CountByte16(data, needle)
+	}
+}
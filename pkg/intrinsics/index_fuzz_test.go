@@ -0,0 +1,34 @@
+package intrinsics
+
+import (
+	"bytes"
+	"testing"
+)
+
+func FuzzIndexBytePair(f *testing.F) {
+	// Seed pairs that straddle the 16-, 32- and 64-byte chunk boundaries,
+	// plus a lone first byte at the very end.
+	for _, n := range []int{0, 1, 2, 15, 16, 17, 31, 32, 33, 63, 64, 65, 127, 128, 129, 200} {
+		data := bytes.Repeat([]byte("."), n)
+		f.Add(data, byte('\r'), byte('\n'))
+		for _, pos := range []int{14, 15, 16, 31, 32, 63, 64, 127, n - 2, n - 1} {
+			if pos < 0 || pos >= n {
+				continue
+			}
+			edge := append([]byte(nil), data...)
+			edge[pos] = '\r'
+			if pos+1 < n {
+				edge[pos+1] = '\n'
+			}
+			f.Add(edge, byte('\r'), byte('\n'))
+		}
+	}
+	f.Add([]byte("aaaa"), byte('a'), byte('a'))
+
+	f.Fuzz(func(t *testing.T, data []byte, b0, b1 byte) {
+		want := bytes.Index(data, []byte{b0, b1})
+		if got := IndexBytePair(data, b0, b1); got != want {
+			t.Fatalf("IndexBytePair(len=%d, %#x, %#x) = %d, want %d", len(data), b0, b1, got, want)
+		}
+	})
+}
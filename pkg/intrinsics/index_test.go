@@ -0,0 +1,160 @@
+package intrinsics
+
+import (
+	"bytes"
+	"fmt"
+	"math/bits"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func scalarIndexByteLess(data []byte, threshold byte) int {
+	for i, b := range data {
+		if b < threshold {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestIndexByteLess(t *testing.T) {
+	require.Equal(t, -1, IndexByteLess(nil, 0x80))
+	require.Equal(t, -1, IndexByteLess([]byte{0, 1, 2}, 0), "threshold 0 matches nothing")
+
+	for _, n := range []int{1, 15, 16, 17, 31, 32, 33, 63, 64, 65, 100, 129} {
+		data := make([]byte, n)
+		for i := range data {
+			data[i] = 0x80 | byte(i)
+		}
+		require.Equal(t, -1, IndexByteLess(data, 0x80), "len=%d no terminator", n)
+
+		// Place the terminator at every position, including the tail that
+		// follows the last full lane.
+		for pos := 0; pos < n; pos++ {
+			buf := append([]byte(nil), data...)
+			buf[pos] = 0x05
+			want := scalarIndexByteLess(buf, 0x80)
+			require.Equal(t, want, IndexByteLess(buf, 0x80), "len=%d pos=%d", n, pos)
+		}
+	}
+}
+
+func scalarCountLeadingByte(data []byte, val byte) int {
+	n := 0
+	for n < len(data) && data[n] == val {
+		n++
+	}
+	return n
+}
+
+func TestCountLeadingByte(t *testing.T) {
+	require.Equal(t, 0, CountLeadingByte(nil, ' '))
+	require.Equal(t, 0, CountLeadingByte([]byte("x   "), ' '), "immediate mismatch")
+
+	for _, n := range []int{1, 15, 16, 17, 31, 32, 33, 63, 64, 65, 100, 129} {
+		spaces := bytes.Repeat([]byte{' '}, n)
+		require.Equal(t, n, CountLeadingByte(spaces, ' '), "all equal len=%d", n)
+		require.Equal(t, -1, IndexNotByte(spaces, ' '), "all equal len=%d", n)
+
+		for pos := 0; pos < n; pos++ {
+			line := append([]byte(nil), spaces...)
+			line[pos] = '-'
+			want := scalarCountLeadingByte(line, ' ')
+			require.Equal(t, want, CountLeadingByte(line, ' '), "len=%d pos=%d", n, pos)
+			require.Equal(t, want, IndexNotByte(line, ' '), "len=%d pos=%d", n, pos)
+		}
+	}
+}
+
+func TestIndexByte(t *testing.T) {
+	require.Equal(t, -1, IndexByte(nil, 'x'))
+
+	for _, n := range []int{1, 2, 15, 16, 17, 31, 32, 33, 47, 48, 63, 64, 65, 100, 127, 128, 129, 255, 256, 257, 767, 768, 769, 1792, 3840, 4095, 4096, 4097, 4160, 4200, 7936, 8000} {
+		data := bytes.Repeat([]byte{'.'}, n)
+		require.Equal(t, -1, IndexByte(data, 'x'), "len=%d no match", n)
+
+		// A single match at every position, including the overlap window at
+		// the tail, plus a second match after it that must not win.
+		for at := 0; at < n; at++ {
+			data[at] = 'x'
+			if at+1 < n {
+				data[n-1] = 'x'
+			}
+			require.Equal(t, at, IndexByte(data, 'x'), "len=%d at=%d", n, at)
+			data[at], data[n-1] = '.', '.'
+		}
+	}
+
+	// A needle byte of 0xFF must not match the padding of short inputs.
+	require.Equal(t, -1, IndexByte([]byte{0, 1, 2}, 0xFF))
+	require.Equal(t, -1, IndexByte([]byte{0xFE}, 0x01))
+}
+
+// countByteMasks is the mask-based CountByte: popcount EqU8Masks64 words and
+// count the len%64 tail in Go.  It is kept as the benchmark baseline for the
+// dedicated count kernel.
+func countByteMasks(data []byte, needle byte) int {
+	var masks [64]uint64
+	count := 0
+	full := len(data) &^ 63
+	for base := 0; base < full; base += len(masks) * 64 {
+		block := data[base:min(base+len(masks)*64, full)]
+		EqU8Masks64(block, needle, masks[:])
+		for _, m := range masks[:len(block)/64] {
+			count += bits.OnesCount64(m)
+		}
+	}
+	return count + bytes.Count(data[full:], []byte{needle})
+}
+
+func TestCountByte(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	for _, n := range []int{0, 1, 15, 16, 17, 31, 32, 33, 63, 64, 65, 255 * 64, 255*64 + 65, 100000} {
+		data := make([]byte, n)
+		for i := range data {
+			data[i] = byte(r.Intn(4))
+		}
+		want := bytes.Count(data, []byte{2})
+		require.Equal(t, want, CountByte(data, 2), "len=%d", n)
+		require.Equal(t, want, countByteMasks(data, 2), "len=%d masks", n)
+	}
+
+	// Every byte matching drives each lane counter past 255 chunks.
+	same := bytes.Repeat([]byte{7}, 70000)
+	require.Equal(t, len(same), CountByte(same, 7))
+}
+
+var countSink int
+
+func BenchmarkIndexByteEarlyHit(b *testing.B) {
+	data := bytes.Repeat([]byte{'.'}, 1<<20)
+	for _, pos := range []int{0, 100, 1000, 10000} {
+		buf := append([]byte(nil), data...)
+		buf[pos] = '\n'
+		b.Run(fmt.Sprintf("pos=%d", pos), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				countSink = IndexByte(buf, '\n')
+			}
+		})
+	}
+}
+
+func BenchmarkCountByte(b *testing.B) {
+	for _, n := range []int{1024, 65536, 1 << 20} {
+		data := bytes.Repeat([]byte("0123456789abcde\n"), n/16)
+		b.Run(fmt.Sprintf("Masks_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				countSink = countByteMasks(data, '\n')
+			}
+		})
+		b.Run(fmt.Sprintf("Kernel_%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				countSink = CountByte(data, '\n')
+			}
+		})
+	}
+}
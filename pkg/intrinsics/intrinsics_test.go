@@ -3,7 +3,10 @@ package intrinsics
 import (
 	"bytes"
 	"fmt"
+	"math"
+	"math/bits"
 	"math/rand"
+	"sort"
 	"testing"
 	"time"
 
@@ -68,3 +71,181 @@ func BenchmarkSumU8(b *testing.B) {
 
 	}
 }
+
+// scalarMinMaxF32 folds with math.Min/math.Max, which define the NaN and
+// signed-zero semantics MinF32/MaxF32 promise.
+func scalarMinMaxF32(data []float32) (lo, hi float32) {
+	l, h := math.Inf(1), math.Inf(-1)
+	for _, x := range data {
+		l = math.Min(l, float64(x))
+		h = math.Max(h, float64(x))
+	}
+	return float32(l), float32(h)
+}
+
+func TestMinMaxF32(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	nan := float32(math.NaN())
+	inf := float32(math.Inf(1))
+
+	sameF32 := func(want, got float32, what string, data []float32) {
+		t.Helper()
+		if math.IsNaN(float64(want)) {
+			require.True(t, math.IsNaN(float64(got)), "%s %v", what, data)
+			return
+		}
+		require.Equal(t, math.Float32bits(want), math.Float32bits(got), "%s %v", what, data)
+	}
+
+	for _, n := range []int{0, 1, 7, 16, 31, 32, 33, 64, 65, 200} {
+		data := make([]float32, n)
+		for i := range data {
+			data[i] = r.Float32()*2000 - 1000
+		}
+		variants := [][]float32{data}
+		for _, special := range []float32{nan, inf, -inf, 0, float32(math.Copysign(0, -1))} {
+			for _, pos := range []int{0, n / 2, n - 1} {
+				if n == 0 {
+					continue
+				}
+				v := append([]float32(nil), data...)
+				v[pos] = special
+				variants = append(variants, v)
+			}
+		}
+		for _, v := range variants {
+			lo, hi := scalarMinMaxF32(v)
+			sameF32(lo, MinF32(v), "MinF32", v)
+			sameF32(hi, MaxF32(v), "MaxF32", v)
+		}
+	}
+
+	negZero := float32(math.Copysign(0, -1))
+	sameF32(0, MaxF32([]float32{negZero, 0}), "max(-0,+0)", nil)
+	sameF32(negZero, MinF32([]float32{0, negZero}), "min(+0,-0)", nil)
+}
+
+func TestXorReduce(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for _, n := range []int{0, 1, 15, 16, 31, 32, 63, 64, 65, 4097} {
+		data := make([]byte, n)
+		for i := range data {
+			data[i] = byte(r.Intn(256))
+		}
+		var want byte
+		for _, b := range data {
+			want ^= b
+		}
+		require.Equal(t, want, XorReduce(data), "n=%d", n)
+	}
+}
+
+func TestIsSortedU8(t *testing.T) {
+	isSorted := func(data []byte) bool {
+		return sort.SliceIsSorted(data, func(i, j int) bool { return data[i] < data[j] })
+	}
+
+	r := rand.New(rand.NewSource(3))
+	for _, n := range []int{0, 1, 2, 15, 16, 17, 31, 32, 33, 63, 64, 65, 128, 1000} {
+		random := make([]byte, n)
+		r.Read(random)
+		require.Equal(t, isSorted(random), IsSortedU8(random), "random n=%d", n)
+
+		sorted := append([]byte(nil), random...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		require.True(t, IsSortedU8(sorted), "sorted n=%d", n)
+	}
+
+	// An inversion straddling each chunk boundary: data[edge-1] > data[edge].
+	for _, edge := range []int{1, 15, 16, 17, 31, 32, 33, 63, 64, 65, 127, 128} {
+		data := make([]byte, 200)
+		for i := range data {
+			data[i] = byte(i)
+		}
+		data[edge] = data[edge-1] - 1
+		require.False(t, IsSortedU8(data), "inversion at %d", edge)
+		require.False(t, isSorted(data), "inversion at %d", edge)
+	}
+}
+
+// scalarPopCount folds bits.OnesCount8 over op(a[i], b[i]).
+func scalarPopCount(a, b []byte, op func(x, y byte) byte) uint64 {
+	var n uint64
+	for i := range a {
+		n += uint64(bits.OnesCount8(op(a[i], b[i])))
+	}
+	return n
+}
+
+func andOp(x, y byte) byte { return x & y }
+func xorOp(x, y byte) byte { return x ^ y }
+
+func TestPopCountAndXor(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	for _, n := range []int{0, 1, 15, 16, 17, 31, 32, 33, 63, 64, 65, 1000, 8192} {
+		a := make([]byte, n)
+		b := make([]byte, n)
+		r.Read(a)
+		r.Read(b)
+		require.Equal(t, scalarPopCount(a, b, andOp), PopCountAnd(a, b), "and n=%d", n)
+		require.Equal(t, scalarPopCount(a, b, xorOp), PopCountXor(a, b), "xor n=%d", n)
+	}
+
+	ones := bytes.Repeat([]byte{0xFF}, 100)
+	require.Equal(t, uint64(800), PopCountAnd(ones, ones))
+	require.Zero(t, PopCountXor(ones, ones))
+
+	require.Panics(t, func() { PopCountAnd(ones, ones[:99]) })
+	require.Panics(t, func() { PopCountXor(ones[:1], nil) })
+}
+
+func TestPopCount(t *testing.T) {
+	r := rand.New(rand.NewSource(6))
+	for _, n := range []int{0, 1, 15, 16, 17, 31, 32, 33, 63, 64, 65, 1000, 8192} {
+		data := make([]byte, n)
+		r.Read(data)
+		require.Equal(t, scalarPopCount(data, data, andOp), PopCount(data), "n=%d", n)
+	}
+
+	// 2^23 set bits, far more than a u8 or u16 lane counter could hold.
+	ones := bytes.Repeat([]byte{0xFF}, 1<<20)
+	require.Equal(t, uint64(8<<20), PopCount(ones))
+}
+
+func BenchmarkPopCountAnd(b *testing.B) {
+	r := rand.New(rand.NewSource(42))
+	x := make([]byte, 8192)
+	y := make([]byte, 8192)
+	r.Read(x)
+	r.Read(y)
+	b.SetBytes(int64(len(x)))
+
+	b.Run("Scalar", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sumSink = scalarPopCount(x, y, andOp)
+		}
+	})
+	b.Run("SIMD_And", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sumSink = PopCountAnd(x, y)
+		}
+	})
+	b.Run("SIMD_Xor", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sumSink = PopCountXor(x, y)
+		}
+	})
+}
+
+func TestPartialOverlap(t *testing.T) {
+	buf := make([]byte, 100)
+	require.False(t, PartialOverlap(buf, buf), "identical")
+	require.False(t, PartialOverlap(buf[:10], buf[:50]), "same start")
+	require.True(t, PartialOverlap(buf[1:], buf), "shifted by one")
+	require.True(t, PartialOverlap(buf[:50], buf[49:]), "one shared byte")
+	require.False(t, PartialOverlap(buf[:50], buf[50:]), "adjacent")
+	require.False(t, PartialOverlap(buf[:0], buf), "empty")
+	require.False(t, PartialOverlap(buf, make([]byte, 100)), "disjoint")
+
+	require.Panics(t, func() { MapBytes(buf[1:], buf[:99], new([256]byte)) })
+}
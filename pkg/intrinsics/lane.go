@@ -0,0 +1,81 @@
+package intrinsics
+
+import (
+	"strconv"
+
+	"github.com/miretskiy/simba/internal/ffi"
+)
+
+// LaneWidth is the logical SIMD lane count of a kernel.  The plain dispatch
+// functions (SumU8, IsASCII, ...) pick it from the input length; the *Lane
+// variants take it explicitly, for benchmarking a specific kernel or for
+// buffers whose size is known ahead of time.
+type LaneWidth int
+
+const (
+	Lane16 LaneWidth = 16
+	Lane32 LaneWidth = 32
+	Lane64 LaneWidth = 64
+)
+
+// LaneWidths lists the supported lane widths in ascending order.
+var LaneWidths = []LaneWidth{Lane16, Lane32, Lane64}
+
+// Valid reports whether w is one of Lane16, Lane32 or Lane64.
+func (w LaneWidth) Valid() bool {
+	return w == Lane16 || w == Lane32 || w == Lane64
+}
+
+// String returns the lane count, e.g. "64".
+func (w LaneWidth) String() string {
+	return strconv.Itoa(int(w))
+}
+
+func badLaneWidth(w LaneWidth) string {
+	return "intrinsics: unsupported LaneWidth " + w.String()
+}
+
+// SumU8Lane is SumU8 using the kernel with lane width w.  It panics if w is
+// not valid.
+func SumU8Lane(data []byte, w LaneWidth) uint32 {
+	switch w {
+	case Lane16:
+		return ffi.SumU8_16(data)
+	case Lane32:
+		return ffi.SumU8_32(data)
+	case Lane64:
+		return ffi.SumU8_64(data)
+	default:
+		panic(badLaneWidth(w))
+	}
+}
+
+// IsASCIILane is IsASCII using the kernel with lane width w.  It panics if w
+// is not valid.
+func IsASCIILane(data []byte, w LaneWidth) bool {
+	switch w {
+	case Lane16:
+		return ffi.IsASCII16(data)
+	case Lane32:
+		return ffi.IsASCII32(data)
+	case Lane64:
+		return ffi.IsASCII64(data)
+	default:
+		panic(badLaneWidth(w))
+	}
+}
+
+// CountByteLane is CountByte using the kernel with lane width w.  It panics
+// if w is not valid.
+func CountByteLane(data []byte, needle byte, w LaneWidth) int {
+	switch w {
+	case Lane16:
+		return ffi.CountByte16(data, needle)
+	case Lane32:
+		return ffi.CountByte32(data, needle)
+	case Lane64:
+		return ffi.CountByte64(data, needle)
+	default:
+		panic(badLaneWidth(w))
+	}
+}
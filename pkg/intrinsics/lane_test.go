@@ -0,0 +1,40 @@
+package intrinsics
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLaneVariantsAgree(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 15, 16, 17, 31, 32, 63, 64, 65, 127, 200, 1000, 4099} {
+		data := make([]byte, n)
+		for i := range data {
+			data[i] = byte(r.Intn(128)) // ASCII, so IsASCII is not trivially false
+		}
+		if n > 0 && n%2 == 1 {
+			data[r.Intn(n)] = 0x80
+		}
+		for _, w := range LaneWidths {
+			require.Equal(t, SumU8(data), SumU8Lane(data, w), "n=%d w=%s", n, w)
+			require.Equal(t, IsASCII(data), IsASCIILane(data, w), "n=%d w=%s", n, w)
+			require.Equal(t, CountByte(data, 'a'), CountByteLane(data, 'a', w), "n=%d w=%s", n, w)
+		}
+	}
+}
+
+func TestLaneWidthInvalid(t *testing.T) {
+	for _, w := range []LaneWidth{0, 8, 48, 128, -16} {
+		require.False(t, w.Valid(), w.String())
+		require.PanicsWithValue(t, "intrinsics: unsupported LaneWidth "+w.String(), func() {
+			SumU8Lane([]byte("x"), w)
+		})
+		require.Panics(t, func() { IsASCIILane(nil, w) })
+		require.Panics(t, func() { CountByteLane(nil, 0, w) })
+	}
+	for _, w := range LaneWidths {
+		require.True(t, w.Valid())
+	}
+}
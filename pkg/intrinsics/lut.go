@@ -16,3 +16,91 @@ func AllBytesInSet(data []byte, lut *[256]byte) bool {
 		return ffi.AllBytesInSet16(data, lut)
 	}
 }
+
+// AllBytesInRange reports whether every byte in data lies in the inclusive
+// range [lo, hi].  The kernel needs only two compares per chunk, so it avoids
+// the table gather of AllBytesInSet for contiguous ranges.  An empty range
+// (lo > hi) admits no bytes; empty data is always in range.  intrinsics
+// always use SIMD; scalar fallback lives in the algo layer.
+func AllBytesInRange(data []byte, lo, hi byte) bool {
+	switch n := len(data); {
+	case n == 0:
+		return true
+	case n >= 64:
+		return ffi.AllBytesInRange64(data, lo, hi)
+	case n >= 32:
+		return ffi.AllBytesInRange32(data, lo, hi)
+	default:
+		return ffi.AllBytesInRange16(data, lo, hi)
+	}
+}
+
+// ContainsAnyByte reports whether any byte in data has a non-zero LUT entry.
+// The kernel ORs the gathered entries of several chunks before testing them
+// and returns at the first hit.  intrinsics always use SIMD; scalar fallback
+// lives in the algo layer.
+func ContainsAnyByte(data []byte, lut *[256]byte) bool {
+	switch n := len(data); {
+	case n == 0:
+		return false
+	case n >= 64:
+		return ffi.ContainsAnyByte64(data, lut)
+	case n >= 32:
+		return ffi.ContainsAnyByte32(data, lut)
+	default:
+		return ffi.ContainsAnyByte16(data, lut)
+	}
+}
+
+// IndexAnyByte returns the offset of the first byte in data whose LUT entry is
+// non-zero, or -1 if there is none.  The kernel gathers the entries of each
+// chunk and returns at the first chunk with a hit; the bytes past the last
+// whole chunk are checked in the kernel too, so the offset is exact.
+// intrinsics always use SIMD; scalar fallback lives in the algo layer.
+func IndexAnyByte(data []byte, lut *[256]byte) int {
+	switch n := len(data); {
+	case n == 0:
+		return -1
+	case n >= 64:
+		return ffi.IndexAnyByte64(data, lut)
+	case n >= 32:
+		return ffi.IndexAnyByte32(data, lut)
+	default:
+		return ffi.IndexAnyByte16(data, lut)
+	}
+}
+
+// LastIndexAnyByte returns the offset of the last byte in data whose LUT entry
+// is non-zero, or -1 if there is none.  Chunks are scanned from the end so the
+// search stops at the first hit.  intrinsics always use SIMD; scalar fallback
+// lives in the algo layer.
+func LastIndexAnyByte(data []byte, lut *[256]byte) int {
+	switch n := len(data); {
+	case n == 0:
+		return -1
+	case n >= 64:
+		return ffi.LastIndexAnyByte64(data, lut)
+	case n >= 32:
+		return ffi.LastIndexAnyByte32(data, lut)
+	default:
+		return ffi.LastIndexAnyByte16(data, lut)
+	}
+}
+
+// CountClassTransitions counts the positions i > 0 where
+// class[data[i]] != class[data[i-1]], i.e. how often the byte class changes.
+// With a letter/non-letter table this is twice the number of interior word
+// boundaries, a cheap word-count estimate.  The kernel carries the last class
+// of each chunk into the next, so boundaries straddling chunks are counted.
+func CountClassTransitions(data []byte, class *[256]byte) int {
+	switch n := len(data); {
+	case n < 2:
+		return 0
+	case n >= 64:
+		return ffi.CountClassTransitions64(data, class)
+	case n >= 32:
+		return ffi.CountClassTransitions32(data, class)
+	default:
+		return ffi.CountClassTransitions16(data, class)
+	}
+}
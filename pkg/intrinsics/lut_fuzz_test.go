@@ -0,0 +1,37 @@
+package intrinsics
+
+import (
+	"bytes"
+	"testing"
+)
+
+func scalarLastIndexAnyByte(data []byte, lut *[256]byte) int {
+	for i := len(data) - 1; i >= 0; i-- {
+		if lut[data[i]] != 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+func FuzzLastIndexAnyByte(f *testing.F) {
+	// Matches confined to the first 16 bytes land in the front remainder that
+	// the reverse kernel scans last.
+	front := bytes.Repeat([]byte("a"), 100)
+	front[3] = '/'
+	f.Add([]byte{}, byte('/'), byte('\\'))
+	f.Add([]byte("a/b/c"), byte('/'), byte('\\'))
+	f.Add(front, byte('/'), byte('\\'))
+	f.Add(front[:65], byte('/'), byte('\\'))
+	f.Add(bytes.Repeat([]byte("x"), 64), byte('x'), byte('x'))
+
+	f.Fuzz(func(t *testing.T, data []byte, a, b byte) {
+		var lut [256]byte
+		lut[a], lut[b] = 1, 1
+		got := LastIndexAnyByte(data, &lut)
+		want := scalarLastIndexAnyByte(data, &lut)
+		if got != want {
+			t.Fatalf("mismatch for %q set {%q,%q}: simd=%d scalar=%d", data, a, b, got, want)
+		}
+	})
+}
@@ -0,0 +1,55 @@
+package intrinsics
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func scalarClassTransitions(data []byte, class *[256]byte) int {
+	n := 0
+	for i := 1; i < len(data); i++ {
+		if class[data[i]] != class[data[i-1]] {
+			n++
+		}
+	}
+	return n
+}
+
+func TestCountClassTransitions(t *testing.T) {
+	var letters [256]byte
+	for c := 'a'; c <= 'z'; c++ {
+		letters[c] = 1
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 15, 16, 17, 31, 32, 33, 63, 64, 65, 127, 128, 200, 1000} {
+		data := make([]byte, n)
+		for i := range data {
+			if r.Intn(3) == 0 {
+				data[i] = ' '
+			} else {
+				data[i] = 'a' + byte(r.Intn(26))
+			}
+		}
+		require.Equal(t, scalarClassTransitions(data, &letters), CountClassTransitions(data, &letters), "random n=%d", n)
+	}
+
+	// A single class change placed on either side of every chunk edge.
+	for _, n := range []int{32, 64, 128, 256} {
+		for _, edge := range []int{15, 16, 17, 31, 32, 33, 63, 64, 65} {
+			if edge >= n {
+				continue
+			}
+			data := make([]byte, n)
+			for i := range data {
+				data[i] = 'a'
+				if i >= edge {
+					data[i] = ' '
+				}
+			}
+			require.Equal(t, 1, CountClassTransitions(data, &letters), "n=%d edge=%d", n, edge)
+		}
+	}
+}
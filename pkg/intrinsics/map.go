@@ -1,15 +1,36 @@
 package intrinsics
 
-import "github.com/miretskiy/simba/internal/ffi"
+import (
+	"unsafe"
+
+	"github.com/miretskiy/simba/internal/ffi"
+)
+
+// PartialOverlap reports whether a and b share memory without starting at
+// the same address.  Kernels that read a chunk before writing it handle
+// dst == src, but a shifted overlap makes later chunks read bytes an earlier
+// chunk already overwrote.
+func PartialOverlap(a, b []byte) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return false
+	}
+	pa := uintptr(unsafe.Pointer(unsafe.SliceData(a)))
+	pb := uintptr(unsafe.Pointer(unsafe.SliceData(b)))
+	return pa != pb && pa < pb+uintptr(len(b)) && pb < pa+uintptr(len(a))
+}
 
 // MapBytes applies the LUT to src and writes into dst via SIMD. intrinsics do
-// not implement a scalar path.
+// not implement a scalar path.  dst may be src itself (in-place mapping) but
+// must not otherwise overlap the first len(src) bytes of it; MapBytes panics
+// if it does.
 func MapBytes(dst, src []byte, lut *[256]byte) {
 	switch n := len(src); {
 	case n == 0:
 		return
 	case len(dst) < n:
 		panic("intrinsics: MapBytes dst slice too short")
+	case PartialOverlap(dst[:n], src):
+		panic("intrinsics: MapBytes dst partially overlaps src")
 	case n >= 64:
 		ffi.MapBytes64(dst, src, lut)
 	case n >= 32:
@@ -18,3 +39,64 @@ func MapBytes(dst, src []byte, lut *[256]byte) {
 		ffi.MapBytes16(dst, src, lut)
 	}
 }
+
+// FilterBytes compacts the bytes of src whose LUT entry is non-zero into dst
+// and returns the number of bytes written.  dst must be at least len(src)
+// long; it may be src itself (in-place filtering) but must not otherwise
+// overlap the first len(src) bytes of it; FilterBytes panics if it does.
+// intrinsics do not implement a scalar path.
+func FilterBytes(dst, src []byte, lut *[256]byte) int {
+	switch n := len(src); {
+	case n == 0:
+		return 0
+	case len(dst) < n:
+		panic("intrinsics: FilterBytes dst slice too short")
+	case PartialOverlap(dst[:n], src):
+		panic("intrinsics: FilterBytes dst partially overlaps src")
+	case n >= 64:
+		return ffi.FilterBytes64(dst, src, lut)
+	case n >= 32:
+		return ffi.FilterBytes32(dst, src, lut)
+	default:
+		return ffi.FilterBytes16(dst, src, lut)
+	}
+}
+
+// ReplaceByte rewrites every byte of data equal to old as new, in place, and
+// returns the number of bytes replaced.  intrinsics do not implement a
+// scalar path.
+func ReplaceByte(data []byte, old, new byte) int {
+	switch n := len(data); {
+	case n == 0:
+		return 0
+	case n >= 64:
+		return ffi.ReplaceByte64(data, old, new)
+	case n >= 32:
+		return ffi.ReplaceByte32(data, old, new)
+	default:
+		return ffi.ReplaceByte16(data, old, new)
+	}
+}
+
+// ValidateMapBytes maps src through lut into dst while checking each byte
+// against allowed, in a single SIMD pass.  It stops at the first byte whose
+// allowed entry is zero and returns its offset, with dst holding the mapped
+// bytes before it, or len(src) if every byte is allowed.  dst must be at
+// least len(src) long; it may be src itself but must not otherwise overlap
+// it.  intrinsics do not implement a scalar path.
+func ValidateMapBytes(dst, src []byte, allowed, lut *[256]byte) int {
+	switch n := len(src); {
+	case n == 0:
+		return 0
+	case len(dst) < n:
+		panic("intrinsics: ValidateMapBytes dst slice too short")
+	case PartialOverlap(dst[:n], src):
+		panic("intrinsics: ValidateMapBytes dst partially overlaps src")
+	case n >= 64:
+		return ffi.ValidateMapBytes64(dst, src, allowed, lut)
+	case n >= 32:
+		return ffi.ValidateMapBytes32(dst, src, allowed, lut)
+	default:
+		return ffi.ValidateMapBytes16(dst, src, allowed, lut)
+	}
+}
@@ -1,6 +1,13 @@
 package intrinsics
 
-import "github.com/miretskiy/simba/internal/ffi"
+import (
+	"bytes"
+	"encoding/binary"
+	"math/bits"
+	"slices"
+
+	"github.com/miretskiy/simba/internal/ffi"
+)
 
 // EqU8Masks64 compares each byte in `data` to `needle` using a 64-lane SIMD
 // kernel and writes the resulting 64-bit masks into `out` – one mask word per
@@ -21,3 +28,173 @@ func EqU8Masks32(data []byte, needle byte, out []uint32) int {
 func EqU8Masks16(data []byte, needle byte, out []uint16) int {
 	return ffi.EqU8Masks16(data, needle, out)
 }
+
+// EqU8MasksAppend appends the equality masks for all of data to out and
+// returns the extended slice, growing it as needed like append.  Unlike
+// EqU8Masks64 the tail is covered too: exactly (len(data)+63)/64 words are
+// appended, the last one holding bits only for the len(data)%64 trailing
+// bytes.  Existing elements of out are left untouched.
+func EqU8MasksAppend(out []uint64, data []byte, needle byte) []uint64 {
+	words := (len(data) + 63) / 64
+	base := len(out)
+	out = slices.Grow(out, words)[:base+words]
+	n := ffi.EqU8Masks64(data, needle, out[base:])
+	if n < len(data) {
+		out[len(out)-1] = eqTailMask(data[n:], needle)
+	}
+	return out
+}
+
+// EqU8MasksFull returns the equality masks for all of data: one word per full
+// 64-byte chunk in words, and the len(data)%64 trailing bytes packed into
+// tailBits, whose bits at and above tailLen are always zero.  Iterating
+// words and then the low tailLen bits of tailBits visits every byte exactly
+// once.
+func EqU8MasksFull(data []byte, needle byte) (words []uint64, tailBits uint64, tailLen int) {
+	words = make([]uint64, len(data)/64)
+	n := 0
+	if len(words) > 0 {
+		n = ffi.EqU8Masks64(data, needle, words)
+	}
+	return words, eqTailMask(data[n:], needle), len(data) - n
+}
+
+// EqU8MasksBytes writes the equality masks for data into out as a packed
+// little-endian bitset: bit i%8 of out[i/8] is set when data[i] == needle,
+// regardless of host byte order.  The bulk goes through the 64-lane kernel,
+// a remainder of 32 or 16 bytes through the narrower ones, and the last few
+// bytes are masked in Go, so the whole input is covered; bits past the end
+// of data in the final byte are zero.  Only the first len(out)*8 bytes of
+// data are examined when out is shorter than (len(data)+7)/8.  Returns the
+// number of input bytes processed.
+func EqU8MasksBytes(data []byte, needle byte, out []byte) int {
+	data = data[:min(len(data), len(out)*8)]
+	var words [64]uint64
+	p := 0
+	for len(data)-p >= 64 {
+		chunk := data[p:min(len(data), p+len(words)*64)]
+		n := ffi.EqU8Masks64(chunk, needle, words[:])
+		for i, w := range words[:n/64] {
+			binary.LittleEndian.PutUint64(out[p/8+i*8:], w)
+		}
+		p += n
+	}
+	if len(data)-p >= 32 {
+		var w [1]uint32
+		p += ffi.EqU8Masks32(data[p:p+32], needle, w[:])
+		binary.LittleEndian.PutUint32(out[p/8-4:], w[0])
+	}
+	if len(data)-p >= 16 {
+		var w [1]uint16
+		p += ffi.EqU8Masks16(data[p:p+16], needle, w[:])
+		binary.LittleEndian.PutUint16(out[p/8-2:], w[0])
+	}
+	if p < len(data) {
+		m := eqTailMask(data[p:], needle)
+		for i := p / 8; i*8 < len(data); i++ {
+			out[i] = byte(m)
+			m >>= 8
+		}
+	}
+	return len(data)
+}
+
+// eqTailMask is the scalar mop-up for fewer than 64 trailing bytes.
+func eqTailMask(tail []byte, needle byte) uint64 {
+	var m uint64
+	for i, b := range tail {
+		if b == needle {
+			m |= 1 << i
+		}
+	}
+	return m
+}
+
+// maxEqAnyNeedles is the most distinct needles EqAnyMasks compares directly;
+// beyond that it classifies through a lookup table.
+const maxEqAnyNeedles = 8
+
+// EqAnyMasks is the multi-needle variant of EqU8Masks64: bit i of a mask word
+// is set when data[i] equals any byte of needles.  Up to eight distinct
+// needles are matched with ORed SIMD compares in a single pass; more fall
+// back to InSetMasks64 with a table built from needles.  One mask per full
+// 64-byte chunk; with no needles the masks are zero.  Returns bytes
+// processed.
+func EqAnyMasks(data []byte, needles []byte, out []uint64) int {
+	var pad [maxEqAnyNeedles]byte
+	k := 0
+	for _, b := range needles {
+		if k > 0 && bytes.IndexByte(pad[:k], b) >= 0 {
+			continue
+		}
+		if k == maxEqAnyNeedles {
+			var lut [256]byte
+			for _, b := range needles {
+				lut[b] = 1
+			}
+			return ffi.InSetMasks64(data, &lut, out)
+		}
+		pad[k] = b
+		k++
+	}
+
+	switch k {
+	case 0:
+		chunks := len(data) / 64
+		clear(out[:chunks])
+		return chunks * 64
+	case 1:
+		return ffi.EqU8Masks64(data, pad[0], out)
+	}
+	for i := k; i < maxEqAnyNeedles; i++ {
+		pad[i] = pad[0]
+	}
+	return ffi.EqAnyMasks64(data, &pad, out)
+}
+
+// InSetMasks64 is the set-membership counterpart of EqU8Masks64: bit i of a
+// mask word is set when lut[data[i]] != 0, so one pass classifies bytes
+// against a whole ByteSet (e.g. all ASCII whitespace) rather than a single
+// needle.  One mask per full 64-byte chunk; returns bytes processed.
+func InSetMasks64(data []byte, lut *[256]byte, out []uint64) int {
+	return ffi.InSetMasks64(data, lut, out)
+}
+
+// InSetMasks32 is the 32-lane variant of InSetMasks64. Returns bytes processed.
+func InSetMasks32(data []byte, lut *[256]byte, out []uint32) int {
+	return ffi.InSetMasks32(data, lut, out)
+}
+
+// InSetMasks16 is the 16-lane variant of InSetMasks64. Returns bytes processed.
+func InSetMasks16(data []byte, lut *[256]byte, out []uint16) int {
+	return ffi.InSetMasks16(data, lut, out)
+}
+
+// AppendSetBits expands mask words into bit positions: for every set bit j of
+// words[i] it appends i*64+j to dst, in ascending order, and returns the
+// extended slice.  It is the inverse of SetBitsFromIndices and turns
+// EqU8Masks64 output into match offsets.
+func AppendSetBits(dst []int, words []uint64) []int {
+	for i, w := range words {
+		for w != 0 {
+			dst = append(dst, i*64+bits.TrailingZeros64(w))
+			w &= w - 1
+		}
+	}
+	return dst
+}
+
+// SetBitsFromIndices sets bit indices[i] in the word array out (bit j lives in
+// out[j/64] at position j%64), decoding a position list into a dense bitmap.
+// out must hold at least max(indices)/64+1 words; a negative or too-large
+// index panics.  Existing bits in out are preserved.
+//
+// Unlike most of this package the loop stays in Go: portable SIMD has no
+// scatter-OR, and emulating one needs conflict detection for indices that
+// share a word, which costs more than the plain read-modify-write this
+// memory-bound loop already achieves.
+func SetBitsFromIndices(out []uint64, indices []int) {
+	for _, idx := range indices {
+		out[idx>>6] |= 1 << (uint(idx) & 63)
+	}
+}
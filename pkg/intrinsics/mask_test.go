@@ -1,6 +1,9 @@
 package intrinsics
 
 import (
+	"bytes"
+	"fmt"
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -59,3 +62,215 @@ func TestEqU8MasksRemainderIgnored(t *testing.T) {
 	require.Equal(t, 16, n16, "bytes16 remainder")
 	require.Equal(t, uint16(0), out16[0], "mask16 remainder")
 }
+
+func TestInSetMasks(t *testing.T) {
+	var ws [256]byte
+	for _, c := range []byte(" \t\n") {
+		ws[c] = 1
+	}
+	r := rand.New(rand.NewSource(2))
+	data := make([]byte, 64*3+20)
+	for i := range data {
+		data[i] = " \t\nab"[r.Intn(5)]
+	}
+
+	out64 := make([]uint64, len(data)/64)
+	out32 := make([]uint32, len(data)/32)
+	out16 := make([]uint16, len(data)/16)
+	require.Equal(t, 192, InSetMasks64(data, &ws, out64))
+	require.Equal(t, 192, InSetMasks32(data, &ws, out32))
+	require.Equal(t, 208, InSetMasks16(data, &ws, out16))
+	for i, c := range data {
+		want := ws[c] != 0
+		if i < 192 {
+			require.Equal(t, want, out64[i/64]>>(i%64)&1 == 1, "64 bit %d", i)
+			require.Equal(t, want, out32[i/32]>>(i%32)&1 == 1, "32 bit %d", i)
+		}
+		if i < 208 {
+			require.Equal(t, want, out16[i/16]>>(i%16)&1 == 1, "16 bit %d", i)
+		}
+	}
+	require.Equal(t, 0, InSetMasks64(data[:63], &ws, nil))
+}
+
+func TestEqAnyMasks(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	data := make([]byte, 64*4+37)
+	for i := range data {
+		data[i] = byte(r.Intn(24))
+	}
+
+	for _, needles := range [][]byte{
+		nil,
+		{5},
+		{1, 7, 19},
+		{0, 2, 4, 6, 8, 10, 12, 14},
+		{3, 3, 9, 3, 9},                    // duplicates collapse to two needles
+		{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, // beyond eight: LUT path
+		{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3},  // eleven needles, eight distinct
+	} {
+		out := make([]uint64, len(data)/64)
+		for i := range out {
+			out[i] = ^uint64(0) // must be overwritten
+		}
+		require.Equal(t, 256, EqAnyMasks(data, needles, out), "needles %v", needles)
+		for i, c := range data[:256] {
+			want := bytes.IndexByte(needles, c) >= 0
+			require.Equal(t, want, out[i/64]>>(i%64)&1 == 1, "needles %v bit %d", needles, i)
+		}
+	}
+	require.Equal(t, 0, EqAnyMasks(data[:63], []byte{1, 2}, nil))
+}
+
+func TestSetBitsFromIndicesRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	data := make([]byte, 64*20)
+	for i := range data {
+		if r.Intn(8) == 0 {
+			data[i] = '_'
+		}
+	}
+	data[0], data[len(data)-1] = '_', '_'
+
+	masks := make([]uint64, len(data)/64)
+	EqU8Masks64(data, '_', masks)
+
+	indices := AppendSetBits(nil, masks)
+	for _, idx := range indices {
+		require.Equal(t, byte('_'), data[idx], "index %d", idx)
+	}
+	require.Equal(t, bytes.Count(data, []byte{'_'}), len(indices))
+
+	rebuilt := make([]uint64, len(masks))
+	SetBitsFromIndices(rebuilt, indices)
+	require.Equal(t, masks, rebuilt)
+
+	// Existing bits are preserved and out-of-range indices panic.
+	out := []uint64{1 << 5}
+	SetBitsFromIndices(out, []int{0, 63})
+	require.Equal(t, uint64(1|1<<5|1<<63), out[0])
+	require.Panics(t, func() { SetBitsFromIndices(out, []int{64}) })
+}
+
+func TestEqU8MasksAppend(t *testing.T) {
+	r := rand.New(rand.NewSource(8))
+	for _, n := range []int{0, 1, 63, 64, 65, 127, 128, 1000} {
+		data := make([]byte, n)
+		for i := range data {
+			data[i] = byte('a' + r.Intn(3))
+		}
+		want := make([]uint64, (n+63)/64)
+		for i, b := range data {
+			if b == 'a' {
+				want[i/64] |= 1 << (i % 64)
+			}
+		}
+
+		// Pre-filled prefix with spare capacity, and one without.
+		prefix := []uint64{0xdead, 0xbeef}
+		for _, out := range [][]uint64{append(make([]uint64, 0, 64), prefix...), prefix} {
+			got := EqU8MasksAppend(out, data, 'a')
+			require.Equal(t, prefix, got[:2], "n=%d prefix", n)
+			require.Equal(t, want, got[2:], "n=%d masks", n)
+		}
+	}
+}
+
+func TestEqU8MasksFull(t *testing.T) {
+	r := rand.New(rand.NewSource(9))
+	for _, n := range []int{0, 1, 15, 17, 31, 33, 47, 63, 64, 65, 100, 127, 128, 191, 1000} {
+		data := make([]byte, n)
+		for i := range data {
+			data[i] = byte('a' + r.Intn(3))
+		}
+
+		words, tail, tailLen := EqU8MasksFull(data, 'a')
+		require.Len(t, words, n/64, "n=%d", n)
+		require.Equal(t, n%64, tailLen, "n=%d", n)
+		if tailLen < 64 {
+			require.Zero(t, tail>>tailLen, "n=%d: bits past the tail", n)
+		}
+
+		count := 0
+		for i, b := range data {
+			var bit bool
+			if i < len(words)*64 {
+				bit = words[i/64]&(1<<(i%64)) != 0
+			} else {
+				bit = tail&(1<<(i-len(words)*64)) != 0
+			}
+			require.Equal(t, b == 'a', bit, "n=%d i=%d", n, i)
+			if bit {
+				count++
+			}
+		}
+		require.Equal(t, bytes.Count(data, []byte{'a'}), count, "n=%d", n)
+	}
+
+	// A tail made entirely of needles sets exactly tailLen bits.
+	words, tail, tailLen := EqU8MasksFull(bytes.Repeat([]byte{'x'}, 100), 'x')
+	require.Equal(t, []uint64{^uint64(0)}, words)
+	require.Equal(t, uint64(1)<<36-1, tail)
+	require.Equal(t, 36, tailLen)
+}
+
+func TestEqU8MasksBytes(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	for _, n := range []int{0, 1, 7, 8, 9, 15, 16, 17, 31, 32, 33, 48, 57, 63, 64, 65, 100, 127, 4096, 4096 + 64 + 32 + 16 + 5, 10000} {
+		data := make([]byte, n)
+		for i := range data {
+			data[i] = byte('a' + r.Intn(3))
+		}
+
+		out := bytes.Repeat([]byte{0xAA}, (n+7)/8)
+		require.Equal(t, n, EqU8MasksBytes(data, 'a', out), "n=%d", n)
+		for i, b := range data {
+			require.Equal(t, b == 'a', out[i/8]>>(i%8)&1 == 1, "n=%d i=%d", n, i)
+		}
+		if n%8 != 0 {
+			require.Zero(t, out[n/8]>>(n%8), "n=%d: bits past the end", n)
+		}
+
+		// A short out limits how much input is examined.
+		if n >= 16 {
+			short := make([]byte, n/16)
+			require.Equal(t, len(short)*8, EqU8MasksBytes(data, 'a', short), "n=%d", n)
+			require.Equal(t, out[:len(short)], short, "n=%d", n)
+		}
+	}
+
+	out := make([]byte, 2)
+	require.Equal(t, 10, EqU8MasksBytes([]byte("x.x.x.x.xx"), 'x', out))
+	require.Equal(t, []byte{0b01010101, 0b11}, out)
+}
+
+func BenchmarkEqAnyMasks(b *testing.B) {
+	data := make([]byte, 64<<10)
+	r := rand.New(rand.NewSource(4))
+	for i := range data {
+		data[i] = byte(r.Intn(128))
+	}
+	out := make([]uint64, len(data)/64)
+	for _, k := range []int{1, 3, 8} {
+		needles := []byte(",;: \t\n|=")[:k]
+		b.Run(fmt.Sprintf("needles=%d/EqAnyMasks", k), func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				EqAnyMasks(data, needles, out)
+			}
+		})
+		b.Run(fmt.Sprintf("needles=%d/EqU8Masks64", k), func(b *testing.B) {
+			tmp := make([]uint64, len(out))
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				EqU8Masks64(data, needles[0], out)
+				for _, n := range needles[1:] {
+					EqU8Masks64(data, n, tmp)
+					for w := range out {
+						out[w] |= tmp[w]
+					}
+				}
+			}
+		})
+	}
+}
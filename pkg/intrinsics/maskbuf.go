@@ -0,0 +1,57 @@
+package intrinsics
+
+import "sync"
+
+// MaskBuffer holds reusable out slices for the mask functions, so a loop
+// that classifies many buffers does not allocate a fresh mask slice per
+// call.  Each MasksNN method returns a slice sized for dataLen input bytes,
+// one word per full NN-byte chunk as EqU8MasksNN and InSetMasksNN expect,
+// reusing the previous backing array when it is large enough.  The returned
+// slice is only valid until the next call for the same width; its contents
+// are unspecified.  A MaskBuffer is not safe for concurrent use.
+type MaskBuffer struct {
+	m16 []uint16
+	m32 []uint32
+	m64 []uint64
+}
+
+// Masks16 returns a slice of dataLen/16 uint16 mask words.
+func (b *MaskBuffer) Masks16(dataLen int) []uint16 {
+	b.m16 = growMasks(b.m16, dataLen/16)
+	return b.m16
+}
+
+// Masks32 returns a slice of dataLen/32 uint32 mask words.
+func (b *MaskBuffer) Masks32(dataLen int) []uint32 {
+	b.m32 = growMasks(b.m32, dataLen/32)
+	return b.m32
+}
+
+// Masks64 returns a slice of dataLen/64 uint64 mask words.
+func (b *MaskBuffer) Masks64(dataLen int) []uint64 {
+	b.m64 = growMasks(b.m64, dataLen/64)
+	return b.m64
+}
+
+// growMasks returns s resliced to n elements, reallocating only when its
+// capacity is too small.
+func growMasks[T uint16 | uint32 | uint64](s []T, n int) []T {
+	if cap(s) < n {
+		return make([]T, n)
+	}
+	return s[:n]
+}
+
+var maskBufferPool = sync.Pool{New: func() any { return new(MaskBuffer) }}
+
+// GetMaskBuffer returns a MaskBuffer from a shared pool.  Return it with
+// PutMaskBuffer once the slices it handed out are no longer used.
+func GetMaskBuffer() *MaskBuffer {
+	return maskBufferPool.Get().(*MaskBuffer)
+}
+
+// PutMaskBuffer returns b to the pool used by GetMaskBuffer.  b and every
+// slice obtained from it must not be used afterwards.
+func PutMaskBuffer(b *MaskBuffer) {
+	maskBufferPool.Put(b)
+}
@@ -0,0 +1,58 @@
+package intrinsics
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaskBufferSizes(t *testing.T) {
+	var b MaskBuffer
+	for _, n := range []int{0, 15, 16, 100, 4096, 64, 1 << 16, 10} {
+		require.Len(t, b.Masks16(n), n/16, "n=%d", n)
+		require.Len(t, b.Masks32(n), n/32, "n=%d", n)
+		require.Len(t, b.Masks64(n), n/64, "n=%d", n)
+	}
+
+	// Shrinking keeps the backing array; growing replaces it.
+	big := b.Masks64(1 << 16)
+	small := b.Masks64(128)
+	require.Same(t, &big[0], &small[0])
+
+	pb := GetMaskBuffer()
+	defer PutMaskBuffer(pb)
+	data := bytes.Repeat([]byte("ab_"), 1000)
+	out := pb.Masks64(len(data))
+	require.Equal(t, len(data)&^63, EqU8Masks64(data, '_', out))
+
+	// Once grown, a buffer serves smaller and equal requests for free.
+	allocs := testing.AllocsPerRun(100, func() {
+		EqU8Masks64(data, '_', pb.Masks64(len(data)))
+		EqU8Masks32(data[:500], '_', pb.Masks32(500))
+	})
+	require.Zero(t, allocs)
+}
+
+func BenchmarkMaskBuffer(b *testing.B) {
+	bufs := make([][]byte, 16)
+	for i := range bufs {
+		bufs[i] = bytes.Repeat([]byte("key=value,"), 100+i*37)
+	}
+	b.Run("alloc", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			data := bufs[i%len(bufs)]
+			EqU8Masks64(data, ',', make([]uint64, len(data)/64))
+		}
+	})
+	b.Run("pool", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			data := bufs[i%len(bufs)]
+			mb := GetMaskBuffer()
+			EqU8Masks64(data, ',', mb.Masks64(len(data)))
+			PutMaskBuffer(mb)
+		}
+	})
+}
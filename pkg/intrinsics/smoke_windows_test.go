@@ -0,0 +1,27 @@
+package intrinsics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestWindowsSmoke checks that kernels are reachable through the Win64
+// trampolines; the _windows file suffix keeps it to windows builds.
+func TestWindowsSmoke(t *testing.T) {
+	data := make([]byte, 1000)
+	var want uint32
+	for i := range data {
+		data[i] = byte(i % 128)
+		want += uint32(data[i])
+	}
+	for _, n := range []int{1, 16, 33, 64, 1000} {
+		require.True(t, IsASCII(data[:n]), "n=%d", n)
+	}
+	require.Equal(t, want, SumU8(data))
+
+	old := data[999]
+	data[999] = 0x80
+	require.False(t, IsASCII(data))
+	require.Equal(t, want-uint32(old)+0x80, SumU8(data))
+}
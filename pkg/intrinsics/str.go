@@ -16,3 +16,55 @@ func IsASCII(data []byte) bool {
 		return ffi.IsASCII16(data)
 	}
 }
+
+// PrintableStats reports, in a single pass, whether every byte in data is
+// printable ASCII (0x20..0x7E) and how many bytes are not.  Control bytes,
+// DEL (0x7F) and all bytes >= 0x80 count as non-printable.  An empty slice is
+// all-printable.
+func PrintableStats(data []byte) (allPrintable bool, nonPrintableCount int) {
+	const lo, hi = 0x20, 0x7E
+	switch n := len(data); {
+	case n == 0:
+		return true, 0
+	case n >= 64:
+		nonPrintableCount = ffi.CountOutsideRange64(data, lo, hi)
+	case n >= 32:
+		nonPrintableCount = ffi.CountOutsideRange32(data, lo, hi)
+	default:
+		nonPrintableCount = ffi.CountOutsideRange16(data, lo, hi)
+	}
+	return nonPrintableCount == 0, nonPrintableCount
+}
+
+// LuhnValid reports whether data, a string of ASCII digits, passes the Luhn
+// (mod 10) check used by card and ID numbers.  Digits are doubled in
+// alternate positions counting from the right through a 10-entry LUT and
+// summed in SIMD lanes.  Any non-digit byte, or empty input, fails.
+func LuhnValid(data []byte) bool {
+	switch n := len(data); {
+	case n == 0:
+		return false
+	case n >= 64:
+		return ffi.LuhnValid64(data)
+	case n >= 32:
+		return ffi.LuhnValid32(data)
+	default:
+		return ffi.LuhnValid16(data)
+	}
+}
+
+// IsASCIIBatch sets out[i] to whether slices[i] is pure ASCII with a single
+// kernel call for the whole batch, so the per-call overhead is paid once
+// rather than per slice.  out must be at least len(slices) long.
+func IsASCIIBatch(slices [][]byte, out []bool) {
+	ffi.IsASCIIBatch(slices, out)
+}
+
+// ValidateTagBatch sets out[i] to whether tags[i] is a valid Datadog tag
+// whose length lies in [minLen, maxLen], with a single kernel call for the
+// whole batch; a maxLen of 0 or less means no upper bound.  See
+// algo.ValidateTagsASCII for the rules.  out must be at least len(tags)
+// long.
+func ValidateTagBatch(tags []string, minLen, maxLen int, out []bool) {
+	ffi.ValidateTagBatch(tags, minLen, maxLen, out)
+}
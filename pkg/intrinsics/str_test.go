@@ -2,7 +2,10 @@ package intrinsics
 
 import (
 	"fmt"
+	"math/rand"
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestIsASCII(t *testing.T) {
@@ -25,6 +28,31 @@ func TestIsASCII(t *testing.T) {
 	}
 }
 
+func TestIsASCIIBatch(t *testing.T) {
+	r := rand.New(rand.NewSource(25))
+	slices := make([][]byte, 500)
+	for i := range slices {
+		buf := make([]byte, r.Intn(150))
+		for j := range buf {
+			buf[j] = byte(r.Intn(128))
+		}
+		if len(buf) > 0 && r.Intn(3) == 0 {
+			buf[r.Intn(len(buf))] |= 0x80
+		}
+		slices[i] = buf
+	}
+	slices[7] = nil
+
+	out := make([]bool, len(slices))
+	IsASCIIBatch(slices, out)
+	for i, s := range slices {
+		require.Equal(t, IsASCII(s), out[i], "slice %d (%q)", i, s)
+	}
+
+	IsASCIIBatch(nil, nil)
+	require.Panics(t, func() { IsASCIIBatch(slices, out[:1]) })
+}
+
 // Benchmark insight (Apple M2 Max, Go 1.24, **syso trampoline – no CGO**, `go test -bench=IsASCII -count=10`)
 //
 // Representative results (Apple M2 Max, Go 1.24; median of 10 runs):
@@ -75,3 +103,58 @@ func BenchmarkIsASCII(b *testing.B) {
 		})
 	}
 }
+
+func TestPrintableStats(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 15, 16, 17, 31, 32, 33, 63, 64, 65, 1000} {
+		for _, mode := range []string{"printable", "random"} {
+			data := make([]byte, n)
+			for i := range data {
+				if mode == "printable" {
+					data[i] = byte(0x20 + r.Intn(0x7F-0x20))
+				} else {
+					data[i] = byte(r.Intn(256))
+				}
+			}
+			if mode == "random" && n > 0 {
+				data[n-1] = 0x7F // DEL in the tail is non-printable
+			}
+
+			var want int
+			for _, b := range data {
+				if b < 0x20 || b > 0x7E {
+					want++
+				}
+			}
+			ok, count := PrintableStats(data)
+			require.Equal(t, want, count, "n=%d %s", n, mode)
+			require.Equal(t, want == 0, ok, "n=%d %s", n, mode)
+		}
+	}
+}
+
+var batchSink []bool
+
+// BenchmarkIsASCIIBatch compares one batched call with one call per slice
+// for many short inputs, where the per-call overhead dominates.
+func BenchmarkIsASCIIBatch(b *testing.B) {
+	slices := make([][]byte, 1000)
+	for i := range slices {
+		slices[i] = []byte(fmt.Sprintf("service:web-%04d", i))
+	}
+	out := make([]bool, len(slices))
+	b.Run("PerSlice", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for j, s := range slices {
+				out[j] = IsASCII(s)
+			}
+		}
+		batchSink = out
+	})
+	b.Run("Batch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			IsASCIIBatch(slices, out)
+		}
+		batchSink = out
+	})
+}
@@ -0,0 +1,81 @@
+package simba
+
+import (
+	"time"
+
+	"github.com/miretskiy/simba/internal/ffi"
+)
+
+// profileSize is the buffer length ProfileWidths times each kernel on: large
+// enough that the ~0.3 ns call overhead is noise, small enough to stay in L1.
+const profileSize = 4 << 10
+
+// profileMinTime is how long quickBench keeps doubling the iteration count
+// before it trusts a measurement.
+const profileMinTime = 20 * time.Millisecond
+
+var profileLUT = func() (lut [256]byte) {
+	for i := 0; i < 128; i++ {
+		lut[i] = 1
+	}
+	return lut
+}()
+
+// profileOps maps ProfileWidths op names to their 16/32/64-lane kernels.
+var profileOps = map[string]map[int]func([]byte){
+	"sum_u8": {
+		16: func(b []byte) { ffi.SumU8_16(b) },
+		32: func(b []byte) { ffi.SumU8_32(b) },
+		64: func(b []byte) { ffi.SumU8_64(b) },
+	},
+	"is_ascii": {
+		16: func(b []byte) { ffi.IsASCII16(b) },
+		32: func(b []byte) { ffi.IsASCII32(b) },
+		64: func(b []byte) { ffi.IsASCII64(b) },
+	},
+	"all_bytes_in_set": {
+		16: func(b []byte) { ffi.AllBytesInSet16(b, &profileLUT) },
+		32: func(b []byte) { ffi.AllBytesInSet32(b, &profileLUT) },
+		64: func(b []byte) { ffi.AllBytesInSet64(b, &profileLUT) },
+	},
+	"xor_reduce": {
+		16: func(b []byte) { ffi.XorReduce16(b) },
+		32: func(b []byte) { ffi.XorReduce32(b) },
+		64: func(b []byte) { ffi.XorReduce64(b) },
+	},
+}
+
+// ProfileWidths times the 16-, 32- and 64-lane kernels of op on a 4 KiB
+// ASCII buffer and returns ns/op keyed by lane width, so threshold tuning on
+// new hardware does not need `go test -bench`.  Supported ops are "sum_u8",
+// "is_ascii", "all_bytes_in_set" and "xor_reduce"; any other op returns nil.
+// Each width runs for at least 20 ms, so a call takes well under a second.
+func ProfileWidths(op string) map[int]float64 {
+	kernels, ok := profileOps[op]
+	if !ok {
+		return nil
+	}
+	data := make([]byte, profileSize)
+	for i := range data {
+		data[i] = byte(i % 128)
+	}
+	res := make(map[int]float64, len(kernels))
+	for width, fn := range kernels {
+		res[width] = quickBench(func() { fn(data) })
+	}
+	return res
+}
+
+// quickBench returns the mean ns/op of fn, doubling the iteration count until
+// one batch runs for at least profileMinTime.
+func quickBench(fn func()) float64 {
+	for n := 1; ; n *= 2 {
+		start := time.Now()
+		for i := 0; i < n; i++ {
+			fn()
+		}
+		if d := time.Since(start); d >= profileMinTime {
+			return float64(d.Nanoseconds()) / float64(n)
+		}
+	}
+}
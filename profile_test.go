@@ -0,0 +1,18 @@
+package simba
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileWidths(t *testing.T) {
+	for op := range profileOps {
+		res := ProfileWidths(op)
+		require.Len(t, res, 3, op)
+		for _, width := range []int{16, 32, 64} {
+			require.Greater(t, res[width], 0.0, "%s width %d", op, width)
+		}
+	}
+	require.Nil(t, ProfileWidths("no_such_op"))
+}
@@ -1,7 +1,7 @@
 //! Rust SIMD kernels for Simba FFI layer
 #![feature(portable_simd)]
 #![allow(unsafe_op_in_unsafe_fn)] // calls to unsafe APIs are audited and wrapped inside unsafe fns
-use core::simd::prelude::{SimdPartialEq, SimdUint};
+use core::simd::prelude::{SimdFloat, SimdInt, SimdOrd, SimdPartialEq, SimdPartialOrd, SimdUint};
 use core::simd::{LaneCount, Simd, SupportedLaneCount};
 use crc32c::{crc32c_append, crc32c_combine};
 
@@ -59,6 +59,25 @@ pub unsafe extern "C" fn crc32_combine(crc1: u32, crc2: u32, len2: usize) -> u32
     crc32c_combine_go(crc1, crc2, len2)
 }
 
+// === CRC32 (IEEE) update =====================================================
+
+/// Update CRC-32 (IEEE 802.3, the zlib/gzip polynomial) with additional
+/// bytes.  `init` is a finalised digest, as with Go's `crc32.Update`;
+/// `crc32fast` picks the carry-less-multiply or table path at runtime.
+///
+/// # Safety
+/// `ptr` must be null or valid for `len` bytes.
+#[unsafe(no_mangle)]
+pub unsafe extern "C" fn crc32_ieee_update(ptr: *const u8, len: usize, init: u32) -> u32 {
+    if ptr.is_null() || len == 0 {
+        return init;
+    }
+    let data = core::slice::from_raw_parts(ptr, len);
+    let mut hasher = crc32fast::Hasher::new_with_initial(init);
+    hasher.update(data);
+    hasher.finalize()
+}
+
 // === Portable SIMD byte-sum ===================================================
 
 // ---- Generic helpers --------------------------------------------------------
@@ -250,12 +269,35 @@ export_map_u8_lut!(map_u8_lut64, 64);
 
 // === Byte equality mask =====================================================
 
+/// Mask word written by the eq_u8_masks kernels; one bit per lane.
+trait MaskWord: Copy {
+    fn from_bitmask(m: u64) -> Self;
+}
+impl MaskWord for u16 {
+    #[inline(always)]
+    fn from_bitmask(m: u64) -> Self {
+        m as u16
+    }
+}
+impl MaskWord for u32 {
+    #[inline(always)]
+    fn from_bitmask(m: u64) -> Self {
+        m as u32
+    }
+}
+impl MaskWord for u64 {
+    #[inline(always)]
+    fn from_bitmask(m: u64) -> Self {
+        m
+    }
+}
+
 #[inline(always)]
-unsafe fn eq_u8_masks_impl<const LANES: usize>(
+unsafe fn eq_u8_masks_impl<const LANES: usize, W: MaskWord>(
     src: *const u8,
     len: usize,
     needle: u8,
-    out: *mut u128, // storage large enough for any mask size, cast later
+    out: *mut W,
 ) -> usize
 where
     LaneCount<LANES>: SupportedLaneCount,
@@ -265,15 +307,12 @@ where
     }
     let chunks = len / LANES;
     let src_slice = core::slice::from_raw_parts(src, len);
-    let out_slice = core::slice::from_raw_parts_mut(out as *mut u128, chunks);
+    let out_slice = core::slice::from_raw_parts_mut(out, chunks);
 
     for (i, chunk) in src_slice.chunks_exact(LANES).enumerate() {
-        if i == chunks {
-            break;
-        }
         let v = Simd::<u8, LANES>::from_slice(chunk);
         let mask = v.simd_eq(Simd::splat(needle));
-        out_slice[i] = mask.to_bitmask() as u128;
+        out_slice[i] = W::from_bitmask(mask.to_bitmask());
     }
     chunks
 }
@@ -297,7 +336,7 @@ macro_rules! export_eq_masks {
             if src.is_null() || out.is_null() || len == 0 {
                 return 0;
             }
-            eq_u8_masks_impl::<$lanes>(src, len, needle, out as *mut u128)
+            eq_u8_masks_impl::<$lanes, $int>(src, len, needle, out)
         }
     };
 }
@@ -306,378 +345,3715 @@ export_eq_masks!(eq_u8_masks16, 16, u16);
 export_eq_masks!(eq_u8_masks32, 32, u32);
 export_eq_masks!(eq_u8_masks64, 64, u64);
 
-// -----------------------------------------------------------------------------
-
-// FFI helper: no-op function to measure call overhead -------------------------
-#[unsafe(no_mangle)]
-pub unsafe extern "C" fn noop() {
-    // deliberately does nothing
-}
-
-// === FFI trampoline sanity helper ===========================================
-/// Simple checksum over the arguments; used only by Go tests to verify that
-/// assembly trampolines pass parameters with the correct width/order.
+/// Generate 64-bit masks with bit i set when byte i of a chunk equals any of
+/// the eight `needles`.  The compares are ORed, so unused needle slots should
+/// repeat a real needle.  Returns the number of mask words written; tail
+/// bytes `len % 64` are skipped.
+///
+/// # Safety
+/// `src`, `needles` and `out` must be valid for `len`, 8 and `len/64`
+/// elements respectively.
 #[unsafe(no_mangle)]
-pub unsafe extern "C" fn trampoline_sanity(
-    ptr: *const u8,
+pub unsafe extern "C" fn eq_any_masks64(
+    src: *const u8,
     len: usize,
-    val32: u32,
-    val8: u8,
-    val64: u64,
-    f64_bits: u64,
-    f32_bits: u32,
+    needles: *const [u8; 8],
+    out: *mut u64,
 ) -> usize {
-    // Mix everything into a 64-bit value using a cheap LCG-style hash.
-    let mut h = 0xcbf29ce484222325u64; // FNV offset basis
-    #[inline(always)]
-    fn mix(h: u64, v: u64) -> u64 {
-        h ^ v.wrapping_mul(0x100_0000_01b3)
+    if src.is_null() || needles.is_null() || out.is_null() || len < 64 {
+        return 0;
     }
-    h = mix(h, ptr as u64);
-    h = mix(h, len as u64);
-    h = mix(h, val32 as u64);
-    h = mix(h, val8 as u64);
-    h = mix(h, val64);
-    let fb64 = f64_bits & 0x7fff_ffff_ffff_ffffu64; // ignore sign if provided
-    let fb32 = (f32_bits & 0x7fff_ffffu32) as u64;
-    h = mix(h, fb64);
-    h = mix(h, fb32);
-    h as usize
+    let chunks = len / 64;
+    let src_slice = core::slice::from_raw_parts(src, len);
+    let out_slice = core::slice::from_raw_parts_mut(out, chunks);
+    let needles = *needles;
+
+    // The needles are splatted per chunk: eight 64-byte splats kept live
+    // across the loop would spill 512 bytes to the stack.
+    for (i, chunk) in src_slice.chunks_exact(64).enumerate() {
+        let v = Simd::<u8, 64>::from_slice(chunk);
+        let mut hit = v.simd_eq(Simd::splat(needles[0]));
+        for &n in &needles[1..] {
+            hit |= v.simd_eq(Simd::splat(n));
+        }
+        out_slice[i] = hit.to_bitmask();
+    }
+    chunks
 }
 
-/// Echo structure for detailed trampoline debugging (test builds only).
-#[repr(C)]
-#[derive(Copy, Clone, Debug, PartialEq)]
-pub struct Echo {
-    pub ptr: usize,
-    pub len: usize,
-    pub v32: u32,
-    pub v8: u8,
-    pub v64: u64,
-    pub f64bits: u64,
-    pub f32bits: u32,
-}
+// === Set-membership mask ====================================================
 
-/// Bounce all parameters back to the caller; used by Go unit tests to pinpoint
-/// which argument (if any) is mis-marshalled by the assembly trampolines.
-#[unsafe(no_mangle)]
-pub unsafe extern "C" fn trampoline_echo(
-    ptr: *const u8,
+#[inline(always)]
+unsafe fn lut_masks_impl<const LANES: usize, W: MaskWord>(
+    src: *const u8,
     len: usize,
-    v32: u32,
-    v8: u8,
-    v64: u64,
-    f64bits: u64,
-    f32bits: u32,
-    out: *mut Echo,
-) {
-    if out.is_null() {
-        return;
-    }
-    *out = Echo {
-        ptr: ptr as usize,
-        len,
-        v32,
-        v8,
-        v64,
-        f64bits,
-        f32bits,
-    };
-}
+    table: *const u8,
+    out: *mut W,
+) -> usize
+where
+    LaneCount<LANES>: SupportedLaneCount,
+{
+    let chunks = len / LANES;
+    let src_slice = core::slice::from_raw_parts(src, len);
+    let lut = core::slice::from_raw_parts(table, 256);
+    let out_slice = core::slice::from_raw_parts_mut(out, chunks);
 
-#[cfg(test)]
-mod tests {
-    #[test]
-    fn test_sum_u8() {
-        let data: Vec<u8> = (0u8..=255u8).collect();
-        let expected: u32 = data.iter().map(|&b| b as u32).sum();
-        let result = unsafe { super::sum_u8_64(data.as_ptr(), data.len()) };
-        assert_eq!(result, expected);
+    for (i, chunk) in src_slice.chunks_exact(LANES).enumerate() {
+        let idx: Simd<usize, LANES> = Simd::<u8, LANES>::from_slice(chunk).cast();
+        let member = Simd::<u8, LANES>::gather_or_default(lut, idx).simd_ne(Simd::splat(0));
+        out_slice[i] = W::from_bitmask(member.to_bitmask());
     }
+    chunks
+}
 
-    #[test]
-    fn test_sum_u8_wrap() {
-        // Number of 0xFF bytes needed to overflow u32.
-        const LEN: usize = 16_843_010; // ceil(2^32 / 255)
-        let data = vec![0xFFu8; LEN];
-        // Expected result is (255 * LEN) mod 2^32.
-        let expected = ((255u64 * LEN as u64) & 0xFFFF_FFFF) as u32;
-        let result = unsafe { super::sum_u8_64(data.as_ptr(), data.len()) };
-        assert_eq!(result, expected);
-    }
+macro_rules! export_lut_masks {
+    ($name:ident, $lanes:expr, $int:ty) => {
+        #[doc = concat!(
+            "Generate set-membership bitmasks (bit set where `table[byte] != 0`) across chunks of ", stringify!($lanes), " lanes. The resulting mask words are stored in `out`. Returns number of mask words written.\n\n",
+            "# Safety\n",
+            "`src` and `out` must be valid for `len` and `len/", stringify!($lanes), "` elements respectively; `table` for 256 bytes."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(
+            src: *const u8,
+            len: usize,
+            table: *const u8,
+            out: *mut $int,
+        ) -> usize {
+            if src.is_null() || table.is_null() || out.is_null() || len == 0 {
+                return 0;
+            }
+            lut_masks_impl::<$lanes, $int>(src, len, table, out)
+        }
+    };
+}
 
-    #[test]
-    fn test_sum_u8_various_lengths() {
-        // Stress a variety of lengths to make sure chunk/remainder logic works.
-        let mut lengths = vec![
-            0usize,
-            1,
-            64 - 1,
-            64,
-            64 + 1,
-            2 * 64 - 3,
-            2 * 64,
-            4096,
-            10_000,
-        ];
-        lengths.push(123_456); // an arbitrary large size
+export_lut_masks!(lut_masks16, 16, u16);
+export_lut_masks!(lut_masks32, 32, u32);
+export_lut_masks!(lut_masks64, 64, u64);
 
-        for len in lengths {
-            // Create data pattern 0,1,2,3,... wrapping every 256.
-            let data: Vec<u8> = (0..len as u32).map(|i| (i % 256) as u8).collect();
+// === First byte below a threshold ===========================================
 
-            let expected: u32 = data.iter().map(|&b| b as u32).sum();
-            let got = unsafe { super::sum_u8_64(data.as_ptr(), data.len()) };
-            assert_eq!(got, expected, "failed at len={}", len);
+#[inline(always)]
+unsafe fn index_lt_u8_impl<const L: usize>(data: &[u8], threshold: u8) -> usize
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    let limit = Simd::<u8, L>::splat(threshold);
+    let mut chunks = data.chunks_exact(L);
+    let mut base = 0usize;
+    for chunk in &mut chunks {
+        let v = Simd::<u8, L>::from_slice(chunk);
+        let mask = v.simd_lt(limit).to_bitmask();
+        if mask != 0 {
+            return base + mask.trailing_zeros() as usize;
         }
+        base += L;
     }
-
-    #[test]
-    fn test_is_ascii() {
-        let ascii = b"Hello, world!";
-        let non_ascii = [0x48u8, 0x80u8, 0x49u8];
-
-        unsafe {
-            assert_eq!(super::is_ascii32(ascii.as_ptr(), ascii.len()), 1);
-            assert_eq!(super::is_ascii64(non_ascii.as_ptr(), non_ascii.len()), 0);
-            assert_eq!(super::is_ascii32(core::ptr::null(), 0), 1);
+    for (i, &b) in chunks.remainder().iter().enumerate() {
+        if b < threshold {
+            return base + i;
         }
     }
+    data.len()
+}
 
-    #[test]
-    fn test_map_u8_lut_basic() {
-        // Mapping table: invert each byte (x -> 255 - x)
-        let map: Vec<u8> = (0..=255u16).map(|b| 255u8.wrapping_sub(b as u8)).collect();
-        let src: Vec<u8> = (0..=255u16).map(|b| b as u8).collect();
-        let mut dst16 = vec![0u8; src.len()];
-        let mut dst32 = vec![0u8; src.len()];
-        let mut dst64 = vec![0u8; src.len()];
-        unsafe {
-            super::map_u8_lut16(src.as_ptr(), src.len(), dst16.as_mut_ptr(), map.as_ptr());
-            super::map_u8_lut32(src.as_ptr(), src.len(), dst32.as_mut_ptr(), map.as_ptr());
-            super::map_u8_lut64(src.as_ptr(), src.len(), dst64.as_mut_ptr(), map.as_ptr());
+/* ─── index_lt_u8 exports via macro ─────────────────────────────────────── */
+macro_rules! export_index_lt_u8 {
+    ($name:ident, $lanes:expr) => {
+        #[doc = concat!(
+            "Return the offset of the first byte `< threshold` using a ", stringify!($lanes), "-lane SIMD kernel, or `len` if there is none.\n\n",
+            "# Safety\n",
+            "`ptr` must be null or valid for `len` bytes."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(ptr: *const u8, len: usize, threshold: u8) -> usize {
+            if ptr.is_null() || len == 0 {
+                return 0;
+            }
+            let data = core::slice::from_raw_parts(ptr, len);
+            index_lt_u8_impl::<$lanes>(data, threshold)
         }
-        let expected: Vec<u8> = src.iter().map(|&b| 255 - b).collect();
-        assert_eq!(dst16, expected, "16-lane mapping failed");
-        assert_eq!(dst32, expected, "32-lane mapping failed");
-        assert_eq!(dst64, expected, "64-lane mapping failed");
-    }
+    };
+}
+export_index_lt_u8!(index_lt_u8_16, 16);
+export_index_lt_u8!(index_lt_u8_32, 32);
+export_index_lt_u8!(index_lt_u8_64, 64);
 
-    #[test]
-    fn test_map_u8_lut_various_lengths() {
-        let map: Vec<u8> = (0..=255u16).map(|b| (b as u8).wrapping_add(1)).collect(); // simple +1 mapping
-        let lengths = [0usize, 1, 15, 16, 17, 31, 32, 33, 63, 64, 65, 255, 1023];
-        for &len in &lengths {
-            let src: Vec<u8> = (0..len as u32).map(|i| (i % 256) as u8).collect();
-            let mut dst = vec![0u8; len];
-            unsafe {
-                super::map_u8_lut64(src.as_ptr(), len, dst.as_mut_ptr(), map.as_ptr());
-            }
-            for i in 0..len {
-                assert_eq!(dst[i], src[i].wrapping_add(1), "idx {} len {}", i, len);
-            }
+// === Reverse LUT membership scan ============================================
+
+#[inline(always)]
+unsafe fn last_index_lut_impl<const L: usize>(data: &[u8], table: &[u8]) -> usize
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    // rchunks_exact aligns chunks to the end of the buffer, so the remainder
+    // is the *front* of the slice and must be scanned last.
+    let mut chunks = data.rchunks_exact(L);
+    let mut end = data.len();
+    for chunk in &mut chunks {
+        end -= L;
+        let v = Simd::<u8, L>::from_slice(chunk);
+        let idx: Simd<usize, L> = v.cast();
+        let flags = Simd::<u8, L>::gather_or_default(table, idx);
+        let mask = flags.simd_ne(Simd::splat(0)).to_bitmask();
+        if mask != 0 {
+            return end + (63 - mask.leading_zeros() as usize);
+        }
+    }
+    for (i, &b) in chunks.remainder().iter().enumerate().rev() {
+        if table[b as usize] != 0 {
+            return i;
         }
     }
+    data.len()
 }
 
-#[cfg(test)]
-mod mask_tests {
-    fn scalar_mask(chunk: &[u8], needle: u8) -> u128 {
-        let mut m = 0u128;
-        for (i, &b) in chunk.iter().enumerate() {
-            if b == needle {
-                m |= 1 << i;
+/* ─── last_index_lut exports via macro ──────────────────────────────────── */
+macro_rules! export_last_index_lut {
+    ($name:ident, $lanes:expr) => {
+        #[doc = concat!(
+            "Return the offset of the last byte whose 256-byte lookup table entry is non-zero, scanning ", stringify!($lanes), "-lane chunks from the end, or `len` if there is none.\n\n",
+            "# Safety\n",
+            "• `ptr`/`lut` must be valid for `len`/256 bytes respectively."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(ptr: *const u8, len: usize, lut: *const u8) -> usize {
+            if ptr.is_null() || len == 0 {
+                return 0;
             }
+            let data = core::slice::from_raw_parts(ptr, len);
+            let table = core::slice::from_raw_parts(lut, 256);
+            last_index_lut_impl::<$lanes>(data, table)
         }
-        m
-    }
-    #[test]
-    fn test_eq_u8_masks_basic() {
-        let data: Vec<u8> = (0..128u16).map(|i| (i % 256) as u8).collect();
-        let mut out16 = vec![0u16; data.len() / 16];
-        let mut out32 = vec![0u32; data.len() / 32];
+    };
+}
+export_last_index_lut!(last_index_lut16, 16);
+export_last_index_lut!(last_index_lut32, 32);
+export_last_index_lut!(last_index_lut64, 64);
+
+// === NaN-propagating f32 min / max ==========================================
+
+// SIMD min/max instructions disagree across platforms on NaN and signed-zero
+// handling, so the kernels never use float compares.  NaN is detected up
+// front; everything else is mapped to an i32 key whose integer order matches
+// the IEEE total order (-0 < +0), reduced with integer min/max and mapped
+// back.  The result follows Go's math.Min / math.Max exactly.
+#[inline(always)]
+fn f32_key(bits: u32) -> i32 {
+    let k = bits as i32;
+    k ^ (((k >> 31) as u32) >> 1) as i32
+}
+
+#[inline(always)]
+unsafe fn minmax_f32_impl<const L: usize, const MAX: bool>(data: &[f32]) -> f32
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    let identity = if MAX { f32::NEG_INFINITY } else { f32::INFINITY };
+    let mut acc = Simd::<i32, L>::splat(f32_key(identity.to_bits()));
+    let mut chunks = data.chunks_exact(L);
+    for chunk in &mut chunks {
+        let v = Simd::<f32, L>::from_slice(chunk);
+        if v.is_nan().any() {
+            return f32::NAN;
+        }
+        let k: Simd<i32, L> = v.to_bits().cast();
+        let k = k ^ ((k >> Simd::splat(31)).cast::<u32>() >> Simd::splat(1)).cast();
+        acc = if MAX { acc.simd_max(k) } else { acc.simd_min(k) };
+    }
+    let mut best = if MAX { acc.reduce_max() } else { acc.reduce_min() };
+    for &x in chunks.remainder() {
+        if x.is_nan() {
+            return f32::NAN;
+        }
+        let k = f32_key(x.to_bits());
+        best = if MAX { best.max(k) } else { best.min(k) };
+    }
+    // f32_key is its own inverse.
+    f32::from_bits(f32_key(best as u32) as u32)
+}
+
+/* ─── min/max f32 exports via macro ─────────────────────────────────────── */
+macro_rules! export_minmax_f32 {
+    ($name:ident, $lanes:expr, $max:expr, $what:literal, $empty:literal) => {
+        #[doc = concat!(
+            "Return the bit pattern of the ", $what, " of `len` f32 values using a ", stringify!($lanes), "-lane SIMD kernel. ",
+            "NaN propagates and -0 orders below +0, matching Go's math.Min/math.Max; an empty input yields ", $empty, ".\n\n",
+            "# Safety\n",
+            "`ptr` must be null or valid for `len` f32 elements."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(ptr: *const f32, len: usize) -> u32 {
+            if ptr.is_null() || len == 0 {
+                return if $max { f32::NEG_INFINITY } else { f32::INFINITY }.to_bits();
+            }
+            let data = core::slice::from_raw_parts(ptr, len);
+            minmax_f32_impl::<$lanes, $max>(data).to_bits()
+        }
+    };
+}
+export_minmax_f32!(min_f32_16, 16, false, "minimum", "+Inf");
+export_minmax_f32!(min_f32_32, 32, false, "minimum", "+Inf");
+export_minmax_f32!(min_f32_64, 64, false, "minimum", "+Inf");
+export_minmax_f32!(max_f32_16, 16, true, "maximum", "-Inf");
+export_minmax_f32!(max_f32_32, 32, true, "maximum", "-Inf");
+export_minmax_f32!(max_f32_64, 64, true, "maximum", "-Inf");
+
+// === Count bytes outside an inclusive range =================================
+
+#[inline(always)]
+unsafe fn count_outside_impl<const L: usize>(data: &[u8], lo: u8, hi: u8) -> usize
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    let vlo = Simd::<u8, L>::splat(lo);
+    let vhi = Simd::<u8, L>::splat(hi);
+    let mut total = 0usize;
+    let mut chunks = data.chunks_exact(L);
+    for chunk in &mut chunks {
+        let v = Simd::<u8, L>::from_slice(chunk);
+        let outside = v.simd_lt(vlo) | v.simd_gt(vhi);
+        total += outside.to_bitmask().count_ones() as usize;
+    }
+    total
+        + chunks
+            .remainder()
+            .iter()
+            .filter(|&&b| b < lo || b > hi)
+            .count()
+}
+
+/* ─── count_outside exports via macro ───────────────────────────────────── */
+macro_rules! export_count_outside {
+    ($name:ident, $lanes:expr) => {
+        #[doc = concat!(
+            "Count bytes outside the inclusive range `[lo, hi]` using a ", stringify!($lanes), "-lane SIMD kernel (two compares plus popcount).\n\n",
+            "# Safety\n",
+            "`ptr` must be null or valid for `len` bytes."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(ptr: *const u8, len: usize, lo: u8, hi: u8) -> usize {
+            if ptr.is_null() || len == 0 {
+                return 0;
+            }
+            let data = core::slice::from_raw_parts(ptr, len);
+            count_outside_impl::<$lanes>(data, lo, hi)
+        }
+    };
+}
+export_count_outside!(count_outside_u8_16, 16);
+export_count_outside!(count_outside_u8_32, 32);
+export_count_outside!(count_outside_u8_64, 64);
+
+// === All bytes within [lo, hi] ==============================================
+
+#[inline(always)]
+fn all_in_range_impl<const L: usize>(data: &[u8], lo: u8, hi: u8) -> bool
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    let vlo = Simd::<u8, L>::splat(lo);
+    let vhi = Simd::<u8, L>::splat(hi);
+    let mut chunks = data.chunks_exact(L);
+    for chunk in &mut chunks {
+        let v = Simd::<u8, L>::from_slice(chunk);
+        if !(v.simd_ge(vlo) & v.simd_le(vhi)).all() {
+            return false;
+        }
+    }
+    chunks.remainder().iter().all(|&b| b >= lo && b <= hi)
+}
+
+/* ─── all_in_range exports via macro ───────────────────────────────────── */
+macro_rules! export_all_in_range {
+    ($name:ident, $lanes:expr) => {
+        #[doc = concat!(
+            "Return 1 if every byte lies in the inclusive range `[lo, hi]` using a ",
+            stringify!($lanes), "-lane SIMD kernel (two compares, no gather), 0 otherwise. ",
+            "An empty range (`lo > hi`) admits no bytes.\n\n",
+            "# Safety\n",
+            "`ptr` must be null or valid for `len` bytes."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(ptr: *const u8, len: usize, lo: u8, hi: u8) -> u8 {
+            if ptr.is_null() || len == 0 {
+                return 1;
+            }
+            let data = core::slice::from_raw_parts(ptr, len);
+            all_in_range_impl::<$lanes>(data, lo, hi) as u8
+        }
+    };
+}
+export_all_in_range!(all_in_range_u8_16, 16);
+export_all_in_range!(all_in_range_u8_32, 32);
+export_all_in_range!(all_in_range_u8_64, 64);
+
+// === LUT-driven byte compaction (filter) ====================================
+
+#[inline(always)]
+unsafe fn filter_u8_lut_impl<const L: usize>(
+    src: *const u8,
+    len: usize,
+    dst: *mut u8,
+    table: &[u8],
+) -> usize
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    // `dst` may alias `src`: the write cursor never overtakes the read cursor
+    // and each chunk is loaded into a register before any byte is stored, so
+    // raw pointers are used instead of overlapping slices.
+    let mut out = 0usize;
+    let mut pos = 0usize;
+    while pos + L <= len {
+        let v = Simd::<u8, L>::from_slice(core::slice::from_raw_parts(src.add(pos), L));
+        let idx: Simd<usize, L> = v.cast();
+        let keep = Simd::<u8, L>::gather_or_default(table, idx).simd_ne(Simd::splat(0));
+        let mut mask = keep.to_bitmask();
+        if keep.all() {
+            core::ptr::copy(src.add(pos), dst.add(out), L);
+            out += L;
+        } else {
+            while mask != 0 {
+                let i = mask.trailing_zeros() as usize;
+                *dst.add(out) = v[i];
+                out += 1;
+                mask &= mask - 1;
+            }
+        }
+        pos += L;
+    }
+    while pos < len {
+        let b = *src.add(pos);
+        if table[b as usize] != 0 {
+            *dst.add(out) = b;
+            out += 1;
+        }
+        pos += 1;
+    }
+    out
+}
+
+/* ─── filter_u8_lut exports via macro ───────────────────────────────────── */
+macro_rules! export_filter_u8_lut {
+    ($name:ident, $lanes:expr) => {
+        #[doc = concat!(
+            "Copy the bytes of `src` whose 256-byte lookup table entry is non-zero into `dst`, compacting them, using a ", stringify!($lanes), "-lane SIMD kernel. Returns the number of bytes written.\n\n",
+            "# Safety\n",
+            "`src`/`dst` must be valid for `len` bytes and `lut` for 256 bytes. `dst` may equal `src` (in-place filtering) but must not overlap it otherwise."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(src: *const u8, len: usize, dst: *mut u8, lut: *const u8) -> usize {
+            if len == 0 || src.is_null() || dst.is_null() || lut.is_null() {
+                return 0;
+            }
+            let table = core::slice::from_raw_parts(lut, 256);
+            filter_u8_lut_impl::<$lanes>(src, len, dst, table)
+        }
+    };
+}
+export_filter_u8_lut!(filter_u8_lut16, 16);
+export_filter_u8_lut!(filter_u8_lut32, 32);
+export_filter_u8_lut!(filter_u8_lut64, 64);
+
+// === Fused LUT validation and mapping =======================================
+
+/// Look every lane of `v` up in the 256-entry `table`.  The loads are done
+/// lane by lane: casting the indices to `Simd<usize, L>` for
+/// `gather_or_default` takes 8*L bytes, which at 64 lanes spills past the
+/// stack a kernel may use.
+#[inline(always)]
+fn lut_lookup<const L: usize>(v: Simd<u8, L>, table: &[u8; 256]) -> Simd<u8, L>
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    Simd::from_array(v.to_array().map(|b| table[b as usize]))
+}
+
+#[inline(always)]
+unsafe fn validate_map_u8_lut_impl<const L: usize>(
+    src: *const u8,
+    len: usize,
+    dst: *mut u8,
+    allowed: &[u8; 256],
+    map: &[u8; 256],
+) -> usize
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    // As in filter_u8_lut_impl, `dst` may equal `src`: each chunk is loaded
+    // before any of it is stored.
+    let mut pos = 0usize;
+    while pos + L <= len {
+        let v = Simd::<u8, L>::from_slice(core::slice::from_raw_parts(src.add(pos), L));
+        let bad = lut_lookup(v, allowed).simd_eq(Simd::splat(0));
+        let mapped = lut_lookup(v, map);
+        if bad.any() {
+            let stop = bad.to_bitmask().trailing_zeros() as usize;
+            for i in 0..stop {
+                *dst.add(pos + i) = mapped[i];
+            }
+            return pos + stop;
+        }
+        mapped.copy_to_slice(core::slice::from_raw_parts_mut(dst.add(pos), L));
+        pos += L;
+    }
+    while pos < len {
+        let b = *src.add(pos);
+        if allowed[b as usize] == 0 {
+            return pos;
+        }
+        *dst.add(pos) = map[b as usize];
+        pos += 1;
+    }
+    len
+}
+
+/* ─── validate_map_u8_lut exports via macro ─────────────────────────────── */
+macro_rules! export_validate_map_u8_lut {
+    ($name:ident, $lanes:expr) => {
+        #[doc = concat!(
+            "Map each byte of `src` through the 256-byte table `map` into `dst` while checking it against the 256-byte table `allowed` (non-zero entry marks a valid byte), in one ", stringify!($lanes), "-lane SIMD pass. Stops at the first invalid byte and returns its offset, having mapped every byte before it; returns `len` when all bytes are valid.\n\n",
+            "# Safety\n",
+            "`src`/`dst` must be valid for `len` bytes and `allowed`/`map` for 256 bytes. `dst` may equal `src` but must not overlap it otherwise."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(
+            src: *const u8,
+            len: usize,
+            dst: *mut u8,
+            allowed: *const u8,
+            map: *const u8,
+        ) -> usize {
+            if len == 0 || src.is_null() || dst.is_null() || allowed.is_null() || map.is_null() {
+                return 0;
+            }
+            let allowed = &*(allowed as *const [u8; 256]);
+            let map = &*(map as *const [u8; 256]);
+            validate_map_u8_lut_impl::<$lanes>(src, len, dst, allowed, map)
+        }
+    };
+}
+export_validate_map_u8_lut!(validate_map_u8_lut16, 16);
+export_validate_map_u8_lut!(validate_map_u8_lut32, 32);
+export_validate_map_u8_lut!(validate_map_u8_lut64, 64);
+
+// === First byte not equal to a value ========================================
+
+#[inline(always)]
+unsafe fn index_ne_u8_impl<const L: usize>(data: &[u8], val: u8) -> usize
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    let splat = Simd::<u8, L>::splat(val);
+    let mut chunks = data.chunks_exact(L);
+    let mut base = 0usize;
+    for chunk in &mut chunks {
+        let v = Simd::<u8, L>::from_slice(chunk);
+        let mask = v.simd_ne(splat).to_bitmask();
+        if mask != 0 {
+            return base + mask.trailing_zeros() as usize;
+        }
+        base += L;
+    }
+    for (i, &b) in chunks.remainder().iter().enumerate() {
+        if b != val {
+            return base + i;
+        }
+    }
+    data.len()
+}
+
+/* ─── index_ne_u8 exports via macro ─────────────────────────────────────── */
+macro_rules! export_index_ne_u8 {
+    ($name:ident, $lanes:expr) => {
+        #[doc = concat!(
+            "Return the offset of the first byte `!= val` using a ", stringify!($lanes), "-lane SIMD kernel, or `len` if every byte equals `val`.\n\n",
+            "# Safety\n",
+            "`ptr` must be null or valid for `len` bytes."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(ptr: *const u8, len: usize, val: u8) -> usize {
+            if ptr.is_null() || len == 0 {
+                return 0;
+            }
+            let data = core::slice::from_raw_parts(ptr, len);
+            index_ne_u8_impl::<$lanes>(data, val)
+        }
+    };
+}
+export_index_ne_u8!(index_ne_u8_16, 16);
+export_index_ne_u8!(index_ne_u8_32, 32);
+export_index_ne_u8!(index_ne_u8_64, 64);
+
+// === First occurrence of a byte pair ========================================
+
+#[inline(always)]
+unsafe fn index_pair_u8_impl<const L: usize>(data: &[u8], b0: u8, b1: u8) -> usize
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    let (s0, s1) = (Simd::<u8, L>::splat(b0), Simd::<u8, L>::splat(b1));
+    let mut base = 0usize;
+    // Each window pairs L bytes with the L bytes one further on, so a pair
+    // that straddles two chunks is seen by the window of its first byte.
+    while base + L < data.len() {
+        let v0 = Simd::<u8, L>::from_slice(&data[base..base + L]);
+        let v1 = Simd::<u8, L>::from_slice(&data[base + 1..base + L + 1]);
+        let mask = (v0.simd_eq(s0) & v1.simd_eq(s1)).to_bitmask();
+        if mask != 0 {
+            return base + mask.trailing_zeros() as usize;
+        }
+        base += L;
+    }
+    data[base..]
+        .windows(2)
+        .position(|w| w[0] == b0 && w[1] == b1)
+        .map_or(data.len(), |i| base + i)
+}
+
+/* ─── index_pair_u8 exports via macro ──────────────────────────────────── */
+macro_rules! export_index_pair_u8 {
+    ($name:ident, $lanes:expr) => {
+        #[doc = concat!(
+            "Return the first offset `i` with `data[i] == b0 && data[i+1] == b1` using a ",
+            stringify!($lanes), "-lane SIMD kernel, or `len` if the pair does not occur.\n\n",
+            "# Safety\n",
+            "`ptr` must be null or valid for `len` bytes."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(ptr: *const u8, len: usize, b0: u8, b1: u8) -> usize {
+            if ptr.is_null() || len == 0 {
+                return 0;
+            }
+            let data = core::slice::from_raw_parts(ptr, len);
+            index_pair_u8_impl::<$lanes>(data, b0, b1)
+        }
+    };
+}
+export_index_pair_u8!(index_pair_u8_16, 16);
+export_index_pair_u8!(index_pair_u8_32, 32);
+export_index_pair_u8!(index_pair_u8_64, 64);
+
+// === XOR fold ===============================================================
+
+#[inline(always)]
+unsafe fn xor_reduce_impl<const L: usize>(data: &[u8]) -> u8
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    let mut acc = Simd::<u8, L>::splat(0);
+    let mut chunks = data.chunks_exact(L);
+    for chunk in &mut chunks {
+        acc ^= Simd::<u8, L>::from_slice(chunk);
+    }
+    chunks.remainder().iter().fold(acc.reduce_xor(), |x, &b| x ^ b)
+}
+
+/* ─── xor_reduce exports via macro ──────────────────────────────────────── */
+macro_rules! export_xor_reduce {
+    ($name:ident, $lanes:expr) => {
+        #[doc = concat!(
+            "Return the XOR of all bytes using a ", stringify!($lanes), "-lane SIMD kernel (0 for empty input).\n\n",
+            "# Safety\n",
+            "`ptr` must be null or valid for `len` bytes."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(ptr: *const u8, len: usize) -> u8 {
+            if ptr.is_null() || len == 0 {
+                return 0;
+            }
+            let data = core::slice::from_raw_parts(ptr, len);
+            xor_reduce_impl::<$lanes>(data)
+        }
+    };
+}
+export_xor_reduce!(xor_reduce_u8_16, 16);
+export_xor_reduce!(xor_reduce_u8_32, 32);
+export_xor_reduce!(xor_reduce_u8_64, 64);
+
+// === Byte-class transitions =================================================
+
+#[inline(always)]
+unsafe fn count_class_transitions_impl<const L: usize>(data: &[u8], class: &[u8; 256]) -> usize
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    // `carry` holds the class of the byte preceding the current chunk; seeding
+    // it with the first byte's class makes position 0 compare equal.
+    let mut carry = class[data[0] as usize];
+    let mut count = 0usize;
+    let mut chunks = data.chunks_exact(L);
+    for chunk in &mut chunks {
+        let cur = lut_lookup(Simd::<u8, L>::from_slice(chunk), class);
+        let mut prev = cur.rotate_elements_right::<1>();
+        prev[0] = carry;
+        count += cur.simd_ne(prev).to_bitmask().count_ones() as usize;
+        carry = cur[L - 1];
+    }
+    for &b in chunks.remainder() {
+        let c = class[b as usize];
+        count += (c != carry) as usize;
+        carry = c;
+    }
+    count
+}
+
+/* ─── count_class_transitions exports via macro ────────────────────────── */
+macro_rules! export_count_class_transitions {
+    ($name:ident, $lanes:expr) => {
+        #[doc = concat!(
+            "Count positions `i > 0` where `table[src[i]] != table[src[i-1]]` using a ", stringify!($lanes), "-lane SIMD kernel.\n\n",
+            "# Safety\n",
+            "`src` must be valid for `len` bytes and `table` for 256 bytes."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(src: *const u8, len: usize, table: *const u8) -> usize {
+            if src.is_null() || table.is_null() || len == 0 {
+                return 0;
+            }
+            let data = core::slice::from_raw_parts(src, len);
+            let class = &*(table as *const [u8; 256]);
+            count_class_transitions_impl::<$lanes>(data, class)
+        }
+    };
+}
+export_count_class_transitions!(count_class_transitions_u8_16, 16);
+export_count_class_transitions!(count_class_transitions_u8_32, 32);
+export_count_class_transitions!(count_class_transitions_u8_64, 64);
+
+// === Sortedness check =======================================================
+
+#[inline(always)]
+unsafe fn is_sorted_u8_impl<const L: usize>(data: &[u8]) -> bool
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    // Compare every lane with its predecessor; lane 0's predecessor is the
+    // last byte of the previous chunk (or itself for the first chunk).
+    let mut carry = data[0];
+    let mut chunks = data.chunks_exact(L);
+    for chunk in &mut chunks {
+        let cur = Simd::<u8, L>::from_slice(chunk);
+        let mut prev = cur.rotate_elements_right::<1>();
+        prev[0] = carry;
+        if prev.simd_gt(cur).any() {
+            return false;
+        }
+        carry = cur[L - 1];
+    }
+    for &b in chunks.remainder() {
+        if carry > b {
+            return false;
+        }
+        carry = b;
+    }
+    true
+}
+
+/* ─── is_sorted exports via macro ──────────────────────────────────────── */
+macro_rules! export_is_sorted {
+    ($name:ident, $lanes:expr) => {
+        #[doc = concat!(
+            "Return 1 if the bytes are in non-decreasing order using a ", stringify!($lanes), "-lane SIMD kernel, 0 otherwise.\n\n",
+            "# Safety\n",
+            "`ptr` must be null or valid for `len` bytes."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(ptr: *const u8, len: usize) -> u8 {
+            if ptr.is_null() || len == 0 {
+                return 1;
+            }
+            let data = core::slice::from_raw_parts(ptr, len);
+            is_sorted_u8_impl::<$lanes>(data) as u8
+        }
+    };
+}
+export_is_sorted!(is_sorted_u8_16, 16);
+export_is_sorted!(is_sorted_u8_32, 32);
+export_is_sorted!(is_sorted_u8_64, 64);
+
+// === Fused CRC32C + byte sum ================================================
+
+/// Block size for the fused kernel: small enough that the sum pass re-reads
+/// each block from L1 right after the CRC pass loaded it, and small enough
+/// that a block sum (255 * 4096) never wraps the 32-bit `sum_u8_impl`.
+const CRC_SUM_BLOCK: usize = 4096;
+
+/// Update CRC32C (Castagnoli) with `len` bytes and store their widening byte
+/// sum in `*sum`, in one pass over memory.  Returns the updated CRC.
+///
+/// # Safety
+/// `ptr` must be null or valid for `len` bytes; `sum` must be valid for a
+/// `u64` write.
+#[unsafe(no_mangle)]
+pub unsafe extern "C" fn crc32_sum_u8(ptr: *const u8, len: usize, init: u32, sum: *mut u64) -> u32 {
+    if sum.is_null() {
+        return init;
+    }
+    *sum = 0;
+    if ptr.is_null() || len == 0 {
+        return init;
+    }
+    let data = core::slice::from_raw_parts(ptr, len);
+    let mut crc = init;
+    let mut total = 0u64;
+    for block in data.chunks(CRC_SUM_BLOCK) {
+        crc = crc32c_update(crc, block);
+        total += sum_u8_impl::<64>(block) as u64;
+    }
+    *sum = total;
+    crc
+}
+
+// === Base32 (RFC 4648 standard alphabet) ====================================
+
+const BASE32_ALPHABET: &[u8; 32] = b"ABCDEFGHIJKLMNOPQRSTUVWXYZ234567";
+
+/// Reverse lookup for `BASE32_ALPHABET`; 0xFF marks bytes outside it.
+const BASE32_DECODE: [u8; 256] = {
+    let mut t = [0xFFu8; 256];
+    let mut i = 0;
+    while i < 32 {
+        t[BASE32_ALPHABET[i] as usize] = i as u8;
+        i += 1;
+    }
+    t
+};
+
+/// Groups handled per SIMD step: one u64 lane holds one 40-bit group.
+const B32_GROUPS: usize = 8;
+
+#[inline(always)]
+fn base32_encode_group(src: &[u8], dst: &mut [u8]) {
+    let v = (src[0] as u64) << 32
+        | (src[1] as u64) << 24
+        | (src[2] as u64) << 16
+        | (src[3] as u64) << 8
+        | src[4] as u64;
+    for k in 0..8 {
+        dst[k] = BASE32_ALPHABET[((v >> (35 - 5 * k)) & 31) as usize];
+    }
+}
+
+/// Encode the `src.len() / 5` complete groups of `src`; returns bytes written.
+#[inline(always)]
+unsafe fn base32_encode_impl(src: &[u8], dst: &mut [u8]) -> usize {
+    let groups = src.len() / 5;
+    let stride5 = Simd::<usize, B32_GROUPS>::from_array(core::array::from_fn(|l| l * 5));
+    let stride8 = Simd::<usize, B32_GROUPS>::from_array(core::array::from_fn(|l| l * 8));
+    let mut g = 0;
+    while g + B32_GROUPS <= groups {
+        let block = &src[g * 5..(g + B32_GROUPS) * 5];
+        // Gather byte j of every group into lane order and pack each group
+        // into the low 40 bits of a u64 lane.
+        let mut v = Simd::<u64, B32_GROUPS>::splat(0);
+        for j in 0..5 {
+            let b: Simd<u64, B32_GROUPS> =
+                Simd::<u8, B32_GROUPS>::gather_or_default(block, stride5 + Simd::splat(j)).cast();
+            v |= b << Simd::splat(32 - 8 * j as u64);
+        }
+        // Peel off 5-bit index k of every group, map it through the alphabet
+        // and scatter it to output position k of each 8-char group.
+        let out = &mut dst[g * 8..(g + B32_GROUPS) * 8];
+        for k in 0..8 {
+            let idx: Simd<usize, B32_GROUPS> =
+                ((v >> Simd::splat(35 - 5 * k as u64)) & Simd::splat(31)).cast();
+            Simd::<u8, B32_GROUPS>::gather_or_default(BASE32_ALPHABET, idx)
+                .scatter(out, stride8 + Simd::splat(k));
+        }
+        g += B32_GROUPS;
+    }
+    for g in g..groups {
+        base32_encode_group(&src[g * 5..g * 5 + 5], &mut dst[g * 8..g * 8 + 8]);
+    }
+    groups * 8
+}
+
+#[inline(always)]
+fn base32_decode_group(src: &[u8], dst: &mut [u8]) -> bool {
+    let mut v = 0u64;
+    for &c in &src[..8] {
+        let d = BASE32_DECODE[c as usize];
+        if d == 0xFF {
+            return false;
+        }
+        v = v << 5 | d as u64;
+    }
+    for j in 0..5 {
+        dst[j] = (v >> (32 - 8 * j)) as u8;
+    }
+    true
+}
+
+/// Decode complete 8-char groups of `src`, stopping before the first group
+/// that holds a byte outside the alphabet; returns input bytes consumed.
+#[inline(always)]
+unsafe fn base32_decode_impl(src: &[u8], dst: &mut [u8]) -> usize {
+    let groups = src.len() / 8;
+    let stride5 = Simd::<usize, B32_GROUPS>::from_array(core::array::from_fn(|l| l * 5));
+    let stride8 = Simd::<usize, B32_GROUPS>::from_array(core::array::from_fn(|l| l * 8));
+    let mut g = 0;
+    while g + B32_GROUPS <= groups {
+        let block = &src[g * 8..(g + B32_GROUPS) * 8];
+        let idx: Simd<usize, 64> = Simd::<u8, 64>::from_slice(block).cast();
+        let vals = Simd::<u8, 64>::gather_or_default(&BASE32_DECODE, idx);
+        if vals.simd_eq(Simd::splat(0xFF)).any() {
+            break; // the scalar loop below pins down the bad group
+        }
+        let vals = vals.to_array();
+        let mut v = Simd::<u64, B32_GROUPS>::splat(0);
+        for k in 0..8 {
+            let d: Simd<u64, B32_GROUPS> =
+                Simd::<u8, B32_GROUPS>::gather_or_default(&vals, stride8 + Simd::splat(k)).cast();
+            v |= d << Simd::splat(35 - 5 * k as u64);
+        }
+        let out = &mut dst[g * 5..(g + B32_GROUPS) * 5];
+        for j in 0..5 {
+            let b: Simd<u8, B32_GROUPS> = (v >> Simd::splat(32 - 8 * j as u64)).cast();
+            b.scatter(out, stride5 + Simd::splat(j));
+        }
+        g += B32_GROUPS;
+    }
+    while g < groups {
+        if !base32_decode_group(&src[g * 8..g * 8 + 8], &mut dst[g * 5..g * 5 + 5]) {
+            break;
+        }
+        g += 1;
+    }
+    g * 8
+}
+
+/// Base32-encode the complete 5-byte groups of `src` (RFC 4648 alphabet, no
+/// padding) into `dst`.  Returns the number of bytes written (`len/5*8`).
+///
+/// # Safety
+/// `src` must be valid for `len` bytes and `dst` for `len/5*8` bytes.
+#[unsafe(no_mangle)]
+pub unsafe extern "C" fn base32_encode(src: *const u8, len: usize, dst: *mut u8) -> usize {
+    if src.is_null() || dst.is_null() || len < 5 {
+        return 0;
+    }
+    let src = core::slice::from_raw_parts(src, len);
+    let dst = core::slice::from_raw_parts_mut(dst, len / 5 * 8);
+    base32_encode_impl(src, dst)
+}
+
+/// Base32-decode the complete 8-char groups of `src` into `dst`, stopping
+/// before the first group containing a byte outside the RFC 4648 alphabet
+/// (padding included).  Returns the number of input bytes consumed, a
+/// multiple of 8; `consumed/8*5` bytes were written.
+///
+/// # Safety
+/// `src` must be valid for `len` bytes and `dst` for `len/8*5` bytes.
+#[unsafe(no_mangle)]
+pub unsafe extern "C" fn base32_decode(src: *const u8, len: usize, dst: *mut u8) -> usize {
+    if src.is_null() || dst.is_null() || len < 8 {
+        return 0;
+    }
+    let src = core::slice::from_raw_parts(src, len);
+    let dst = core::slice::from_raw_parts_mut(dst, len / 8 * 5);
+    base32_decode_impl(src, dst)
+}
+
+// === Luhn checksum ==========================================================
+
+/// Luhn doubling map: `2*d` with its digits summed.
+const LUHN_DOUBLED: [u8; 10] = [0, 2, 4, 6, 8, 1, 3, 5, 7, 9];
+
+#[inline(always)]
+unsafe fn luhn_impl<const L: usize>(data: &[u8]) -> bool
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    // Counting from the right, every second digit is doubled; that is the
+    // positions i with i % 2 == len % 2.  Chunks start at even offsets, so
+    // the doubled lanes are the same in every chunk.
+    let parity = data.len() & 1;
+    let lane = Simd::<usize, L>::from_array(core::array::from_fn(|l| l));
+    let doubled = (lane & Simd::splat(1)).simd_eq(Simd::splat(parity));
+
+    let mut acc = Simd::<u32, L>::splat(0);
+    let mut chunks = data.chunks_exact(L);
+    for chunk in &mut chunks {
+        let d = Simd::<u8, L>::from_slice(chunk) - Simd::splat(b'0');
+        if d.simd_gt(Simd::splat(9)).any() {
+            return false;
+        }
+        let dd = Simd::<u8, L>::gather_or_default(&LUHN_DOUBLED, d.cast());
+        acc += doubled.cast().select(dd, d).cast();
+    }
+    let mut sum = acc.reduce_sum();
+    let base = data.len() - chunks.remainder().len();
+    for (j, &b) in chunks.remainder().iter().enumerate() {
+        let d = b.wrapping_sub(b'0');
+        if d > 9 {
+            return false;
+        }
+        sum += if (base + j) & 1 == parity { LUHN_DOUBLED[d as usize] } else { d } as u32;
+    }
+    sum % 10 == 0
+}
+
+/* ─── luhn exports via macro ───────────────────────────────────────────── */
+macro_rules! export_luhn {
+    ($name:ident, $lanes:expr) => {
+        #[doc = concat!(
+            "Return 1 if the ASCII digits pass the Luhn (mod 10) check using a ", stringify!($lanes), "-lane SIMD kernel, 0 if they fail or contain a non-digit.\n\n",
+            "# Safety\n",
+            "`ptr` must be null or valid for `len` bytes."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(ptr: *const u8, len: usize) -> u8 {
+            if ptr.is_null() || len == 0 {
+                return 0;
+            }
+            let data = core::slice::from_raw_parts(ptr, len);
+            luhn_impl::<$lanes>(data) as u8
+        }
+    };
+}
+export_luhn!(luhn_u8_16, 16);
+export_luhn!(luhn_u8_32, 32);
+export_luhn!(luhn_u8_64, 64);
+
+// === Population count of combined bitmaps ===================================
+
+/// Chunks folded into the u32 lane accumulator before it is drained into the
+/// u64 total (8 bits per lane per chunk, far below u32 overflow).
+const POPCOUNT_FLUSH: usize = 1 << 20;
+
+#[inline(always)]
+unsafe fn popcount_op_impl<const L: usize>(
+    a: &[u8],
+    b: &[u8],
+    op: impl Fn(Simd<u8, L>, Simd<u8, L>) -> Simd<u8, L>,
+    scalar: impl Fn(u8, u8) -> u8,
+) -> u64
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    let mut total = 0u64;
+    let mut acc = Simd::<u32, L>::splat(0);
+    let mut ca = a.chunks_exact(L);
+    let mut cb = b.chunks_exact(L);
+    for (i, (x, y)) in (&mut ca).zip(&mut cb).enumerate() {
+        let v = op(Simd::from_slice(x), Simd::from_slice(y));
+        acc += v.count_ones().cast();
+        if i % POPCOUNT_FLUSH == POPCOUNT_FLUSH - 1 {
+            total += acc.reduce_sum() as u64;
+            acc = Simd::splat(0);
+        }
+    }
+    total += acc.reduce_sum() as u64;
+    for (&x, &y) in ca.remainder().iter().zip(cb.remainder()) {
+        total += scalar(x, y).count_ones() as u64;
+    }
+    total
+}
+
+/* ─── popcount_and / popcount_xor exports via macro ───────────────────── */
+macro_rules! export_popcount_op {
+    ($name:ident, $lanes:expr, $op:tt, $what:literal) => {
+        #[doc = concat!(
+            "Return the number of set bits in `a[i] ", $what, " b[i]` over both buffers using a ", stringify!($lanes), "-lane SIMD kernel.\n\n",
+            "# Safety\n",
+            "`a` and `b` must be null or valid for `len` bytes each."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(a: *const u8, b: *const u8, len: usize) -> u64 {
+            if a.is_null() || b.is_null() || len == 0 {
+                return 0;
+            }
+            let a = core::slice::from_raw_parts(a, len);
+            let b = core::slice::from_raw_parts(b, len);
+            popcount_op_impl::<$lanes>(a, b, |x, y| x $op y, |x, y| x $op y)
+        }
+    };
+}
+export_popcount_op!(popcount_and_u8_16, 16, &, "&");
+export_popcount_op!(popcount_and_u8_32, 32, &, "&");
+export_popcount_op!(popcount_and_u8_64, 64, &, "&");
+export_popcount_op!(popcount_xor_u8_16, 16, ^, "^");
+export_popcount_op!(popcount_xor_u8_32, 32, ^, "^");
+export_popcount_op!(popcount_xor_u8_64, 64, ^, "^");
+
+#[inline(always)]
+unsafe fn popcount_u8_impl<const L: usize>(data: &[u8]) -> u64
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    let mut total = 0u64;
+    let mut acc = Simd::<u32, L>::splat(0);
+    let mut chunks = data.chunks_exact(L);
+    for (i, c) in (&mut chunks).enumerate() {
+        acc += Simd::<u8, L>::from_slice(c).count_ones().cast();
+        if i % POPCOUNT_FLUSH == POPCOUNT_FLUSH - 1 {
+            total += acc.reduce_sum() as u64;
+            acc = Simd::splat(0);
+        }
+    }
+    total += acc.reduce_sum() as u64;
+    for &x in chunks.remainder() {
+        total += x.count_ones() as u64;
+    }
+    total
+}
+
+/* ─── popcount_u8 exports via macro ───────────────────────────────────── */
+macro_rules! export_popcount {
+    ($name:ident, $lanes:expr) => {
+        #[doc = concat!(
+            "Return the number of set bits in `ptr[0..len]` using a ", stringify!($lanes), "-lane SIMD kernel.\n\n",
+            "# Safety\n",
+            "`ptr` must be null or valid for `len` bytes."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(ptr: *const u8, len: usize) -> u64 {
+            if ptr.is_null() || len == 0 {
+                return 0;
+            }
+            let data = core::slice::from_raw_parts(ptr, len);
+            popcount_u8_impl::<$lanes>(data)
+        }
+    };
+}
+export_popcount!(popcount_u8_16, 16);
+export_popcount!(popcount_u8_32, 32);
+export_popcount!(popcount_u8_64, 64);
+
+// === Fletcher checksums =====================================================
+
+/// Fold a run of values into a Fletcher state `(s1, s2)` modulo `m`.
+///
+/// Whole `L`-value chunks are processed in blocks of at most `block` chunks.
+/// Per lane, `s1v` accumulates the values and `p` accumulates `s1v` as it
+/// stood before each chunk, so over a block of `k` chunks
+///
+/// ```text
+/// s1 += sum(s1v)
+/// s2 += k*L*s1 + L*sum(p) + sum((L - j) * s1v[j])
+/// ```
+///
+/// Only the first `full` values may be loaded through `vals`; the rest go
+/// through `scalar`.  `block` must keep every `p` lane below 2^32: `max * k * (k - 1) / 2`
+/// where `max` is the largest value.  The block sums are folded into the
+/// state with u64 arithmetic and reduced, then the tail is summed serially.
+#[inline(always)]
+fn fletcher_impl<const L: usize>(
+    vals: impl Fn(usize) -> Simd<u32, L>,
+    scalar: impl Fn(usize) -> u32,
+    n: usize,
+    full: usize,
+    block: usize,
+    m: u64,
+) -> (u64, u64)
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    let weights = Simd::<u64, L>::from_array(core::array::from_fn(|j| (L - j) as u64));
+    let (mut s1, mut s2) = (0u64, 0u64);
+    let chunks = full / L;
+    let mut c = 0;
+    while c < chunks {
+        let k = block.min(chunks - c);
+        let mut s1v = Simd::<u32, L>::splat(0);
+        let mut p = Simd::<u32, L>::splat(0);
+        for i in c..c + k {
+            p += s1v;
+            s1v += vals(i * L);
+        }
+        let lanes = s1v.cast::<u64>();
+        s2 += (k * L) as u64 * s1
+            + L as u64 * p.cast::<u64>().reduce_sum()
+            + (lanes * weights).reduce_sum();
+        s1 += lanes.reduce_sum();
+        s1 %= m;
+        s2 %= m;
+        c += k;
+    }
+    for i in chunks * L..n {
+        s1 = (s1 + scalar(i) as u64) % m;
+        s2 = (s2 + s1) % m;
+    }
+    (s1, s2)
+}
+
+/// Bytes: 255 * 4096 * 4095 / 2 < 2^32.
+const FLETCHER16_BLOCK: usize = 4096;
+/// 16-bit words: 65535 * 256 * 255 / 2 < 2^32.
+const FLETCHER32_BLOCK: usize = 256;
+
+/* ─── fletcher16 / fletcher32 exports via macro ──────────────────────── */
+macro_rules! export_fletcher {
+    ($f16:ident, $f32:ident, $lanes:expr) => {
+        #[doc = concat!(
+            "Return the Fletcher-16 checksum of `len` bytes (`s2 << 8 | s1`, both sums modulo 255) using a ", stringify!($lanes), "-lane SIMD kernel.\n\n",
+            "# Safety\n",
+            "`ptr` must be null or valid for `len` bytes."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $f16(ptr: *const u8, len: usize) -> u32 {
+            if ptr.is_null() || len == 0 {
+                return 0;
+            }
+            let data = core::slice::from_raw_parts(ptr, len);
+            let (s1, s2) = fletcher_impl::<$lanes>(
+                |i| Simd::<u8, $lanes>::from_slice(&data[i..]).cast(),
+                |i| data[i] as u32,
+                len,
+                len,
+                FLETCHER16_BLOCK,
+                255,
+            );
+            (s2 << 8 | s1) as u32
+        }
+
+        #[doc = concat!(
+            "Return the Fletcher-32 checksum of `len` bytes read as little-endian 16-bit words (`s2 << 16 | s1`, both sums modulo 65535) using a ", stringify!($lanes), "-lane SIMD kernel.  An odd trailing byte is zero-padded.\n\n",
+            "# Safety\n",
+            "`ptr` must be null or valid for `len` bytes."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $f32(ptr: *const u8, len: usize) -> u32 {
+            if ptr.is_null() || len == 0 {
+                return 0;
+            }
+            let data = core::slice::from_raw_parts(ptr, len);
+            let word = |i: usize| {
+                let hi = if 2 * i + 1 < len { data[2 * i + 1] } else { 0 };
+                u16::from_le_bytes([data[2 * i], hi]) as u32
+            };
+            let (s1, s2) = fletcher_impl::<$lanes>(
+                |i| {
+                    let raw: [u16; $lanes] =
+                        core::ptr::read_unaligned(data[2 * i..].as_ptr() as *const [u16; $lanes]);
+                    Simd::from_array(raw.map(u16::from_le)).cast()
+                },
+                word,
+                len.div_ceil(2),
+                len / 2,
+                FLETCHER32_BLOCK,
+                65535,
+            );
+            (s2 << 16 | s1) as u32
+        }
+    };
+}
+export_fletcher!(fletcher16_u8_16, fletcher32_u16_16, 16);
+export_fletcher!(fletcher16_u8_32, fletcher32_u16_32, 32);
+// The 64-lane entry points run the 32-lane loop: 64 lanes of `s1v` and `p`
+// alone are 512 bytes, which spill to the stack on 128-bit targets and
+// overrun what a kernel may use of the goroutine stack.
+export_fletcher!(fletcher16_u8_64, fletcher32_u16_64, 32);
+
+// === Byte occurrence count ==================================================
+
+/// Count bytes equal to `needle`.  Matches are accumulated in u8 lanes (each
+/// chunk adds at most 1 per lane) and drained into the u64 total every 255
+/// chunks, before any lane can wrap.
+#[inline(always)]
+fn count_u8_impl<const L: usize>(data: &[u8], needle: u8) -> u64
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    let splat = Simd::<u8, L>::splat(needle);
+    let one = Simd::<u8, L>::splat(1);
+    let zero = Simd::<u8, L>::splat(0);
+    let mut total = 0u64;
+    let mut chunks = data.chunks_exact(L);
+    loop {
+        let mut acc = zero;
+        let mut k = 0;
+        for chunk in (&mut chunks).take(255) {
+            acc += Simd::<u8, L>::from_slice(chunk).simd_eq(splat).select(one, zero);
+            k += 1;
+        }
+        total += acc.cast::<u32>().reduce_sum() as u64;
+        if k < 255 {
+            break;
+        }
+    }
+    total + chunks.remainder().iter().filter(|&&b| b == needle).count() as u64
+}
+
+/* ─── count_u8 exports via macro ───────────────────────────────────────── */
+macro_rules! export_count_u8 {
+    ($name:ident, $lanes:expr) => {
+        #[doc = concat!(
+            "Return the number of bytes equal to `needle` using a ", stringify!($lanes), "-lane SIMD kernel.\n\n",
+            "# Safety\n",
+            "`ptr` must be null or valid for `len` bytes."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(ptr: *const u8, len: usize, needle: u8) -> u64 {
+            if ptr.is_null() || len == 0 {
+                return 0;
+            }
+            let data = core::slice::from_raw_parts(ptr, len);
+            count_u8_impl::<$lanes>(data, needle)
+        }
+    };
+}
+export_count_u8!(count_u8_16, 16);
+export_count_u8!(count_u8_32, 32);
+export_count_u8!(count_u8_64, 64);
+
+// === Wide sums of u16 / u32 =================================================
+
+/// Chunks of u16 values summed into u32 lanes before draining into the u64
+/// total: 65535 * 65536 < 2^32.
+const SUM_U16_FLUSH: usize = 1 << 16;
+
+#[inline(always)]
+fn sum_u16_impl<const L: usize>(data: &[u16]) -> u64
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    let mut total = 0u64;
+    let mut chunks = data.chunks_exact(L);
+    loop {
+        let mut acc = Simd::<u32, L>::splat(0);
+        let mut k = 0;
+        for chunk in (&mut chunks).take(SUM_U16_FLUSH) {
+            acc += Simd::<u16, L>::from_slice(chunk).cast();
+            k += 1;
+        }
+        total += acc.cast::<u64>().reduce_sum();
+        if k < SUM_U16_FLUSH {
+            break;
+        }
+    }
+    total + chunks.remainder().iter().map(|&v| v as u64).sum::<u64>()
+}
+
+/// u32 values are widened straight into u64 lanes, which cannot overflow
+/// for any addressable input.
+#[inline(always)]
+fn sum_u32_impl<const L: usize>(data: &[u32]) -> u64
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    let mut acc = Simd::<u64, L>::splat(0);
+    let mut chunks = data.chunks_exact(L);
+    for chunk in &mut chunks {
+        acc += Simd::<u32, L>::from_slice(chunk).cast();
+    }
+    acc.reduce_sum() + chunks.remainder().iter().map(|&v| v as u64).sum::<u64>()
+}
+
+/* ─── sum_u16 / sum_u32 exports via macro ──────────────────────────────── */
+macro_rules! export_sum_wide {
+    ($name:ident, $ty:ty, $impl:ident, $lanes:expr) => {
+        #[doc = concat!(
+            "Return the sum of `len` ", stringify!($ty), " values, without wrapping, using a ", stringify!($lanes), "-lane SIMD kernel.\n\n",
+            "# Safety\n",
+            "`ptr` must be null or valid for `len` ", stringify!($ty), " elements."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(ptr: *const $ty, len: usize) -> u64 {
+            if ptr.is_null() || len == 0 {
+                return 0;
+            }
+            let data = core::slice::from_raw_parts(ptr, len);
+            $impl::<$lanes>(data)
+        }
+    };
+}
+export_sum_wide!(sum_u16_16, u16, sum_u16_impl, 16);
+export_sum_wide!(sum_u16_32, u16, sum_u16_impl, 32);
+export_sum_wide!(sum_u16_64, u16, sum_u16_impl, 64);
+export_sum_wide!(sum_u32_16, u32, sum_u32_impl, 16);
+export_sum_wide!(sum_u32_32, u32, sum_u32_impl, 32);
+export_sum_wide!(sum_u32_64, u32, sum_u32_impl, 64);
+
+// === Dot products ===========================================================
+
+/// Chunks of u8 products summed into u32 lanes before draining into the u64
+/// total: 255 * 255 * 65536 < 2^32.
+const DOT_U8_FLUSH: usize = 1 << 16;
+
+#[inline(always)]
+fn dot_u8_impl<const L: usize>(a: &[u8], b: &[u8]) -> u64
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    let mut total = 0u64;
+    let mut ca = a.chunks_exact(L);
+    let mut cb = b.chunks_exact(L);
+    loop {
+        let mut acc = Simd::<u32, L>::splat(0);
+        let mut k = 0;
+        for (x, y) in (&mut ca).zip(&mut cb).take(DOT_U8_FLUSH) {
+            let x: Simd<u32, L> = Simd::<u8, L>::from_slice(x).cast();
+            let y: Simd<u32, L> = Simd::<u8, L>::from_slice(y).cast();
+            acc += x * y;
+            k += 1;
+        }
+        total += acc.cast::<u64>().reduce_sum();
+        if k < DOT_U8_FLUSH {
+            break;
+        }
+    }
+    let tail: u64 = ca
+        .remainder()
+        .iter()
+        .zip(cb.remainder())
+        .map(|(&x, &y)| x as u64 * y as u64)
+        .sum();
+    total + tail
+}
+
+/// Products are formed and accumulated in f64 lanes, so the result carries
+/// no float32 rounding beyond that of the inputs themselves.
+#[inline(always)]
+fn dot_f32_impl<const L: usize>(a: &[f32], b: &[f32]) -> f64
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    let mut acc = Simd::<f64, L>::splat(0.0);
+    let mut ca = a.chunks_exact(L);
+    let mut cb = b.chunks_exact(L);
+    for (x, y) in (&mut ca).zip(&mut cb) {
+        let x: Simd<f64, L> = Simd::<f32, L>::from_slice(x).cast();
+        let y: Simd<f64, L> = Simd::<f32, L>::from_slice(y).cast();
+        acc += x * y;
+    }
+    let tail: f64 = ca
+        .remainder()
+        .iter()
+        .zip(cb.remainder())
+        .map(|(&x, &y)| x as f64 * y as f64)
+        .sum();
+    acc.reduce_sum() + tail
+}
+
+/* ─── dot_u8 / dot_f32 exports via macro ───────────────────────────────── */
+macro_rules! export_dot {
+    ($name:ident, $ty:ty, $ret:ty, $impl:ident, $lanes:expr) => {
+        #[doc = concat!(
+            "Return the dot product of two ", stringify!($ty), " slices of `len` elements as ",
+            stringify!($ret), " using a ", stringify!($lanes), "-lane SIMD kernel.\n\n",
+            "# Safety\n",
+            "`a` and `b` must be null or valid for `len` ", stringify!($ty), " elements."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(a: *const $ty, b: *const $ty, len: usize) -> $ret {
+            if a.is_null() || b.is_null() || len == 0 {
+                return Default::default();
+            }
+            let a = core::slice::from_raw_parts(a, len);
+            let b = core::slice::from_raw_parts(b, len);
+            $impl::<$lanes>(a, b)
+        }
+    };
+}
+export_dot!(dot_u8_16, u8, u64, dot_u8_impl, 16);
+export_dot!(dot_u8_32, u8, u64, dot_u8_impl, 32);
+export_dot!(dot_u8_64, u8, u64, dot_u8_impl, 64);
+export_dot!(dot_f32_16, f32, f64, dot_f32_impl, 16);
+export_dot!(dot_f32_32, f32, f64, dot_f32_impl, 32);
+export_dot!(dot_f32_64, f32, f64, dot_f32_impl, 64);
+
+// === Byte min / max =========================================================
+
+#[inline(always)]
+fn minmax_u8_impl<const L: usize, const MAX: bool>(data: &[u8]) -> u8
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    let identity = if MAX { u8::MIN } else { u8::MAX };
+    let mut acc = Simd::<u8, L>::splat(identity);
+    let mut chunks = data.chunks_exact(L);
+    for chunk in &mut chunks {
+        let v = Simd::<u8, L>::from_slice(chunk);
+        acc = if MAX { acc.simd_max(v) } else { acc.simd_min(v) };
+    }
+    let mut r = if MAX { acc.reduce_max() } else { acc.reduce_min() };
+    for &b in chunks.remainder() {
+        r = if MAX { r.max(b) } else { r.min(b) };
+    }
+    r
+}
+
+/* ─── min_u8 / max_u8 exports via macro ────────────────────────────────── */
+macro_rules! export_minmax_u8 {
+    ($name:ident, $lanes:expr, $max:expr, $what:literal, $empty:literal) => {
+        #[doc = concat!(
+            "Return the ", $what, " of `len` bytes using a ", stringify!($lanes), "-lane SIMD kernel; an empty input yields ", $empty, ".\n\n",
+            "# Safety\n",
+            "`ptr` must be null or valid for `len` bytes."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(ptr: *const u8, len: usize) -> u8 {
+            if ptr.is_null() || len == 0 {
+                return if $max { u8::MIN } else { u8::MAX };
+            }
+            let data = core::slice::from_raw_parts(ptr, len);
+            minmax_u8_impl::<$lanes, $max>(data)
+        }
+    };
+}
+export_minmax_u8!(min_u8_16, 16, false, "minimum", "0xFF");
+export_minmax_u8!(min_u8_32, 32, false, "minimum", "0xFF");
+export_minmax_u8!(min_u8_64, 64, false, "minimum", "0xFF");
+export_minmax_u8!(max_u8_16, 16, true, "maximum", "0");
+export_minmax_u8!(max_u8_32, 32, true, "maximum", "0");
+export_minmax_u8!(max_u8_64, 64, true, "maximum", "0");
+
+// === Element-wise binary byte ops ===========================================
+
+/// Compute `dst[i] = op(a[i], b[i])` for `len` bytes.  Every chunk is loaded
+/// from both inputs before it is stored, and all accesses go through raw
+/// pointers, so `dst` may alias `a` or `b`.
+#[inline(always)]
+unsafe fn binop_u8_impl<const L: usize>(
+    a: *const u8,
+    b: *const u8,
+    len: usize,
+    dst: *mut u8,
+    op: impl Fn(Simd<u8, L>, Simd<u8, L>) -> Simd<u8, L>,
+    scalar: impl Fn(u8, u8) -> u8,
+) where
+    LaneCount<L>: SupportedLaneCount,
+{
+    let full = len - len % L;
+    let mut i = 0;
+    while i < full {
+        let x = core::ptr::read_unaligned(a.add(i) as *const Simd<u8, L>);
+        let y = core::ptr::read_unaligned(b.add(i) as *const Simd<u8, L>);
+        core::ptr::write_unaligned(dst.add(i) as *mut Simd<u8, L>, op(x, y));
+        i += L;
+    }
+    for i in full..len {
+        *dst.add(i) = scalar(*a.add(i), *b.add(i));
+    }
+}
+
+/* ─── xor_u8 / and_u8 / or_u8 exports via macro ────────────────────────── */
+macro_rules! export_binop_u8 {
+    ($name:ident, $lanes:expr, $op:tt, $what:literal) => {
+        #[doc = concat!(
+            "Write `a[i] ", $what, " b[i]` to `dst[i]` for `len` bytes using a ", stringify!($lanes), "-lane SIMD kernel.\n\n",
+            "# Safety\n",
+            "All pointers must be null or valid for `len` bytes. `dst` may alias `a` or `b`."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(a: *const u8, b: *const u8, len: usize, dst: *mut u8) {
+            if len == 0 || a.is_null() || b.is_null() || dst.is_null() {
+                return;
+            }
+            binop_u8_impl::<$lanes>(a, b, len, dst, |x, y| x $op y, |x, y| x $op y);
+        }
+    };
+}
+export_binop_u8!(xor_u8_16, 16, ^, "^");
+export_binop_u8!(xor_u8_32, 32, ^, "^");
+export_binop_u8!(xor_u8_64, 64, ^, "^");
+export_binop_u8!(and_u8_16, 16, &, "&");
+export_binop_u8!(and_u8_32, 32, &, "&");
+export_binop_u8!(and_u8_64, 64, &, "&");
+export_binop_u8!(or_u8_16, 16, |, "|");
+export_binop_u8!(or_u8_32, 32, |, "|");
+export_binop_u8!(or_u8_64, 64, |, "|");
+
+/* ─── add_u8_sat / add_u8_wrap exports via macro ───────────────────────── */
+macro_rules! export_add_u8 {
+    ($name:ident, $lanes:expr, $simd:expr, $scalar:expr, $what:literal) => {
+        #[doc = concat!(
+            "Write the ", $what, " sum `a[i] + b[i]` to `dst[i]` for `len` bytes using a ",
+            stringify!($lanes), "-lane SIMD kernel.\n\n",
+            "# Safety\n",
+            "All pointers must be null or valid for `len` bytes. `dst` may alias `a` or `b`."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(a: *const u8, b: *const u8, len: usize, dst: *mut u8) {
+            if len == 0 || a.is_null() || b.is_null() || dst.is_null() {
+                return;
+            }
+            binop_u8_impl::<$lanes>(a, b, len, dst, $simd, $scalar);
+        }
+    };
+}
+export_add_u8!(add_u8_sat_16, 16, |x, y| x.saturating_add(y), u8::saturating_add, "saturating");
+export_add_u8!(add_u8_sat_32, 32, |x, y| x.saturating_add(y), u8::saturating_add, "saturating");
+export_add_u8!(add_u8_sat_64, 64, |x, y| x.saturating_add(y), u8::saturating_add, "saturating");
+export_add_u8!(add_u8_wrap_16, 16, |x, y| x + y, u8::wrapping_add, "wrapping");
+export_add_u8!(add_u8_wrap_32, 32, |x, y| x + y, u8::wrapping_add, "wrapping");
+export_add_u8!(add_u8_wrap_64, 64, |x, y| x + y, u8::wrapping_add, "wrapping");
+
+// === First differing byte ===================================================
+
+#[inline(always)]
+fn index_diff_u8_impl<const L: usize>(a: &[u8], b: &[u8]) -> usize
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    let mut ca = a.chunks_exact(L);
+    let mut cb = b.chunks_exact(L);
+    let mut base = 0usize;
+    for (x, y) in (&mut ca).zip(&mut cb) {
+        let mask = Simd::<u8, L>::from_slice(x)
+            .simd_ne(Simd::<u8, L>::from_slice(y))
+            .to_bitmask();
+        if mask != 0 {
+            return base + mask.trailing_zeros() as usize;
+        }
+        base += L;
+    }
+    for (i, (x, y)) in ca.remainder().iter().zip(cb.remainder()).enumerate() {
+        if x != y {
+            return base + i;
+        }
+    }
+    a.len()
+}
+
+/* ─── index_diff_u8 exports via macro ──────────────────────────────────── */
+macro_rules! export_index_diff_u8 {
+    ($name:ident, $lanes:expr) => {
+        #[doc = concat!(
+            "Return the offset of the first `i` with `a[i] != b[i]` using a ", stringify!($lanes), "-lane SIMD kernel, or `len` if the buffers are equal.\n\n",
+            "# Safety\n",
+            "`a` and `b` must be null or valid for `len` bytes each."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(a: *const u8, b: *const u8, len: usize) -> usize {
+            if a.is_null() || b.is_null() || len == 0 {
+                return len;
+            }
+            let a = core::slice::from_raw_parts(a, len);
+            let b = core::slice::from_raw_parts(b, len);
+            index_diff_u8_impl::<$lanes>(a, b)
+        }
+    };
+}
+export_index_diff_u8!(index_diff_u8_16, 16);
+export_index_diff_u8!(index_diff_u8_32, 32);
+export_index_diff_u8!(index_diff_u8_64, 64);
+
+/// Fold ASCII upper-case letters to lower case, leaving every other byte,
+/// including those >= 0x80, unchanged.
+#[inline(always)]
+fn fold_ascii<const L: usize>(v: Simd<u8, L>) -> Simd<u8, L>
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    let upper = v.simd_ge(Simd::splat(b'A')) & v.simd_le(Simd::splat(b'Z'));
+    upper.select(v | Simd::splat(0x20), v)
+}
+
+#[inline(always)]
+fn index_diff_fold_u8_impl<const L: usize>(a: &[u8], b: &[u8]) -> usize
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    let mut ca = a.chunks_exact(L);
+    let mut cb = b.chunks_exact(L);
+    let mut base = 0usize;
+    for (x, y) in (&mut ca).zip(&mut cb) {
+        let mask = fold_ascii(Simd::<u8, L>::from_slice(x))
+            .simd_ne(fold_ascii(Simd::<u8, L>::from_slice(y)))
+            .to_bitmask();
+        if mask != 0 {
+            return base + mask.trailing_zeros() as usize;
+        }
+        base += L;
+    }
+    for (i, (x, y)) in ca.remainder().iter().zip(cb.remainder()).enumerate() {
+        if x.to_ascii_lowercase() != y.to_ascii_lowercase() {
+            return base + i;
+        }
+    }
+    a.len()
+}
+
+/* ─── index_diff_fold_u8 exports via macro ─────────────────────────────── */
+macro_rules! export_index_diff_fold_u8 {
+    ($name:ident, $lanes:expr) => {
+        #[doc = concat!(
+            "Return the offset of the first `i` at which `a[i]` and `b[i]` differ after folding ASCII letters to lower case, using a ", stringify!($lanes), "-lane SIMD kernel, or `len` if the buffers are equal up to ASCII case.\n\n",
+            "# Safety\n",
+            "`a` and `b` must be null or valid for `len` bytes each."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(a: *const u8, b: *const u8, len: usize) -> usize {
+            if a.is_null() || b.is_null() || len == 0 {
+                return len;
+            }
+            let a = core::slice::from_raw_parts(a, len);
+            let b = core::slice::from_raw_parts(b, len);
+            index_diff_fold_u8_impl::<$lanes>(a, b)
+        }
+    };
+}
+export_index_diff_fold_u8!(index_diff_fold_u8_16, 16);
+export_index_diff_fold_u8!(index_diff_fold_u8_32, 32);
+export_index_diff_fold_u8!(index_diff_fold_u8_64, 64);
+
+// === Hex encode / decode ====================================================
+
+const HEX_DIGITS: [u8; 16] = *b"0123456789abcdef";
+
+/// Encode every byte of `src` as two lower-case hex digits.  Each 16-byte
+/// chunk is split into high and low nibbles, both are mapped through the
+/// digit table with a byte shuffle, and the two digit vectors are interleaved
+/// into 32 output bytes.
+#[inline(always)]
+fn hex_encode_impl(src: &[u8], dst: &mut [u8]) {
+    let digits = Simd::<u8, 16>::from_array(HEX_DIGITS);
+    let mut chunks = src.chunks_exact(16);
+    let mut out = dst.chunks_exact_mut(32);
+    for (chunk, o) in (&mut chunks).zip(&mut out) {
+        let v = Simd::<u8, 16>::from_slice(chunk);
+        let hi = digits.swizzle_dyn(v >> Simd::splat(4));
+        let lo = digits.swizzle_dyn(v & Simd::splat(15));
+        let (first, second) = hi.interleave(lo);
+        o[..16].copy_from_slice(first.as_array());
+        o[16..].copy_from_slice(second.as_array());
+    }
+    let done = src.len() - chunks.remainder().len();
+    for (i, &b) in chunks.remainder().iter().enumerate() {
+        dst[2 * (done + i)] = HEX_DIGITS[(b >> 4) as usize];
+        dst[2 * (done + i) + 1] = HEX_DIGITS[(b & 15) as usize];
+    }
+}
+
+/// Map hex digits (either case) to their values; the mask flags the lanes
+/// that held a valid digit.
+#[inline(always)]
+fn hex_digit_values(c: Simd<u8, 16>) -> (Simd<u8, 16>, core::simd::Mask<i8, 16>) {
+    let d = c - Simd::splat(b'0');
+    let is_digit = d.simd_lt(Simd::splat(10));
+    let l = (c | Simd::splat(0x20)) - Simd::splat(b'a');
+    let is_letter = l.simd_lt(Simd::splat(6));
+    (is_digit.select(d, l + Simd::splat(10)), is_digit | is_letter)
+}
+
+#[inline(always)]
+fn hex_digit_value(c: u8) -> Option<u8> {
+    match c {
+        b'0'..=b'9' => Some(c - b'0'),
+        b'a'..=b'f' => Some(c - b'a' + 10),
+        b'A'..=b'F' => Some(c - b'A' + 10),
+        _ => None,
+    }
+}
+
+/// Decode complete digit pairs of `src`, stopping before the first pair that
+/// holds a non-hex byte; returns input bytes consumed.  32-byte chunks are
+/// deinterleaved into high and low digit vectors, validated as a whole and
+/// packed into 16 output bytes.
+#[inline(always)]
+fn hex_decode_impl(src: &[u8], dst: &mut [u8]) -> usize {
+    let pairs = src.len() / 2;
+    let mut p = 0;
+    while p + 16 <= pairs {
+        let a = Simd::<u8, 16>::from_slice(&src[2 * p..]);
+        let b = Simd::<u8, 16>::from_slice(&src[2 * p + 16..]);
+        let (hi, lo) = a.deinterleave(b);
+        let (hv, hok) = hex_digit_values(hi);
+        let (lv, lok) = hex_digit_values(lo);
+        if !(hok & lok).all() {
+            break; // the scalar loop below pins down the bad pair
+        }
+        dst[p..p + 16].copy_from_slice((hv << Simd::splat(4) | lv).as_array());
+        p += 16;
+    }
+    while p < pairs {
+        match (hex_digit_value(src[2 * p]), hex_digit_value(src[2 * p + 1])) {
+            (Some(h), Some(l)) => dst[p] = h << 4 | l,
+            _ => break,
+        }
+        p += 1;
+    }
+    2 * p
+}
+
+/// Hex-encode `len` bytes of `src` (lower-case digits) into `dst`.  Returns
+/// the number of bytes written (`2*len`).
+///
+/// # Safety
+/// `src` must be valid for `len` bytes and `dst` for `2*len` bytes; they must
+/// not overlap.
+#[unsafe(no_mangle)]
+pub unsafe extern "C" fn hex_encode(src: *const u8, len: usize, dst: *mut u8) -> usize {
+    if src.is_null() || dst.is_null() || len == 0 {
+        return 0;
+    }
+    let src = core::slice::from_raw_parts(src, len);
+    let dst = core::slice::from_raw_parts_mut(dst, 2 * len);
+    hex_encode_impl(src, dst);
+    2 * len
+}
+
+/// Hex-decode the complete digit pairs of `src` into `dst`, stopping before
+/// the first pair containing a byte that is not a hex digit (either case).
+/// Returns the number of input bytes consumed, an even number;
+/// `consumed/2` bytes were written.
+///
+/// # Safety
+/// `src` must be valid for `len` bytes and `dst` for `len/2` bytes; they must
+/// not overlap.
+#[unsafe(no_mangle)]
+pub unsafe extern "C" fn hex_decode(src: *const u8, len: usize, dst: *mut u8) -> usize {
+    if src.is_null() || dst.is_null() || len < 2 {
+        return 0;
+    }
+    let src = core::slice::from_raw_parts(src, len);
+    let dst = core::slice::from_raw_parts_mut(dst, len / 2);
+    hex_decode_impl(src, dst)
+}
+
+// === Base64 =================================================================
+
+/// RFC 4648 standard and URL-safe base64 alphabets.
+const BASE64_STD: &[u8; 64] = b"ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/";
+const BASE64_URL: &[u8; 64] = b"ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_";
+
+/// Reverse lookup for a base64 alphabet; 0xFF marks bytes outside it.
+const fn base64_decode_table(alphabet: &[u8; 64]) -> [u8; 256] {
+    let mut t = [0xFFu8; 256];
+    let mut i = 0;
+    while i < 64 {
+        t[alphabet[i] as usize] = i as u8;
+        i += 1;
+    }
+    t
+}
+
+const BASE64_STD_DECODE: [u8; 256] = base64_decode_table(BASE64_STD);
+const BASE64_URL_DECODE: [u8; 256] = base64_decode_table(BASE64_URL);
+
+/// Groups handled per SIMD step: one u32 lane holds one 24-bit group.
+const B64_GROUPS: usize = 16;
+
+#[inline(always)]
+fn base64_encode_group(src: &[u8], dst: &mut [u8], alphabet: &[u8; 64]) {
+    let v = (src[0] as u32) << 16 | (src[1] as u32) << 8 | src[2] as u32;
+    for k in 0..4 {
+        dst[k] = alphabet[((v >> (18 - 6 * k)) & 63) as usize];
+    }
+}
+
+/// Encode the `src.len() / 3` complete groups of `src`; returns bytes written.
+#[inline(always)]
+unsafe fn base64_encode_impl(src: &[u8], dst: &mut [u8], alphabet: &[u8; 64]) -> usize {
+    let groups = src.len() / 3;
+    let stride3 = Simd::<usize, B64_GROUPS>::from_array(core::array::from_fn(|l| l * 3));
+    let stride4 = Simd::<usize, B64_GROUPS>::from_array(core::array::from_fn(|l| l * 4));
+    let mut g = 0;
+    while g + B64_GROUPS <= groups {
+        let block = &src[g * 3..(g + B64_GROUPS) * 3];
+        // Gather byte j of every group into lane order and pack each group
+        // into the low 24 bits of a u32 lane.
+        let mut v = Simd::<u32, B64_GROUPS>::splat(0);
+        for j in 0..3 {
+            let b: Simd<u32, B64_GROUPS> =
+                Simd::<u8, B64_GROUPS>::gather_or_default(block, stride3 + Simd::splat(j)).cast();
+            v |= b << Simd::splat(16 - 8 * j as u32);
+        }
+        // Peel off 6-bit index k of every group, map it through the alphabet
+        // and scatter it to output position k of each 4-char group.
+        let out = &mut dst[g * 4..(g + B64_GROUPS) * 4];
+        for k in 0..4 {
+            let idx: Simd<usize, B64_GROUPS> =
+                ((v >> Simd::splat(18 - 6 * k as u32)) & Simd::splat(63)).cast();
+            Simd::<u8, B64_GROUPS>::gather_or_default(alphabet, idx)
+                .scatter(out, stride4 + Simd::splat(k));
+        }
+        g += B64_GROUPS;
+    }
+    for g in g..groups {
+        base64_encode_group(&src[g * 3..g * 3 + 3], &mut dst[g * 4..g * 4 + 4], alphabet);
+    }
+    groups * 4
+}
+
+#[inline(always)]
+fn base64_decode_group(src: &[u8], dst: &mut [u8], table: &[u8; 256]) -> bool {
+    let mut v = 0u32;
+    for &c in &src[..4] {
+        let d = table[c as usize];
+        if d == 0xFF {
+            return false;
+        }
+        v = v << 6 | d as u32;
+    }
+    for j in 0..3 {
+        dst[j] = (v >> (16 - 8 * j)) as u8;
+    }
+    true
+}
+
+/// Decode complete 4-char groups of `src`, stopping before the first group
+/// that holds a byte outside the alphabet; returns input bytes consumed.
+#[inline(always)]
+unsafe fn base64_decode_impl(src: &[u8], dst: &mut [u8], table: &[u8; 256]) -> usize {
+    let groups = src.len() / 4;
+    let stride3 = Simd::<usize, B64_GROUPS>::from_array(core::array::from_fn(|l| l * 3));
+    let stride4 = Simd::<usize, B64_GROUPS>::from_array(core::array::from_fn(|l| l * 4));
+    let mut g = 0;
+    while g + B64_GROUPS <= groups {
+        let block = &src[g * 4..(g + B64_GROUPS) * 4];
+        let idx: Simd<usize, 64> = Simd::<u8, 64>::from_slice(block).cast();
+        let vals = Simd::<u8, 64>::gather_or_default(table, idx);
+        if vals.simd_eq(Simd::splat(0xFF)).any() {
+            break; // the scalar loop below pins down the bad group
+        }
+        let vals = vals.to_array();
+        let mut v = Simd::<u32, B64_GROUPS>::splat(0);
+        for k in 0..4 {
+            let d: Simd<u32, B64_GROUPS> =
+                Simd::<u8, B64_GROUPS>::gather_or_default(&vals, stride4 + Simd::splat(k)).cast();
+            v |= d << Simd::splat(18 - 6 * k as u32);
+        }
+        let out = &mut dst[g * 3..(g + B64_GROUPS) * 3];
+        for j in 0..3 {
+            let b: Simd<u8, B64_GROUPS> = (v >> Simd::splat(16 - 8 * j as u32)).cast();
+            b.scatter(out, stride3 + Simd::splat(j));
+        }
+        g += B64_GROUPS;
+    }
+    while g < groups {
+        if !base64_decode_group(&src[g * 4..g * 4 + 4], &mut dst[g * 3..g * 3 + 3], table) {
+            break;
+        }
+        g += 1;
+    }
+    g * 4
+}
+
+/// Base64-encode the complete 3-byte groups of `src` into `dst`, using the
+/// URL-safe alphabet when `url` is non-zero and the standard one otherwise
+/// (no padding).  Returns the number of bytes written (`len/3*4`).
+///
+/// # Safety
+/// `src` must be valid for `len` bytes and `dst` for `len/3*4` bytes.
+#[unsafe(no_mangle)]
+pub unsafe extern "C" fn base64_encode(src: *const u8, len: usize, dst: *mut u8, url: u8) -> usize {
+    if src.is_null() || dst.is_null() || len < 3 {
+        return 0;
+    }
+    let src = core::slice::from_raw_parts(src, len);
+    let dst = core::slice::from_raw_parts_mut(dst, len / 3 * 4);
+    base64_encode_impl(src, dst, if url != 0 { BASE64_URL } else { BASE64_STD })
+}
+
+/// Base64-decode the complete 4-char groups of `src` into `dst`, stopping
+/// before the first group containing a byte outside the alphabet (padding
+/// included).  `url` selects the alphabet as in `base64_encode`.  Returns
+/// the number of input bytes consumed, a multiple of 4; `consumed/4*3`
+/// bytes were written.
+///
+/// # Safety
+/// `src` must be valid for `len` bytes and `dst` for `len/4*3` bytes.
+#[unsafe(no_mangle)]
+pub unsafe extern "C" fn base64_decode(src: *const u8, len: usize, dst: *mut u8, url: u8) -> usize {
+    if src.is_null() || dst.is_null() || len < 4 {
+        return 0;
+    }
+    let src = core::slice::from_raw_parts(src, len);
+    let dst = core::slice::from_raw_parts_mut(dst, len / 4 * 3);
+    let table = if url != 0 { &BASE64_URL_DECODE } else { &BASE64_STD_DECODE };
+    base64_decode_impl(src, dst, table)
+}
+
+/// Rewrite every byte equal to `old` as `new` and return how many were
+/// replaced.  Each chunk is blended with a splat of `new` under the equality
+/// mask and stored back only when it held a match; the count is accumulated
+/// in u8 lanes as in `count_u8_impl`.
+#[inline(always)]
+fn replace_u8_impl<const L: usize>(data: &mut [u8], old: u8, new: u8) -> u64
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    let from = Simd::<u8, L>::splat(old);
+    let to = Simd::<u8, L>::splat(new);
+    let one = Simd::<u8, L>::splat(1);
+    let zero = Simd::<u8, L>::splat(0);
+    let mut total = 0u64;
+    let mut chunks = data.chunks_exact_mut(L);
+    loop {
+        let mut acc = zero;
+        let mut k = 0;
+        for chunk in (&mut chunks).take(255) {
+            let v = Simd::<u8, L>::from_slice(chunk);
+            let m = v.simd_eq(from);
+            if m.any() {
+                m.select(to, v).copy_to_slice(chunk);
+                acc += m.select(one, zero);
+            }
+            k += 1;
+        }
+        total += acc.cast::<u32>().reduce_sum() as u64;
+        if k < 255 {
+            break;
+        }
+    }
+    for b in chunks.into_remainder() {
+        if *b == old {
+            *b = new;
+            total += 1;
+        }
+    }
+    total
+}
+
+/* ─── replace_u8 exports via macro ─────────────────────────────────────── */
+macro_rules! export_replace_u8 {
+    ($name:ident, $lanes:expr) => {
+        #[doc = concat!(
+            "Replace, in place, every byte equal to `old` with `new` using a ", stringify!($lanes),
+            "-lane SIMD kernel.  Returns the number of bytes replaced.\n\n",
+            "# Safety\n",
+            "`ptr` must be null or valid for reads and writes of `len` bytes."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(ptr: *mut u8, len: usize, old: u8, new: u8) -> u64 {
+            if ptr.is_null() || len == 0 {
+                return 0;
+            }
+            let data = core::slice::from_raw_parts_mut(ptr, len);
+            replace_u8_impl::<$lanes>(data, old, new)
+        }
+    };
+}
+export_replace_u8!(replace_u8_16, 16);
+export_replace_u8!(replace_u8_32, 32);
+export_replace_u8!(replace_u8_64, 64);
+
+/// Add the byte frequencies of `data` to `hist`.  Each L-byte chunk is loaded
+/// as a vector and its lanes are counted straight into `hist`: the kernel runs
+/// on the goroutine stack, which has no room for private partial tables.
+#[inline(always)]
+fn histogram_u8_impl<const L: usize>(data: &[u8], hist: &mut [u64; 256])
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    let mut chunks = data.chunks_exact(L);
+    for chunk in &mut chunks {
+        for b in Simd::<u8, L>::from_slice(chunk).to_array() {
+            hist[b as usize] += 1;
+        }
+    }
+    for &b in chunks.remainder() {
+        hist[b as usize] += 1;
+    }
+}
+
+/* ─── histogram_u8 exports via macro ───────────────────────────────────── */
+macro_rules! export_histogram_u8 {
+    ($name:ident, $lanes:expr) => {
+        #[doc = concat!(
+            "Add the frequency of every byte value in `ptr[..len]` to the 256 counters at `hist` using ",
+            stringify!($lanes), "-byte chunks.\n\n",
+            "# Safety\n",
+            "`ptr` must be null or valid for `len` bytes; `hist` must be null or valid for reads and writes of 256 u64s."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(ptr: *const u8, len: usize, hist: *mut u64) {
+            if ptr.is_null() || hist.is_null() || len == 0 {
+                return;
+            }
+            let data = core::slice::from_raw_parts(ptr, len);
+            histogram_u8_impl::<$lanes>(data, &mut *(hist as *mut [u64; 256]));
+        }
+    };
+}
+export_histogram_u8!(histogram_u8_16, 16);
+export_histogram_u8!(histogram_u8_32, 32);
+export_histogram_u8!(histogram_u8_64, 64);
+
+// === LUT membership: any byte in set =======================================
+
+/// Report whether any byte of `data` has a non-zero table entry.  The
+/// gathered flags of four chunks are OR-ed together before the single
+/// horizontal test, so the early exit costs one reduction per 4*L bytes.
+#[inline(always)]
+unsafe fn any_u8_lut_impl<const L: usize>(data: &[u8], table: &[u8]) -> bool
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    let flags = |chunk: &[u8]| {
+        let idx: Simd<usize, L> = Simd::<u8, L>::from_slice(chunk).cast();
+        Simd::<u8, L>::gather_or_default(table, idx)
+    };
+    let mut blocks = data.chunks_exact(4 * L);
+    for block in &mut blocks {
+        let hits = flags(&block[..L])
+            | flags(&block[L..2 * L])
+            | flags(&block[2 * L..3 * L])
+            | flags(&block[3 * L..]);
+        if hits.reduce_max() != 0 {
+            return true;
+        }
+    }
+    let mut chunks = blocks.remainder().chunks_exact(L);
+    for chunk in &mut chunks {
+        if flags(chunk).reduce_max() != 0 {
+            return true;
+        }
+    }
+    chunks.remainder().iter().any(|&b| table[b as usize] != 0)
+}
+
+/* ─── any_u8_lut exports via macro ──────────────────────────────────────── */
+macro_rules! export_any_u8_lut {
+    ($name:ident, $lanes:expr) => {
+        #[doc = concat!(
+            "Report whether any byte has a non-zero entry in a 256-byte lookup table using a ", stringify!($lanes), "-lane SIMD kernel. Returns 1 at the first hit, 0 if there is none.\n\n",
+            "# Safety\n",
+            "• `ptr`/`lut` must be valid for `len`/256 bytes respectively."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(ptr: *const u8, len: usize, lut: *const u8) -> u8 {
+            if ptr.is_null() || len == 0 {
+                return 0;
+            }
+            let data = core::slice::from_raw_parts(ptr, len);
+            let table = core::slice::from_raw_parts(lut, 256);
+            any_u8_lut_impl::<$lanes>(data, table) as u8
+        }
+    };
+}
+export_any_u8_lut!(any_u8_lut16, 16);
+export_any_u8_lut!(any_u8_lut32, 32);
+export_any_u8_lut!(any_u8_lut64, 64);
+
+// === Forward LUT membership scan ============================================
+
+#[inline(always)]
+unsafe fn index_lut_impl<const L: usize>(data: &[u8], table: &[u8]) -> usize
+where
+    LaneCount<L>: SupportedLaneCount,
+{
+    let mut chunks = data.chunks_exact(L);
+    let mut start = 0;
+    for chunk in &mut chunks {
+        let v = Simd::<u8, L>::from_slice(chunk);
+        let idx: Simd<usize, L> = v.cast();
+        let flags = Simd::<u8, L>::gather_or_default(table, idx);
+        let mask = flags.simd_ne(Simd::splat(0)).to_bitmask();
+        if mask != 0 {
+            return start + mask.trailing_zeros() as usize;
+        }
+        start += L;
+    }
+    for (i, &b) in chunks.remainder().iter().enumerate() {
+        if table[b as usize] != 0 {
+            return start + i;
+        }
+    }
+    data.len()
+}
+
+/* ─── index_lut exports via macro ───────────────────────────────────────── */
+macro_rules! export_index_lut {
+    ($name:ident, $lanes:expr) => {
+        #[doc = concat!(
+            "Return the offset of the first byte whose 256-byte lookup table entry is non-zero, scanning ", stringify!($lanes), "-lane chunks, or `len` if there is none.\n\n",
+            "# Safety\n",
+            "• `ptr`/`lut` must be valid for `len`/256 bytes respectively."
+        )]
+        #[unsafe(no_mangle)]
+        pub unsafe extern "C" fn $name(ptr: *const u8, len: usize, lut: *const u8) -> usize {
+            if ptr.is_null() || len == 0 {
+                return 0;
+            }
+            let data = core::slice::from_raw_parts(ptr, len);
+            let table = core::slice::from_raw_parts(lut, 256);
+            index_lut_impl::<$lanes>(data, table)
+        }
+    };
+}
+export_index_lut!(index_lut16, 16);
+export_index_lut!(index_lut32, 32);
+export_index_lut!(index_lut64, 64);
+
+// === Batched validation over Go slice/string headers ========================
+//
+// The batch entry points take the caller's array of Go slice or string
+// headers as is, so nothing is marshalled per call: header `i` starts
+// `i * stride` words into `descs`, its first word is the data pointer and
+// its second the length (stride 3 for []byte headers, 2 for strings).
+
+/// Return the `i`-th (ptr, len) pair of a header array as a byte slice.
+#[inline(always)]
+unsafe fn batch_item<'a>(descs: *const usize, stride: usize, i: usize) -> &'a [u8] {
+    let h = descs.add(i * stride);
+    let (ptr, len) = (*h as *const u8, *h.add(1));
+    if ptr.is_null() || len == 0 {
+        return &[];
+    }
+    core::slice::from_raw_parts(ptr, len)
+}
+
+/// Set `out[i]` to 1 if the `i`-th of `count` byte strings is pure ASCII and
+/// to 0 otherwise, in one call for the whole batch.
+///
+/// # Safety
+/// `descs` must hold `count` headers of `stride` words (`stride >= 2`) whose
+/// (ptr, len) pairs are valid; `out` must be valid for `count` bytes.
+#[unsafe(no_mangle)]
+pub unsafe extern "C" fn is_ascii_batch(descs: *const usize, count: usize, stride: usize, out: *mut u8) {
+    if descs.is_null() || out.is_null() || stride < 2 {
+        return;
+    }
+    for i in 0..count {
+        *out.add(i) = is_ascii_impl::<32>(batch_item(descs, stride, i)) as u8;
+    }
+}
+
+/// Tag character classes: bit 0 marks bytes allowed anywhere in a tag
+/// (a-z, 0-9, ':', '.', '/', '-', '_'), bit 1 bytes allowed as its first
+/// character (a-z, ':').
+const TAG_CLASS: [u8; 256] = {
+    let mut t = [0u8; 256];
+    let mut c = b'a';
+    while c <= b'z' {
+        t[c as usize] = 3;
+        c += 1;
+    }
+    let mut c = b'0';
+    while c <= b'9' {
+        t[c as usize] = 1;
+        c += 1;
+    }
+    t[b':' as usize] = 3;
+    t[b'.' as usize] = 1;
+    t[b'/' as usize] = 1;
+    t[b'-' as usize] = 1;
+    t[b'_' as usize] = 1;
+    t
+};
+
+/// Datadog tag rules: length within [min, max], a start character first,
+/// only tag characters after it, no "__" and no trailing '_'.  Whole
+/// 16-byte chunks are classified with a LUT gather and tested for "__"
+/// against the same chunk shifted by one byte.
+#[inline(always)]
+fn tag_valid(tag: &[u8], min: usize, max: usize) -> bool {
+    let n = tag.len();
+    if n == 0 || n < min || n > max {
+        return false;
+    }
+    if TAG_CLASS[tag[0] as usize] & 2 == 0 || tag[n - 1] == b'_' {
+        return false;
+    }
+    let us = Simd::<u8, 16>::splat(b'_');
+    let mut i = 0;
+    while i + 17 <= n {
+        let a = Simd::<u8, 16>::from_slice(&tag[i..]);
+        let b = Simd::<u8, 16>::from_slice(&tag[i + 1..]);
+        let class = Simd::<u8, 16>::gather_or_default(&TAG_CLASS, a.cast());
+        let disallowed = (class & Simd::splat(1)).simd_eq(Simd::splat(0));
+        if disallowed.any() || (a.simd_eq(us) & b.simd_eq(us)).any() {
+            return false;
+        }
+        i += 16;
+    }
+    for j in i..n {
+        if TAG_CLASS[tag[j] as usize] & 1 == 0 || (tag[j] == b'_' && j + 1 < n && tag[j + 1] == b'_') {
+            return false;
+        }
+    }
+    true
+}
+
+/// Set `out[i]` to 1 if the `i`-th of `count` tags is valid under the
+/// Datadog tag rules with a length limit of `min..=max` bytes, and to 0
+/// otherwise.
+///
+/// # Safety
+/// As for `is_ascii_batch`.
+#[unsafe(no_mangle)]
+pub unsafe extern "C" fn validate_tag_batch(
+    descs: *const usize,
+    count: usize,
+    stride: usize,
+    min: usize,
+    max: usize,
+    out: *mut u8,
+) {
+    if descs.is_null() || out.is_null() || stride < 2 {
+        return;
+    }
+    for i in 0..count {
+        *out.add(i) = tag_valid(batch_item(descs, stride, i), min, max) as u8;
+    }
+}
+
+// -----------------------------------------------------------------------------
+
+// FFI helper: no-op function to measure call overhead -------------------------
+#[unsafe(no_mangle)]
+pub unsafe extern "C" fn noop() {
+    // deliberately does nothing
+}
+
+// === FFI trampoline sanity helper ===========================================
+/// Simple checksum over the arguments; used only by Go tests to verify that
+/// assembly trampolines pass parameters with the correct width/order.
+#[unsafe(no_mangle)]
+pub unsafe extern "C" fn trampoline_sanity(
+    ptr: *const u8,
+    len: usize,
+    val32: u32,
+    val8: u8,
+    val64: u64,
+    f64_bits: u64,
+    f32_bits: u32,
+) -> usize {
+    // Mix everything into a 64-bit value using a cheap LCG-style hash.
+    let mut h = 0xcbf29ce484222325u64; // FNV offset basis
+    #[inline(always)]
+    fn mix(h: u64, v: u64) -> u64 {
+        h ^ v.wrapping_mul(0x100_0000_01b3)
+    }
+    h = mix(h, ptr as u64);
+    h = mix(h, len as u64);
+    h = mix(h, val32 as u64);
+    h = mix(h, val8 as u64);
+    h = mix(h, val64);
+    let fb64 = f64_bits & 0x7fff_ffff_ffff_ffffu64; // ignore sign if provided
+    let fb32 = (f32_bits & 0x7fff_ffffu32) as u64;
+    h = mix(h, fb64);
+    h = mix(h, fb32);
+    h as usize
+}
+
+/// Echo structure for detailed trampoline debugging (test builds only).
+#[repr(C)]
+#[derive(Copy, Clone, Debug, PartialEq)]
+pub struct Echo {
+    pub ptr: usize,
+    pub len: usize,
+    pub v32: u32,
+    pub v8: u8,
+    pub v64: u64,
+    pub f64bits: u64,
+    pub f32bits: u32,
+    pub f64: f64,
+}
+
+/// Bounce all parameters back to the caller; used by Go unit tests to pinpoint
+/// which argument (if any) is mis-marshalled by the assembly trampolines.
+#[unsafe(no_mangle)]
+pub unsafe extern "C" fn trampoline_echo(
+    ptr: *const u8,
+    len: usize,
+    v32: u32,
+    v8: u8,
+    v64: u64,
+    f64bits: u64,
+    f32bits: u32,
+    out: *mut Echo,
+) {
+    if out.is_null() {
+        return;
+    }
+    *out = Echo {
+        ptr: ptr as usize,
+        len,
+        v32,
+        v8,
+        v64,
+        f64bits,
+        f32bits,
+        f64: f64::from_bits(f64bits),
+    };
+}
+
+/// Return the float64 with the given bit pattern; used by Go unit tests to
+/// verify that trampolines move floating-point results out of the FP return
+/// register (XMM0 / D0) rather than the integer one.
+#[unsafe(no_mangle)]
+pub unsafe extern "C" fn trampoline_echo_f64(bits: u64) -> f64 {
+    f64::from_bits(bits)
+}
+
+#[cfg(test)]
+mod tests {
+    #[test]
+    fn test_sum_u8() {
+        let data: Vec<u8> = (0u8..=255u8).collect();
+        let expected: u32 = data.iter().map(|&b| b as u32).sum();
+        let result = unsafe { super::sum_u8_64(data.as_ptr(), data.len()) };
+        assert_eq!(result, expected);
+    }
+
+    #[test]
+    fn test_sum_u8_wrap() {
+        // Number of 0xFF bytes needed to overflow u32.
+        const LEN: usize = 16_843_010; // ceil(2^32 / 255)
+        let data = vec![0xFFu8; LEN];
+        // Expected result is (255 * LEN) mod 2^32.
+        let expected = ((255u64 * LEN as u64) & 0xFFFF_FFFF) as u32;
+        let result = unsafe { super::sum_u8_64(data.as_ptr(), data.len()) };
+        assert_eq!(result, expected);
+    }
+
+    #[test]
+    fn test_sum_u8_various_lengths() {
+        // Stress a variety of lengths to make sure chunk/remainder logic works.
+        let mut lengths = vec![
+            0usize,
+            1,
+            64 - 1,
+            64,
+            64 + 1,
+            2 * 64 - 3,
+            2 * 64,
+            4096,
+            10_000,
+        ];
+        lengths.push(123_456); // an arbitrary large size
+
+        for len in lengths {
+            // Create data pattern 0,1,2,3,... wrapping every 256.
+            let data: Vec<u8> = (0..len as u32).map(|i| (i % 256) as u8).collect();
+
+            let expected: u32 = data.iter().map(|&b| b as u32).sum();
+            let got = unsafe { super::sum_u8_64(data.as_ptr(), data.len()) };
+            assert_eq!(got, expected, "failed at len={}", len);
+        }
+    }
+
+    #[test]
+    fn test_is_ascii() {
+        let ascii = b"Hello, world!";
+        let non_ascii = [0x48u8, 0x80u8, 0x49u8];
+
+        unsafe {
+            assert_eq!(super::is_ascii32(ascii.as_ptr(), ascii.len()), 1);
+            assert_eq!(super::is_ascii64(non_ascii.as_ptr(), non_ascii.len()), 0);
+            assert_eq!(super::is_ascii32(core::ptr::null(), 0), 1);
+        }
+    }
+
+    #[test]
+    fn test_map_u8_lut_basic() {
+        // Mapping table: invert each byte (x -> 255 - x)
+        let map: Vec<u8> = (0..=255u16).map(|b| 255u8.wrapping_sub(b as u8)).collect();
+        let src: Vec<u8> = (0..=255u16).map(|b| b as u8).collect();
+        let mut dst16 = vec![0u8; src.len()];
+        let mut dst32 = vec![0u8; src.len()];
+        let mut dst64 = vec![0u8; src.len()];
+        unsafe {
+            super::map_u8_lut16(src.as_ptr(), src.len(), dst16.as_mut_ptr(), map.as_ptr());
+            super::map_u8_lut32(src.as_ptr(), src.len(), dst32.as_mut_ptr(), map.as_ptr());
+            super::map_u8_lut64(src.as_ptr(), src.len(), dst64.as_mut_ptr(), map.as_ptr());
+        }
+        let expected: Vec<u8> = src.iter().map(|&b| 255 - b).collect();
+        assert_eq!(dst16, expected, "16-lane mapping failed");
+        assert_eq!(dst32, expected, "32-lane mapping failed");
+        assert_eq!(dst64, expected, "64-lane mapping failed");
+    }
+
+    #[test]
+    fn test_map_u8_lut_various_lengths() {
+        let map: Vec<u8> = (0..=255u16).map(|b| (b as u8).wrapping_add(1)).collect(); // simple +1 mapping
+        let lengths = [0usize, 1, 15, 16, 17, 31, 32, 33, 63, 64, 65, 255, 1023];
+        for &len in &lengths {
+            let src: Vec<u8> = (0..len as u32).map(|i| (i % 256) as u8).collect();
+            let mut dst = vec![0u8; len];
+            unsafe {
+                super::map_u8_lut64(src.as_ptr(), len, dst.as_mut_ptr(), map.as_ptr());
+            }
+            for i in 0..len {
+                assert_eq!(dst[i], src[i].wrapping_add(1), "idx {} len {}", i, len);
+            }
+        }
+    }
+}
+
+#[cfg(test)]
+mod mask_tests {
+    fn scalar_mask(chunk: &[u8], needle: u8) -> u128 {
+        let mut m = 0u128;
+        for (i, &b) in chunk.iter().enumerate() {
+            if b == needle {
+                m |= 1 << i;
+            }
+        }
+        m
+    }
+    #[test]
+    fn test_eq_u8_masks_basic() {
+        let data: Vec<u8> = (0..128u16).map(|i| (i % 256) as u8).collect();
+        let mut out16 = vec![0u16; data.len() / 16];
+        let mut out32 = vec![0u32; data.len() / 32];
+        let mut out64 = vec![0u64; data.len() / 64];
+        unsafe {
+            let c16 = super::eq_u8_masks16(data.as_ptr(), data.len(), 3u8, out16.as_mut_ptr());
+            let c32 = super::eq_u8_masks32(data.as_ptr(), data.len(), 3u8, out32.as_mut_ptr());
+            let c64 = super::eq_u8_masks64(data.as_ptr(), data.len(), 3u8, out64.as_mut_ptr());
+            assert_eq!(c16, out16.len());
+            assert_eq!(c32, out32.len());
+            assert_eq!(c64, out64.len());
+        }
+        // validate
+        for (i, &mask) in out16.iter().enumerate() {
+            let start = i * 16;
+            let chunk = &data[start..start + 16];
+            assert_eq!(mask as u128, scalar_mask(chunk, 3));
+        }
+        for (i, &mask) in out32.iter().enumerate() {
+            let start = i * 32;
+            assert_eq!(mask as u128, scalar_mask(&data[start..start + 32], 3));
+        }
+        for (i, &mask) in out64.iter().enumerate() {
+            let start = i * 64;
+            assert_eq!(mask as u128, scalar_mask(&data[start..start + 64], 3));
+        }
+    }
+
+    #[test]
+    fn test_eq_any_masks64() {
+        let data: Vec<u8> = (0..200u32).map(|i| (i * 7 % 251) as u8).collect();
+        let needles = [3u8, 10, 200, 3, 3, 3, 3, 3];
+        let mut out = [0u64; 3];
+        let n =
+            unsafe { super::eq_any_masks64(data.as_ptr(), data.len(), &needles, out.as_mut_ptr()) };
+        assert_eq!(n, 3);
+        for (i, &b) in data[..192].iter().enumerate() {
+            let want = needles.contains(&b);
+            assert_eq!(out[i / 64] >> (i % 64) & 1 == 1, want, "byte {i}");
+        }
+    }
+}
+
+#[cfg(test)]
+mod crc32c_tests {
+    /// Known-good CRC32C values computed via Go's hash/crc32 package.
+    const CRC1: u32 = 0xa016d052; // checksum of a single byte 0x01
+    const CRC_HELLO: u32 = 0x9a71bb4c; // checksum of "hello"
+
+    #[test]
+    fn test_crc32c_single_byte() {
+        let data = [0x01u8];
+        let got = super::crc32c_update(0, &data);
+        assert_eq!(
+            got, CRC1,
+            "crc32c_update mismatch for 0x01: {:08x} != {:08x}",
+            got, CRC1
+        );
+    }
+
+    #[test]
+    fn test_crc32c_hello() {
+        let data = b"hello";
+        let got = super::crc32c_update(0, data);
+        assert_eq!(
+            got, CRC_HELLO,
+            "crc32c_update mismatch for \"hello\": {:08x} != {:08x}",
+            got, CRC_HELLO
+        );
+    }
+
+    #[test]
+    fn test_crc32c_reference_crc_crate() {
+        const CRC32C: crc::Crc<u32> = crc::Crc::<u32>::new(&crc::CRC_32_ISCSI);
+        let data1 = [0x01u8];
+        let data2 = b"hello";
+        let ref1 = CRC32C.checksum(&data1);
+        let ref2 = CRC32C.checksum(data2);
+        assert_eq!(ref1, CRC1);
+        assert_eq!(ref2, CRC_HELLO);
+    }
+
+    #[test]
+    fn test_crc32c_direct_function() {
+        let data1 = [0x01u8];
+        let data2 = b"hello";
+        assert_eq!(crc32c::crc32c(&data1), 0xa016d052);
+        assert_eq!(crc32c::crc32c(data2), 0x9a71bb4c);
+    }
+
+    #[test]
+    fn test_crc32c_append_semantics() {
+        let data = [0x01u8];
+        let res = crc32c::crc32c_append(0, &data);
+        assert_eq!(
+            res, 0xa016d052,
+            "crc32c_append with init 0 should give finalised CRC"
+        );
+    }
+
+    #[test]
+    fn test_crc32c_50_ab() {
+        let data = vec![0xABu8; 50];
+        let expect = 0xd64d26c9u32;
+        let got = super::crc32c_update(0, &data);
+        assert_eq!(got, expect);
+    }
+
+    #[test]
+    fn test_crc32c_ffi_raw() {
+        let data = [0x01u8];
+        let got = unsafe { super::crc32_update_32_raw(data.as_ptr(), data.len(), 0) };
+        assert_eq!(got, 0xa016d052);
+    }
+
+    #[test]
+    fn test_crc32c_alias_function() {
+        let data = [0x01u8];
+        let got = unsafe { super::crc32_update_32(data.as_ptr(), data.len(), 0) };
+        assert_eq!(got, 0xa016d052);
+    }
+
+    #[test]
+    fn test_crc32c_combine() {
+        use rand::{RngCore, SeedableRng};
+        let mut rng = rand::rngs::StdRng::seed_from_u64(42);
+        let len1 = 100usize;
+        let len2 = 200usize;
+        let mut buf1 = vec![0u8; len1];
+        let mut buf2 = vec![0u8; len2];
+        rng.fill_bytes(&mut buf1);
+        rng.fill_bytes(&mut buf2);
+
+        let crc1 = super::crc32c_update(0, &buf1);
+        let crc2 = super::crc32c_update(0, &buf2);
+        let mut concat = Vec::from(buf1);
+        concat.extend_from_slice(&buf2);
+        let expected_concat = super::crc32c_update(0, &concat);
+        let combined = unsafe { super::crc32_combine_raw(crc1, crc2, len2) };
+        assert_eq!(combined, expected_concat, "combine mismatch");
+    }
+
+    #[test]
+    fn debug_combine_variants() {
+        use crc32c::crc32c_combine;
+        let buf1: &[u8] = b"hello";
+        let buf2: &[u8] = b" world"; // len2=6
+        let crc1 = super::crc32c_update(0, buf1);
+        let crc2 = super::crc32c_update(0, buf2);
+        let mut concat = Vec::from(buf1);
+        concat.extend_from_slice(&buf2);
+        let expected = super::crc32c_update(0, &concat);
+
+        let raw1 = !crc1;
+        let raw2 = !crc2;
+
+        let v1 = !crc32c_combine(raw1, raw2, buf2.len());
+        let v2 = crc32c_combine(raw1, raw2, buf2.len());
+        let v3 = !crc32c_combine(crc1, crc2, buf2.len());
+        let v4 = crc32c_combine(crc1, crc2, buf2.len());
+        println!(
+            "expected {:08x} v1 {:08x} v2 {:08x} v3 {:08x} v4 {:08x}",
+            expected, v1, v2, v3, v4
+        );
+    }
+
+    #[test]
+    fn test_crc32c_combine_variants_randomised() {
+        use rand::{RngCore, SeedableRng};
+
+        const TRIALS: usize = 100;
+        let mut rng = rand::rngs::StdRng::seed_from_u64(0x5eed);
+
+        for _ in 0..TRIALS {
+            // Generate two random buffers of random length up to 8 KiB.
+            let len1 = (rng.next_u32() % 8192) as usize;
+            let len2 = (rng.next_u32() % 8192) as usize;
+            let mut buf1 = vec![0u8; len1];
+            let mut buf2 = vec![0u8; len2];
+            rng.fill_bytes(&mut buf1);
+            rng.fill_bytes(&mut buf2);
+
+            let crc1 = super::crc32c_update(0, &buf1);
+            let crc2 = super::crc32c_update(0, &buf2);
+
+            // Ground-truth CRC of the concatenation.
+            let mut concat = buf1.clone();
+            concat.extend_from_slice(&buf2);
+            let expected = super::crc32c_update(0, &concat);
+
+            // Variant implemented in Simba (direct finalised combine).
+            let chosen = super::crc32c_combine_go(crc1, crc2, len2);
+            assert_eq!(chosen, expected, "crc32c_combine_go produced wrong value");
+
+            // Sanity-check other flip combinations — none should match.
+            let raw1 = !crc1;
+            let raw2 = !crc2;
+            let variants = [
+                // Previously-used implementation that flipped before + after
+                !crc32c::crc32c_combine(raw1, raw2, len2),
+                // Mix-and-match variants
+                crc32c::crc32c_combine(raw1, raw2, len2),
+                !crc32c::crc32c_combine(crc1, crc2, len2),
+            ];
+            for (i, &v) in variants.iter().enumerate() {
+                assert_ne!(v, expected, "variant {} unexpectedly matched", i + 1);
+            }
+        }
+    }
+}
+
+#[cfg(test)]
+mod index_pair_tests {
+    fn scalar(data: &[u8], b0: u8, b1: u8) -> usize {
+        data.windows(2)
+            .position(|w| w[0] == b0 && w[1] == b1)
+            .unwrap_or(data.len())
+    }
+
+    #[test]
+    fn test_index_pair_chunk_boundaries() {
+        let kernels: [unsafe extern "C" fn(*const u8, usize, u8, u8) -> usize; 3] = [
+            super::index_pair_u8_16,
+            super::index_pair_u8_32,
+            super::index_pair_u8_64,
+        ];
+        for len in 2..200usize {
+            let mut cases = vec![];
+            for pos in [0, 14, 15, 16, 31, 32, 63, 64, len / 2, len - 2] {
+                if pos + 1 < len {
+                    let mut data = vec![b'x'; len];
+                    data[pos] = b'\r';
+                    data[pos + 1] = b'\n';
+                    cases.push(data);
+                }
+            }
+            // A lone first byte at the very end is not a match.
+            let mut data = vec![b'x'; len];
+            data[len - 1] = b'\r';
+            cases.push(data);
+
+            for data in &cases {
+                let want = scalar(data, b'\r', b'\n');
+                for k in kernels {
+                    let got = unsafe { k(data.as_ptr(), len, b'\r', b'\n') };
+                    assert_eq!(got, want, "len={len} want={want}");
+                }
+            }
+        }
+    }
+}
+
+#[cfg(test)]
+mod index_lt_tests {
+    fn scalar(data: &[u8], threshold: u8) -> usize {
+        data.iter().position(|&b| b < threshold).unwrap_or(data.len())
+    }
+
+    #[test]
+    fn test_index_lt_u8_tail() {
+        for len in [1usize, 15, 16, 17, 31, 32, 33, 63, 64, 65, 130] {
+            for pos in 0..len {
+                let mut data = vec![0x80u8; len];
+                data[pos] = 0x7F;
+                let want = scalar(&data, 0x80);
+                unsafe {
+                    assert_eq!(super::index_lt_u8_16(data.as_ptr(), len, 0x80), want);
+                    assert_eq!(super::index_lt_u8_32(data.as_ptr(), len, 0x80), want);
+                    assert_eq!(super::index_lt_u8_64(data.as_ptr(), len, 0x80), want);
+                }
+            }
+            let none = vec![0xFFu8; len];
+            assert_eq!(unsafe { super::index_lt_u8_64(none.as_ptr(), len, 0x80) }, len);
+        }
+    }
+}
+
+#[cfg(test)]
+mod last_index_lut_tests {
+    #[test]
+    fn test_last_index_lut_front_remainder() {
+        let mut table = [0u8; 256];
+        table[b'/' as usize] = 1;
+        for len in [1usize, 15, 16, 17, 31, 32, 33, 63, 64, 65, 130] {
+            for pos in 0..len {
+                let mut data = vec![b'a'; len];
+                data[pos] = b'/';
+                unsafe {
+                    assert_eq!(super::last_index_lut16(data.as_ptr(), len, table.as_ptr()), pos);
+                    assert_eq!(super::last_index_lut32(data.as_ptr(), len, table.as_ptr()), pos);
+                    assert_eq!(super::last_index_lut64(data.as_ptr(), len, table.as_ptr()), pos);
+                }
+            }
+            let none = vec![b'a'; len];
+            assert_eq!(unsafe { super::last_index_lut64(none.as_ptr(), len, table.as_ptr()) }, len);
+        }
+    }
+}
+
+#[cfg(test)]
+mod minmax_f32_tests {
+    fn run(f: unsafe extern "C" fn(*const f32, usize) -> u32, data: &[f32]) -> f32 {
+        f32::from_bits(unsafe { f(data.as_ptr(), data.len()) })
+    }
+
+    #[test]
+    fn test_minmax_f32() {
+        let mut data: Vec<f32> = (0..100).map(|i| (i as f32 - 50.0) * 1.5).collect();
+        assert_eq!(run(super::max_f32_16, &data), 73.5);
+        assert_eq!(run(super::min_f32_64, &data), -75.0);
+
+        data[99] = f32::INFINITY;
+        data[0] = f32::NEG_INFINITY;
+        assert_eq!(run(super::max_f32_32, &data), f32::INFINITY);
+        assert_eq!(run(super::min_f32_32, &data), f32::NEG_INFINITY);
+
+        // NaN in the SIMD body and in the tail.
+        for pos in [3usize, 70, 99] {
+            let mut d = data.clone();
+            d[pos] = f32::NAN;
+            assert!(run(super::max_f32_64, &d).is_nan());
+            assert!(run(super::min_f32_16, &d).is_nan());
+        }
+
+        // Signed zeros follow math.Max / math.Min.
+        let zeros = [0.0f32, -0.0, 0.0, -0.0];
+        assert!(run(super::max_f32_16, &zeros).is_sign_positive());
+        assert!(run(super::min_f32_16, &zeros).is_sign_negative());
+        let zeros64 = [-0.0f32; 64];
+        assert!(run(super::max_f32_64, &zeros64).is_sign_negative());
+
+        assert_eq!(run(super::max_f32_16, &[]), f32::NEG_INFINITY);
+        assert_eq!(run(super::min_f32_16, &[]), f32::INFINITY);
+    }
+}
+
+#[cfg(test)]
+mod count_outside_tests {
+    #[test]
+    fn test_count_outside_printable() {
+        let data: Vec<u8> = (0..1000u32).map(|i| (i * 7 % 256) as u8).collect();
+        for len in [0usize, 1, 15, 16, 17, 63, 64, 65, 1000] {
+            let d = &data[..len];
+            let want = d.iter().filter(|&&b| b < 0x20 || b > 0x7E).count();
+            unsafe {
+                assert_eq!(super::count_outside_u8_16(d.as_ptr(), len, 0x20, 0x7E), want);
+                assert_eq!(super::count_outside_u8_32(d.as_ptr(), len, 0x20, 0x7E), want);
+                assert_eq!(super::count_outside_u8_64(d.as_ptr(), len, 0x20, 0x7E), want);
+            }
+        }
+    }
+}
+
+#[cfg(test)]
+mod filter_tests {
+    #[test]
+    fn test_filter_u8_lut() {
+        let mut table = [1u8; 256];
+        table[b'-' as usize] = 0;
+        table[0] = 0;
+        let src: Vec<u8> = (0..300u32).map(|i| if i % 3 == 0 { b'-' } else { (i % 256) as u8 }).collect();
+        let want: Vec<u8> = src.iter().copied().filter(|&b| table[b as usize] != 0).collect();
+        for f in [super::filter_u8_lut16, super::filter_u8_lut32, super::filter_u8_lut64] {
+            let mut dst = vec![0u8; src.len()];
+            let n = unsafe { f(src.as_ptr(), src.len(), dst.as_mut_ptr(), table.as_ptr()) };
+            assert_eq!(&dst[..n], &want[..]);
+
+            // In place.
+            let mut buf = src.clone();
+            let n = unsafe { f(buf.as_ptr(), buf.len(), buf.as_mut_ptr(), table.as_ptr()) };
+            assert_eq!(&buf[..n], &want[..]);
+        }
+    }
+}
+
+#[cfg(test)]
+mod validate_map_tests {
+    #[test]
+    fn test_validate_map_u8_lut() {
+        let mut allowed = [0u8; 256];
+        let mut map: [u8; 256] = core::array::from_fn(|i| i as u8);
+        for c in b'A'..=b'Z' {
+            allowed[c as usize] = 1;
+            allowed[(c + 32) as usize] = 1;
+            map[c as usize] = c + 32;
+        }
+        let src: Vec<u8> = (0..300u32).map(|i| b"aBcD"[(i % 4) as usize]).collect();
+        let want: Vec<u8> = src.iter().map(|&b| map[b as usize]).collect();
+        use super::{
+            validate_map_u8_lut16 as v16, validate_map_u8_lut32 as v32,
+            validate_map_u8_lut64 as v64,
+        };
+        let (a, m) = (allowed.as_ptr(), map.as_ptr());
+        for f in [v16, v32, v64] {
+            for stop in [0usize, 5, 63, 64, 150, 299, 300] {
+                let mut src = src.clone();
+                if stop < src.len() {
+                    src[stop] = b'!';
+                }
+                let mut dst = vec![0u8; src.len()];
+                let n = unsafe { f(src.as_ptr(), src.len(), dst.as_mut_ptr(), a, m) };
+                assert_eq!(n, stop);
+                assert_eq!(&dst[..n], &want[..n]);
+
+                // In place.
+                let n = unsafe { f(src.as_ptr(), src.len(), src.as_mut_ptr(), a, m) };
+                assert_eq!(n, stop);
+                assert_eq!(&src[..n], &want[..n]);
+            }
+        }
+    }
+}
+
+#[cfg(test)]
+mod index_ne_tests {
+    #[test]
+    fn test_index_ne_u8() {
+        for len in [1usize, 15, 16, 17, 31, 32, 33, 63, 64, 65, 130] {
+            let same = vec![b' '; len];
+            unsafe {
+                assert_eq!(super::index_ne_u8_16(same.as_ptr(), len, b' '), len);
+                assert_eq!(super::index_ne_u8_64(same.as_ptr(), len, b' '), len);
+            }
+            for pos in 0..len {
+                let mut data = same.clone();
+                data[pos] = b'x';
+                unsafe {
+                    assert_eq!(super::index_ne_u8_16(data.as_ptr(), len, b' '), pos);
+                    assert_eq!(super::index_ne_u8_32(data.as_ptr(), len, b' '), pos);
+                    assert_eq!(super::index_ne_u8_64(data.as_ptr(), len, b' '), pos);
+                }
+            }
+        }
+    }
+}
+
+#[cfg(test)]
+mod xor_reduce_tests {
+    #[test]
+    fn test_xor_reduce() {
+        let data: Vec<u8> = (0..1000u32).map(|i| (i * 31 % 251) as u8).collect();
+        for len in [0usize, 1, 15, 16, 17, 63, 64, 65, 1000] {
+            let d = &data[..len];
+            let want = d.iter().fold(0u8, |x, &b| x ^ b);
+            unsafe {
+                assert_eq!(super::xor_reduce_u8_16(d.as_ptr(), len), want);
+                assert_eq!(super::xor_reduce_u8_32(d.as_ptr(), len), want);
+                assert_eq!(super::xor_reduce_u8_64(d.as_ptr(), len), want);
+            }
+        }
+    }
+}
+
+#[cfg(test)]
+mod count_class_transitions_tests {
+    #[test]
+    fn test_count_class_transitions() {
+        let mut class = [0u8; 256];
+        for b in b'a'..=b'z' {
+            class[b as usize] = 1;
+        }
+        let data: Vec<u8> = (0..1000u32)
+            .map(|i| if (i * 7 + i / 13) % 5 < 2 { b' ' } else { b'a' + (i % 26) as u8 })
+            .collect();
+        for len in [0usize, 1, 2, 15, 16, 17, 31, 32, 33, 63, 64, 65, 1000] {
+            let d = &data[..len];
+            let want = d.windows(2).filter(|w| class[w[0] as usize] != class[w[1] as usize]).count();
+            unsafe {
+                assert_eq!(super::count_class_transitions_u8_16(d.as_ptr(), len, class.as_ptr()), want);
+                assert_eq!(super::count_class_transitions_u8_32(d.as_ptr(), len, class.as_ptr()), want);
+                assert_eq!(super::count_class_transitions_u8_64(d.as_ptr(), len, class.as_ptr()), want);
+            }
+        }
+    }
+}
+
+#[cfg(test)]
+mod is_sorted_tests {
+    #[test]
+    fn test_is_sorted_u8() {
+        let sorted: Vec<u8> = (0..1000u32).map(|i| (i / 4) as u8).collect();
+        for len in [0usize, 1, 2, 15, 16, 17, 31, 32, 33, 63, 64, 65, 1000] {
+            let mut d = sorted[..len].to_vec();
+            let fns = [super::is_sorted_u8_16, super::is_sorted_u8_32, super::is_sorted_u8_64];
+            for f in fns {
+                assert_eq!(unsafe { f(d.as_ptr(), len) }, 1, "sorted len={len}");
+            }
+            // An inversion at every position, including chunk boundaries.
+            for i in 1..len {
+                if d[i - 1] == 0 {
+                    continue;
+                }
+                let saved = d[i];
+                d[i] = d[i - 1] - 1;
+                for f in fns {
+                    assert_eq!(unsafe { f(d.as_ptr(), len) }, 0, "inversion at {i} len={len}");
+                }
+                d[i] = saved;
+            }
+        }
+    }
+}
+
+#[cfg(test)]
+mod crc32_sum_tests {
+    #[test]
+    fn test_crc32_sum_u8() {
+        let data: Vec<u8> = (0..20000u32).map(|i| (i * 131 % 256) as u8).collect();
+        for len in [0usize, 1, 63, 64, 4095, 4096, 4097, 20000] {
+            let d = &data[..len];
+            let mut sum = 0xdeadu64;
+            let crc = unsafe { super::crc32_sum_u8(d.as_ptr(), len, 7, &mut sum) };
+            assert_eq!(crc, super::crc32c_update(7, d));
+            assert_eq!(sum, d.iter().map(|&b| b as u64).sum::<u64>());
+        }
+    }
+}
+
+#[cfg(test)]
+mod base32_tests {
+    #[test]
+    fn test_base32_rfc4648_vectors() {
+        let mut out = [0u8; 16];
+        // The trailing partial group ("a") is left to the caller.
+        let n = unsafe { super::base32_encode(b"foobarfooba".as_ptr(), 11, out.as_mut_ptr()) };
+        assert_eq!(&out[..n], b"MZXW6YTBOJTG633C");
+    }
+
+    #[test]
+    fn test_base32_round_trip() {
+        let data: Vec<u8> = (0..1000u32).map(|i| (i * 167 + i / 7) as u8).collect();
+        for len in [0usize, 4, 5, 39, 40, 41, 45, 80, 1000] {
+            let src = &data[..len];
+            let mut enc = vec![0u8; len / 5 * 8];
+            let n = unsafe { super::base32_encode(src.as_ptr(), len, enc.as_mut_ptr()) };
+            assert_eq!(n, enc.len());
+            for (g, chunk) in enc.chunks(8).enumerate() {
+                let mut want = [0u8; 8];
+                super::base32_encode_group(&src[g * 5..g * 5 + 5], &mut want);
+                assert_eq!(chunk, want, "group {g} len {len}");
+            }
+            let mut dec = vec![0u8; enc.len() / 8 * 5];
+            let used = unsafe { super::base32_decode(enc.as_ptr(), enc.len(), dec.as_mut_ptr()) };
+            assert_eq!(used, enc.len());
+            assert_eq!(&dec[..], &src[..len / 5 * 5]);
+        }
+    }
+
+    #[test]
+    fn test_base32_decode_stops_at_invalid_group() {
+        let enc = vec![b'A'; 128];
+        for bad in [0usize, 7, 8, 63, 64, 100, 127] {
+            let mut e = enc.clone();
+            e[bad] = b'1';
+            let mut dec = vec![0u8; 80];
+            let used = unsafe { super::base32_decode(e.as_ptr(), e.len(), dec.as_mut_ptr()) };
+            assert_eq!(used, bad / 8 * 8, "bad byte at {bad}");
+        }
+    }
+}
+
+#[cfg(test)]
+mod lut_masks_tests {
+    #[test]
+    fn test_lut_masks() {
+        let mut lut = [0u8; 256];
+        for b in [b' ', b'\t', b'\n'] {
+            lut[b as usize] = 1;
+        }
+        let data: Vec<u8> = (0..256u32).map(|i| if (i * 11) % 7 < 2 { b"\t\n "[i as usize % 3] } else { b'a' }).collect();
+        let mut out16 = vec![0u16; data.len() / 16];
+        let mut out32 = vec![0u32; data.len() / 32];
         let mut out64 = vec![0u64; data.len() / 64];
         unsafe {
-            let c16 = super::eq_u8_masks16(data.as_ptr(), data.len(), 3u8, out16.as_mut_ptr());
-            let c32 = super::eq_u8_masks32(data.as_ptr(), data.len(), 3u8, out32.as_mut_ptr());
-            let c64 = super::eq_u8_masks64(data.as_ptr(), data.len(), 3u8, out64.as_mut_ptr());
-            assert_eq!(c16, out16.len());
-            assert_eq!(c32, out32.len());
-            assert_eq!(c64, out64.len());
+            assert_eq!(super::lut_masks16(data.as_ptr(), data.len(), lut.as_ptr(), out16.as_mut_ptr()), 16);
+            assert_eq!(super::lut_masks32(data.as_ptr(), data.len(), lut.as_ptr(), out32.as_mut_ptr()), 8);
+            assert_eq!(super::lut_masks64(data.as_ptr(), data.len(), lut.as_ptr(), out64.as_mut_ptr()), 4);
+        }
+        for (i, &b) in data.iter().enumerate() {
+            let want = lut[b as usize] != 0;
+            assert_eq!(out16[i / 16] >> (i % 16) & 1 == 1, want);
+            assert_eq!(out32[i / 32] >> (i % 32) & 1 == 1, want);
+            assert_eq!(out64[i / 64] >> (i % 64) & 1 == 1, want);
+        }
+    }
+}
+
+#[cfg(test)]
+mod luhn_tests {
+    fn scalar_luhn(data: &[u8]) -> bool {
+        let mut sum = 0u32;
+        for (k, &b) in data.iter().rev().enumerate() {
+            if !b.is_ascii_digit() {
+                return false;
+            }
+            let d = (b - b'0') as u32;
+            sum += if k % 2 == 1 { if d * 2 > 9 { d * 2 - 9 } else { d * 2 } } else { d };
+        }
+        !data.is_empty() && sum % 10 == 0
+    }
+
+    #[test]
+    fn test_luhn() {
+        let fns = [super::luhn_u8_16, super::luhn_u8_32, super::luhn_u8_64];
+        let mut cases: Vec<Vec<u8>> = vec![b"79927398713".to_vec(), b"79927398710".to_vec(), b"4111111111111111".to_vec()];
+        let digits: Vec<u8> = (0..200u32).map(|i| b'0' + ((i * 7 + i / 3) % 10) as u8).collect();
+        for len in [1usize, 2, 15, 16, 17, 31, 32, 33, 63, 64, 65, 131, 200] {
+            for check in b'0'..=b'9' {
+                let mut d = digits[..len].to_vec();
+                d[len - 1] = check;
+                cases.push(d);
+            }
+        }
+        let mut bad = digits[..70].to_vec();
+        bad[40] = b'x';
+        cases.push(bad);
+        for c in cases {
+            let want = scalar_luhn(&c);
+            for f in fns {
+                assert_eq!(unsafe { f(c.as_ptr(), c.len()) } == 1, want, "{:?}", core::str::from_utf8(&c));
+            }
+        }
+    }
+}
+
+#[cfg(test)]
+mod popcount_op_tests {
+    #[test]
+    fn test_popcount_and_xor() {
+        let a: Vec<u8> = (0..1000u32).map(|i| (i * 73 + 5) as u8).collect();
+        let b: Vec<u8> = (0..1000u32).map(|i| (i * 151 ^ 0x5a) as u8).collect();
+        for len in [0usize, 1, 15, 16, 17, 63, 64, 65, 1000] {
+            let want_and: u64 = (0..len).map(|i| (a[i] & b[i]).count_ones() as u64).sum();
+            let want_xor: u64 = (0..len).map(|i| (a[i] ^ b[i]).count_ones() as u64).sum();
+            unsafe {
+                for f in [super::popcount_and_u8_16, super::popcount_and_u8_32, super::popcount_and_u8_64] {
+                    assert_eq!(f(a.as_ptr(), b.as_ptr(), len), want_and, "and len={len}");
+                }
+                for f in [super::popcount_xor_u8_16, super::popcount_xor_u8_32, super::popcount_xor_u8_64] {
+                    assert_eq!(f(a.as_ptr(), b.as_ptr(), len), want_xor, "xor len={len}");
+                }
+            }
+        }
+    }
+
+    #[test]
+    fn test_popcount() {
+        use super::{popcount_u8_16, popcount_u8_32, popcount_u8_64};
+        let a: Vec<u8> = (0..1000u32).map(|i| (i * 73 + 5) as u8).collect();
+        for len in [0usize, 1, 15, 16, 17, 63, 64, 65, 1000] {
+            let want: u64 = a[..len].iter().map(|&x| x.count_ones() as u64).sum();
+            for f in [popcount_u8_16, popcount_u8_32, popcount_u8_64] {
+                assert_eq!(unsafe { f(a.as_ptr(), len) }, want, "len={len}");
+            }
+        }
+
+        // Enough chunks to drain the u32 lane accumulator more than once.
+        let ones = vec![0xffu8; 16 * super::POPCOUNT_FLUSH + 3];
+        for f in [popcount_u8_16, popcount_u8_32, popcount_u8_64] {
+            let got = unsafe { f(ones.as_ptr(), ones.len()) };
+            assert_eq!(got, 8 * ones.len() as u64);
+        }
+    }
+}
+
+#[cfg(test)]
+mod fletcher_tests {
+    fn ref16(d: &[u8]) -> u32 {
+        let (mut a, mut b) = (0u32, 0u32);
+        for &x in d {
+            a = (a + x as u32) % 255;
+            b = (b + a) % 255;
+        }
+        b << 8 | a
+    }
+
+    fn ref32(d: &[u8]) -> u32 {
+        let (mut a, mut b) = (0u64, 0u64);
+        for w in d.chunks(2) {
+            let v = w[0] as u64 | (*w.get(1).unwrap_or(&0) as u64) << 8;
+            a = (a + v) % 65535;
+            b = (b + a) % 65535;
+        }
+        (b << 16 | a) as u32
+    }
+
+    #[test]
+    fn test_fletcher_vectors() {
+        unsafe {
+            for (s, f16, f32) in [("abcde", 0xC8F0, 0xF04FC729u32), ("abcdef", 0x2057, 0x56502D2A), ("abcdefgh", 0x0627, 0xEBE19591)] {
+                assert_eq!(super::fletcher16_u8_16(s.as_ptr(), s.len()), f16, "{s}");
+                assert_eq!(super::fletcher32_u16_16(s.as_ptr(), s.len()), f32, "{s}");
+            }
+        }
+    }
+
+    #[test]
+    fn test_fletcher_random() {
+        let mut x = 1u32;
+        let data: Vec<u8> = (0..300_001)
+            .map(|_| {
+                x ^= x << 13;
+                x ^= x >> 17;
+                x ^= x << 5;
+                if x % 3 == 0 { 0xFF } else { x as u8 }
+            })
+            .collect();
+        for len in [0usize, 1, 15, 16, 17, 63, 64, 65, 129, 1000, 300_000, 300_001] {
+            let d = &data[..len];
+            unsafe {
+                for f in [super::fletcher16_u8_16, super::fletcher16_u8_32, super::fletcher16_u8_64] {
+                    assert_eq!(f(d.as_ptr(), len), ref16(d), "f16 len={len}");
+                }
+                for f in [super::fletcher32_u16_16, super::fletcher32_u16_32, super::fletcher32_u16_64] {
+                    assert_eq!(f(d.as_ptr(), len), ref32(d), "f32 len={len}");
+                }
+            }
+        }
+        let ones = vec![0xFFu8; 1 << 20];
+        unsafe {
+            assert_eq!(super::fletcher16_u8_64(ones.as_ptr(), ones.len()), ref16(&ones));
+            assert_eq!(super::fletcher32_u16_64(ones.as_ptr(), ones.len()), ref32(&ones));
+        }
+    }
+}
+
+#[cfg(test)]
+mod crc32_ieee_tests {
+    #[test]
+    fn test_crc32_ieee_update() {
+        unsafe {
+            let h = b"hello";
+            assert_eq!(super::crc32_ieee_update(h.as_ptr(), h.len(), 0), 0x3610a686);
+            let hw = b"hello world";
+            assert_eq!(super::crc32_ieee_update(hw.as_ptr(), hw.len(), 0), 0x0d4a1185);
+            let tail = b" world";
+            let first = super::crc32_ieee_update(h.as_ptr(), h.len(), 0);
+            assert_eq!(super::crc32_ieee_update(tail.as_ptr(), tail.len(), first), 0x0d4a1185);
         }
-        // validate
-        for (i, &mask) in out16.iter().enumerate() {
-            let start = i * 16;
-            let chunk = &data[start..start + 16];
-            assert_eq!(mask as u128, scalar_mask(chunk, 3));
+    }
+}
+
+#[cfg(test)]
+mod count_u8_tests {
+    #[test]
+    fn test_count_u8() {
+        let data: Vec<u8> = (0..100_000u32).map(|i| (i * 7 % 5) as u8).collect();
+        for len in [0usize, 1, 15, 16, 17, 64, 65, 255 * 16, 255 * 64 + 3, 100_000] {
+            let d = &data[..len];
+            let want = d.iter().filter(|&&b| b == 3).count() as u64;
+            unsafe {
+                for f in [super::count_u8_16, super::count_u8_32, super::count_u8_64] {
+                    assert_eq!(f(d.as_ptr(), len, 3), want, "len={len}");
+                }
+            }
+        }
+        let same = vec![9u8; 70_000];
+        unsafe {
+            assert_eq!(super::count_u8_64(same.as_ptr(), same.len(), 9), 70_000);
+            assert_eq!(super::count_u8_16(same.as_ptr(), same.len(), 9), 70_000);
         }
     }
 }
 
 #[cfg(test)]
-mod crc32c_tests {
-    /// Known-good CRC32C values computed via Go's hash/crc32 package.
-    const CRC1: u32 = 0xa016d052; // checksum of a single byte 0x01
-    const CRC_HELLO: u32 = 0x9a71bb4c; // checksum of "hello"
+mod sum_wide_tests {
+    #[test]
+    fn test_sum_u16_u32() {
+        let a: Vec<u16> = (0..5000u32).map(|i| (i.wrapping_mul(2654435761) >> 16) as u16).collect();
+        let b: Vec<u32> = (0..5000u32).map(|i| i.wrapping_mul(2654435761)).collect();
+        for len in [0usize, 1, 15, 16, 17, 63, 64, 65, 5000] {
+            let wa: u64 = a[..len].iter().map(|&v| v as u64).sum();
+            let wb: u64 = b[..len].iter().map(|&v| v as u64).sum();
+            unsafe {
+                for f in [super::sum_u16_16, super::sum_u16_32, super::sum_u16_64] {
+                    assert_eq!(f(a.as_ptr(), len), wa, "u16 len={len}");
+                }
+                for f in [super::sum_u32_16, super::sum_u32_32, super::sum_u32_64] {
+                    assert_eq!(f(b.as_ptr(), len), wb, "u32 len={len}");
+                }
+            }
+        }
+        // Enough max-valued u16 chunks to force several lane flushes.
+        let max = vec![u16::MAX; 16 * (1 << 16) * 2 + 5];
+        let want = max.len() as u64 * u16::MAX as u64;
+        unsafe {
+            assert_eq!(super::sum_u16_16(max.as_ptr(), max.len()), want);
+            assert_eq!(super::sum_u16_64(max.as_ptr(), max.len()), want);
+        }
+    }
+}
 
+#[cfg(test)]
+mod dot_tests {
     #[test]
-    fn test_crc32c_single_byte() {
-        let data = [0x01u8];
-        let got = super::crc32c_update(0, &data);
-        assert_eq!(
-            got, CRC1,
-            "crc32c_update mismatch for 0x01: {:08x} != {:08x}",
-            got, CRC1
-        );
+    fn test_dot_u8() {
+        let a: Vec<u8> = (0..5000u32).map(|i| (i * 31 + 7) as u8).collect();
+        let b: Vec<u8> = (0..5000u32).map(|i| (i * 17 ^ 0xA5) as u8).collect();
+        for len in [0usize, 1, 15, 16, 17, 63, 64, 65, 5000] {
+            let want: u64 = (0..len).map(|i| a[i] as u64 * b[i] as u64).sum();
+            unsafe {
+                for f in [super::dot_u8_16, super::dot_u8_32, super::dot_u8_64] {
+                    assert_eq!(f(a.as_ptr(), b.as_ptr(), len), want, "len={len}");
+                }
+            }
+        }
+        // Enough 255*255 chunks to force several lane flushes.
+        let max = vec![255u8; 64 * (1 << 16) * 2 + 5];
+        let want = max.len() as u64 * 255 * 255;
+        unsafe {
+            assert_eq!(super::dot_u8_16(max.as_ptr(), max.as_ptr(), max.len()), want);
+            assert_eq!(super::dot_u8_64(max.as_ptr(), max.as_ptr(), max.len()), want);
+        }
     }
 
     #[test]
-    fn test_crc32c_hello() {
-        let data = b"hello";
-        let got = super::crc32c_update(0, data);
-        assert_eq!(
-            got, CRC_HELLO,
-            "crc32c_update mismatch for \"hello\": {:08x} != {:08x}",
-            got, CRC_HELLO
-        );
+    fn test_dot_f32() {
+        let a: Vec<f32> = (0..1000).map(|i| (i as f32 * 0.37).sin()).collect();
+        let b: Vec<f32> = (0..1000).map(|i| (i as f32 * 0.11).cos() * 3.0).collect();
+        for len in [0usize, 1, 15, 16, 17, 63, 64, 65, 1000] {
+            let want: f64 = (0..len).map(|i| a[i] as f64 * b[i] as f64).sum();
+            unsafe {
+                for f in [super::dot_f32_16, super::dot_f32_32, super::dot_f32_64] {
+                    let got = f(a.as_ptr(), b.as_ptr(), len);
+                    let tol = 1e-9 * (1.0 + want.abs());
+                    assert!((got - want).abs() <= tol, "len={len}: {got} vs {want}");
+                }
+            }
+        }
     }
+}
 
+#[cfg(test)]
+mod minmax_u8_tests {
     #[test]
-    fn test_crc32c_reference_crc_crate() {
-        const CRC32C: crc::Crc<u32> = crc::Crc::<u32>::new(&crc::CRC_32_ISCSI);
-        let data1 = [0x01u8];
-        let data2 = b"hello";
-        let ref1 = CRC32C.checksum(&data1);
-        let ref2 = CRC32C.checksum(data2);
-        assert_eq!(ref1, CRC1);
-        assert_eq!(ref2, CRC_HELLO);
+    fn test_minmax_u8() {
+        let data: Vec<u8> = (0..1000u32).map(|i| (i.wrapping_mul(2654435761) >> 24) as u8 | 0x10).collect();
+        for len in [1usize, 15, 16, 17, 63, 64, 65, 1000] {
+            let d = &data[..len];
+            let (lo, hi) = (*d.iter().min().unwrap(), *d.iter().max().unwrap());
+            unsafe {
+                for f in [super::min_u8_16, super::min_u8_32, super::min_u8_64] {
+                    assert_eq!(f(d.as_ptr(), len), lo, "min len={len}");
+                }
+                for f in [super::max_u8_16, super::max_u8_32, super::max_u8_64] {
+                    assert_eq!(f(d.as_ptr(), len), hi, "max len={len}");
+                }
+            }
+        }
+        unsafe {
+            assert_eq!(super::min_u8_16(core::ptr::null(), 0), 0xFF);
+            assert_eq!(super::max_u8_16(core::ptr::null(), 0), 0);
+        }
     }
+}
 
+#[cfg(test)]
+mod binop_u8_tests {
     #[test]
-    fn test_crc32c_direct_function() {
-        let data1 = [0x01u8];
-        let data2 = b"hello";
-        assert_eq!(crc32c::crc32c(&data1), 0xa016d052);
-        assert_eq!(crc32c::crc32c(data2), 0x9a71bb4c);
+    fn test_xor_u8() {
+        let a: Vec<u8> = (0..300u32).map(|i| (i * 31 + 7) as u8).collect();
+        let b: Vec<u8> = (0..300u32).map(|i| (i * 17 ^ 0xA5) as u8).collect();
+        for len in [0usize, 1, 15, 16, 17, 63, 64, 65, 300] {
+            let want: Vec<u8> = (0..len).map(|i| a[i] ^ b[i]).collect();
+            unsafe {
+                for f in [super::xor_u8_16, super::xor_u8_32, super::xor_u8_64] {
+                    let mut dst = vec![0u8; len];
+                    f(a.as_ptr(), b.as_ptr(), len, dst.as_mut_ptr());
+                    assert_eq!(dst, want, "len={len}");
+
+                    // In place: dst aliases a.
+                    let mut buf = a[..len].to_vec();
+                    f(buf.as_ptr(), b.as_ptr(), len, buf.as_mut_ptr());
+                    assert_eq!(buf, want, "in-place len={len}");
+                }
+                let want_and: Vec<u8> = (0..len).map(|i| a[i] & b[i]).collect();
+                let want_or: Vec<u8> = (0..len).map(|i| a[i] | b[i]).collect();
+                for (f, want) in [
+                    (super::and_u8_16 as unsafe extern "C" fn(_, _, _, _), &want_and),
+                    (super::and_u8_64, &want_and),
+                    (super::or_u8_32, &want_or),
+                    (super::or_u8_64, &want_or),
+                ] {
+                    let mut buf = b[..len].to_vec();
+                    f(a.as_ptr(), buf.as_ptr(), len, buf.as_mut_ptr());
+                    assert_eq!(&buf, want, "and/or len={len}");
+                }
+            }
+        }
     }
+}
 
+#[cfg(test)]
+mod all_in_range_tests {
     #[test]
-    fn test_crc32c_append_semantics() {
-        let data = [0x01u8];
-        let res = crc32c::crc32c_append(0, &data);
-        assert_eq!(
-            res, 0xa016d052,
-            "crc32c_append with init 0 should give finalised CRC"
-        );
+    fn test_all_in_range() {
+        for len in [2usize, 15, 16, 17, 63, 64, 65, 300] {
+            let mut data = vec![0x20u8; len];
+            data[len / 2] = 0x7e;
+            for f in [super::all_in_range_u8_16, super::all_in_range_u8_32, super::all_in_range_u8_64] {
+                unsafe {
+                    assert_eq!(f(data.as_ptr(), len, 0x20, 0x7e), 1, "len={len}");
+                    assert_eq!(f(data.as_ptr(), len, 0x21, 0x7e), 0, "lo len={len}");
+                    assert_eq!(f(data.as_ptr(), len, 0x20, 0x7d), 0, "hi len={len}");
+                    assert_eq!(f(data.as_ptr(), len, 0x7e, 0x20), 0, "empty range len={len}");
+                    assert_eq!(f(data.as_ptr(), 0, 0x7e, 0x20), 1, "empty input");
+                }
+            }
+            // A violation in the scalar tail.
+            data[len - 1] = 0x7f;
+            unsafe {
+                assert_eq!(super::all_in_range_u8_64(data.as_ptr(), len, 0x20, 0x7e), 0, "tail len={len}");
+            }
+        }
     }
+}
 
+#[cfg(test)]
+mod add_u8_tests {
     #[test]
-    fn test_crc32c_50_ab() {
-        let data = vec![0xABu8; 50];
-        let expect = 0xd64d26c9u32;
-        let got = super::crc32c_update(0, &data);
-        assert_eq!(got, expect);
+    fn test_add_u8() {
+        let a: Vec<u8> = (0..300u32).map(|i| (i * 37 + 100) as u8).collect();
+        let b: Vec<u8> = (0..300u32).map(|i| (i * 91 ^ 0x5A) as u8).collect();
+        for len in [0usize, 1, 15, 16, 17, 63, 64, 65, 300] {
+            let sat: Vec<u8> = (0..len).map(|i| a[i].saturating_add(b[i])).collect();
+            let wrap: Vec<u8> = (0..len).map(|i| a[i].wrapping_add(b[i])).collect();
+            unsafe {
+                for (f, want) in [
+                    (super::add_u8_sat_16 as unsafe extern "C" fn(_, _, _, _), &sat),
+                    (super::add_u8_sat_32, &sat),
+                    (super::add_u8_sat_64, &sat),
+                    (super::add_u8_wrap_16, &wrap),
+                    (super::add_u8_wrap_32, &wrap),
+                    (super::add_u8_wrap_64, &wrap),
+                ] {
+                    let mut dst = vec![0u8; len];
+                    f(a.as_ptr(), b.as_ptr(), len, dst.as_mut_ptr());
+                    assert_eq!(&dst, want, "len={len}");
+
+                    // In place: dst aliases a.
+                    let mut buf = a[..len].to_vec();
+                    f(buf.as_ptr(), b.as_ptr(), len, buf.as_mut_ptr());
+                    assert_eq!(&buf, want, "in-place len={len}");
+                }
+            }
+        }
     }
+}
 
+#[cfg(test)]
+mod index_diff_tests {
     #[test]
-    fn test_crc32c_ffi_raw() {
-        let data = [0x01u8];
-        let got = unsafe { super::crc32_update_32_raw(data.as_ptr(), data.len(), 0) };
-        assert_eq!(got, 0xa016d052);
+    fn test_index_diff_u8() {
+        let a: Vec<u8> = (0..200u32).map(|i| i as u8).collect();
+        for len in [1usize, 15, 16, 17, 63, 64, 65, 200] {
+            unsafe {
+                for f in [super::index_diff_u8_16, super::index_diff_u8_32, super::index_diff_u8_64] {
+                    assert_eq!(f(a.as_ptr(), a.as_ptr(), len), len, "equal len={len}");
+                    for at in 0..len {
+                        let mut b = a.clone();
+                        b[at] ^= 1;
+                        if at + 1 < len {
+                            b[len - 1] ^= 2;
+                        }
+                        assert_eq!(f(a.as_ptr(), b.as_ptr(), len), at, "len={len} at={at}");
+                    }
+                }
+            }
+        }
     }
+}
+
+#[cfg(test)]
+mod index_diff_fold_tests {
+    use super::{
+        index_diff_fold_u8_16 as f16, index_diff_fold_u8_32 as f32, index_diff_fold_u8_64 as f64,
+    };
 
     #[test]
-    fn test_crc32c_alias_function() {
-        let data = [0x01u8];
-        let got = unsafe { super::crc32_update_32(data.as_ptr(), data.len(), 0) };
-        assert_eq!(got, 0xa016d052);
+    fn test_index_diff_fold_u8() {
+        let pattern = b"Content-Type: \xC3\x89t\xE9";
+        let a: Vec<u8> = pattern.iter().copied().cycle().take(200).collect();
+        let upper = a.to_ascii_uppercase();
+        for len in [1usize, 15, 16, 17, 63, 64, 65, 200] {
+            unsafe {
+                for f in [f16, f32, f64] {
+                    assert_eq!(f(a.as_ptr(), upper.as_ptr(), len), len, "folded len={len}");
+                    for at in 0..len {
+                        // Bit 6 never separates two cases of one letter.
+                        let mut b = upper.clone();
+                        b[at] ^= 0x40;
+                        assert_eq!(f(a.as_ptr(), b.as_ptr(), len), at, "len={len} at={at}");
+                    }
+                }
+            }
+        }
     }
 
     #[test]
-    fn test_crc32c_combine() {
-        use rand::{RngCore, SeedableRng};
-        let mut rng = rand::rngs::StdRng::seed_from_u64(42);
-        let len1 = 100usize;
-        let len2 = 200usize;
-        let mut buf1 = vec![0u8; len1];
-        let mut buf2 = vec![0u8; len2];
-        rng.fill_bytes(&mut buf1);
-        rng.fill_bytes(&mut buf2);
+    fn test_index_diff_fold_u8_non_letters() {
+        // These pairs differ only in bit 5 like letter cases do, but are not
+        // ASCII letters, so they must not compare equal.
+        for (x, y) in [(b'@', b'`'), (b'[', b'{'), (0xC9, 0xE9)] {
+            for len in [1usize, 16, 33, 64, 100] {
+                let a = vec![x; len];
+                let b = vec![y; len];
+                unsafe {
+                    for f in [f16, f32, f64] {
+                        let got = f(a.as_ptr(), b.as_ptr(), len);
+                        assert_eq!(got, 0, "{x:#x} vs {y:#x} len={len}");
+                    }
+                }
+            }
+        }
+    }
+}
 
-        let crc1 = super::crc32c_update(0, &buf1);
-        let crc2 = super::crc32c_update(0, &buf2);
-        let mut concat = Vec::from(buf1);
-        concat.extend_from_slice(&buf2);
-        let expected_concat = super::crc32c_update(0, &concat);
-        let combined = unsafe { super::crc32_combine_raw(crc1, crc2, len2) };
-        assert_eq!(combined, expected_concat, "combine mismatch");
+#[cfg(test)]
+mod hex_tests {
+    #[test]
+    fn test_hex_round_trip() {
+        let src: Vec<u8> = (0..300u32).map(|i| (i * 37 + 11) as u8).collect();
+        for len in [1usize, 15, 16, 17, 31, 32, 33, 300] {
+            let mut enc = vec![0u8; 2 * len];
+            unsafe {
+                assert_eq!(super::hex_encode(src.as_ptr(), len, enc.as_mut_ptr()), 2 * len);
+            }
+            let want: String = src[..len].iter().map(|b| format!("{b:02x}")).collect();
+            assert_eq!(enc, want.as_bytes(), "len={len}");
+
+            let upper = want.to_uppercase();
+            for text in [want.as_bytes(), upper.as_bytes()] {
+                let mut dec = vec![0u8; len];
+                unsafe {
+                    assert_eq!(super::hex_decode(text.as_ptr(), text.len(), dec.as_mut_ptr()), 2 * len);
+                }
+                assert_eq!(dec, &src[..len], "len={len}");
+            }
+        }
     }
 
     #[test]
-    fn debug_combine_variants() {
-        use crc32c::crc32c_combine;
-        let buf1: &[u8] = b"hello";
-        let buf2: &[u8] = b" world"; // len2=6
-        let crc1 = super::crc32c_update(0, buf1);
-        let crc2 = super::crc32c_update(0, buf2);
-        let mut concat = Vec::from(buf1);
-        concat.extend_from_slice(&buf2);
-        let expected = super::crc32c_update(0, &concat);
+    fn test_hex_decode_stops_at_bad_pair() {
+        let text: Vec<u8> = b"0123456789abcdefABCDEF".iter().cycle().take(100).copied().collect();
+        for bad in [0usize, 1, 31, 32, 33, 63, 64, 99] {
+            for c in [b'g', b'G', b'/', b':', b'@', b'`', 0xFF] {
+                let mut t = text.clone();
+                t[bad] = c;
+                let mut dec = vec![0u8; 50];
+                let n = unsafe { super::hex_decode(t.as_ptr(), t.len(), dec.as_mut_ptr()) };
+                assert_eq!(n, bad & !1, "bad={bad} c={c}");
+            }
+        }
+    }
+}
 
-        let raw1 = !crc1;
-        let raw2 = !crc2;
+#[cfg(test)]
+mod base64_tests {
+    #[test]
+    fn test_base64_rfc4648_vectors() {
+        let mut out = [0u8; 8];
+        let n = unsafe { super::base64_encode(b"foobar".as_ptr(), 6, out.as_mut_ptr(), 0) };
+        assert_eq!(&out[..n], b"Zm9vYmFy");
+        let mut dec = [0u8; 6];
+        let used = unsafe { super::base64_decode(out.as_ptr(), 8, dec.as_mut_ptr(), 0) };
+        assert_eq!(used, 8);
+        assert_eq!(&dec, b"foobar");
+    }
 
-        let v1 = !crc32c_combine(raw1, raw2, buf2.len());
-        let v2 = crc32c_combine(raw1, raw2, buf2.len());
-        let v3 = !crc32c_combine(crc1, crc2, buf2.len());
-        let v4 = crc32c_combine(crc1, crc2, buf2.len());
-        println!(
-            "expected {:08x} v1 {:08x} v2 {:08x} v3 {:08x} v4 {:08x}",
-            expected, v1, v2, v3, v4
-        );
+    #[test]
+    fn test_base64_round_trip() {
+        let src: Vec<u8> = (0..400u32).map(|i| (i.wrapping_mul(2654435761) >> 13) as u8).collect();
+        for url in [0u8, 1] {
+            let alphabet = if url != 0 { super::BASE64_URL } else { super::BASE64_STD };
+            for len in [3usize, 47, 48, 49, 95, 96, 150, 400] {
+                let mut enc = vec![0u8; len / 3 * 4];
+                let n = unsafe { super::base64_encode(src.as_ptr(), len, enc.as_mut_ptr(), url) };
+                assert_eq!(n, len / 3 * 4);
+                for g in 0..len / 3 {
+                    let mut want = [0u8; 4];
+                    super::base64_encode_group(&src[g * 3..g * 3 + 3], &mut want, alphabet);
+                    assert_eq!(&enc[g * 4..g * 4 + 4], &want, "len={len} group={g}");
+                }
+                let mut dec = vec![0u8; len / 3 * 3];
+                let used = unsafe { super::base64_decode(enc.as_ptr(), enc.len(), dec.as_mut_ptr(), url) };
+                assert_eq!(used, enc.len());
+                assert_eq!(&dec[..], &src[..len / 3 * 3], "len={len} url={url}");
+            }
+        }
     }
 
     #[test]
-    fn test_crc32c_combine_variants_randomised() {
-        use rand::{RngCore, SeedableRng};
+    fn test_base64_decode_stops_at_invalid_group() {
+        let src: Vec<u8> = (0..150u32).map(|i| i as u8).collect();
+        let mut enc = vec![0u8; 200];
+        unsafe { super::base64_encode(src.as_ptr(), 150, enc.as_mut_ptr(), 0) };
+        for bad in [0usize, 5, 63, 64, 130, 199] {
+            for c in [b'=', b'-', b'\n', 0x80] {
+                let mut e = enc.clone();
+                e[bad] = c;
+                let mut dec = vec![0u8; 150];
+                let used = unsafe { super::base64_decode(e.as_ptr(), e.len(), dec.as_mut_ptr(), 0) };
+                assert_eq!(used, bad / 4 * 4, "bad={bad} c={c}");
+            }
+        }
+        // '+' is only valid in the standard alphabet.
+        let text = b"ab+c";
+        let mut dec = [0u8; 3];
+        assert_eq!(unsafe { super::base64_decode(text.as_ptr(), 4, dec.as_mut_ptr(), 1) }, 0);
+        assert_eq!(unsafe { super::base64_decode(text.as_ptr(), 4, dec.as_mut_ptr(), 0) }, 4);
+    }
+}
 
-        const TRIALS: usize = 100;
-        let mut rng = rand::rngs::StdRng::seed_from_u64(0x5eed);
+#[cfg(test)]
+mod replace_tests {
+    fn check(f: unsafe extern "C" fn(*mut u8, usize, u8, u8) -> u64) {
+        for len in [1usize, 15, 16, 17, 63, 64, 65, 255 * 64 + 3, 40000] {
+            let src: Vec<u8> = (0..len as u32).map(|i| (i.wrapping_mul(2654435761) >> 27) as u8).collect();
+            let mut got = src.clone();
+            let n = unsafe { f(got.as_mut_ptr(), len, 7, 200) };
+            let want: Vec<u8> = src.iter().map(|&b| if b == 7 { 200 } else { b }).collect();
+            assert_eq!(n, src.iter().filter(|&&b| b == 7).count() as u64, "len={len}");
+            assert_eq!(got, want, "len={len}");
+
+            let mut all = vec![9u8; len];
+            assert_eq!(unsafe { f(all.as_mut_ptr(), len, 9, 1) }, len as u64);
+            assert!(all.iter().all(|&b| b == 1));
+            assert_eq!(unsafe { f(all.as_mut_ptr(), len, 9, 2) }, 0);
+        }
+    }
 
-        for _ in 0..TRIALS {
-            // Generate two random buffers of random length up to 8 KiB.
-            let len1 = (rng.next_u32() % 8192) as usize;
-            let len2 = (rng.next_u32() % 8192) as usize;
-            let mut buf1 = vec![0u8; len1];
-            let mut buf2 = vec![0u8; len2];
-            rng.fill_bytes(&mut buf1);
-            rng.fill_bytes(&mut buf2);
+    #[test]
+    fn test_replace_u8() {
+        check(super::replace_u8_16);
+        check(super::replace_u8_32);
+        check(super::replace_u8_64);
+    }
+}
 
-            let crc1 = super::crc32c_update(0, &buf1);
-            let crc2 = super::crc32c_update(0, &buf2);
+#[cfg(test)]
+mod histogram_tests {
+    fn check(f: unsafe extern "C" fn(*const u8, usize, *mut u64)) {
+        for len in [1usize, 15, 16, 17, 63, 64, 65, 1000, 70000] {
+            let data: Vec<u8> = (0..len as u32).map(|i| (i.wrapping_mul(2654435761) >> 24) as u8).collect();
+            let mut want = [0u64; 256];
+            for &b in &data {
+                want[b as usize] += 1;
+            }
+            // Counts are added to what is already in the table.
+            let mut got = [1u64; 256];
+            unsafe { f(data.as_ptr(), len, got.as_mut_ptr()) };
+            for k in 0..256 {
+                assert_eq!(got[k], want[k] + 1, "len={len} byte={k}");
+            }
+        }
+    }
 
-            // Ground-truth CRC of the concatenation.
-            let mut concat = buf1.clone();
-            concat.extend_from_slice(&buf2);
-            let expected = super::crc32c_update(0, &concat);
+    #[test]
+    fn test_histogram_u8() {
+        check(super::histogram_u8_16);
+        check(super::histogram_u8_32);
+        check(super::histogram_u8_64);
+    }
+}
 
-            // Variant implemented in Simba (direct finalised combine).
-            let chosen = super::crc32c_combine_go(crc1, crc2, len2);
-            assert_eq!(chosen, expected, "crc32c_combine_go produced wrong value");
+#[cfg(test)]
+mod any_lut_tests {
+    fn check(f: unsafe extern "C" fn(*const u8, usize, *const u8) -> u8) {
+        let mut table = [0u8; 256];
+        table[b'\n' as usize] = 1;
+        table[0x7F] = 1;
+        for len in [1usize, 15, 16, 17, 63, 64, 65, 255, 256, 257, 1000] {
+            let data = vec![b'a'; len];
+            assert_eq!(unsafe { f(data.as_ptr(), len, table.as_ptr()) }, 0, "len={len}");
+            for pos in [0, len / 2, len - 1] {
+                for hit in [b'\n', 0x7F] {
+                    let mut d = data.clone();
+                    d[pos] = hit;
+                    assert_eq!(unsafe { f(d.as_ptr(), len, table.as_ptr()) }, 1, "len={len} pos={pos}");
+                }
+            }
+        }
+    }
 
-            // Sanity-check other flip combinations — none should match.
-            let raw1 = !crc1;
-            let raw2 = !crc2;
-            let variants = [
-                // Previously-used implementation that flipped before + after
-                !crc32c::crc32c_combine(raw1, raw2, len2),
-                // Mix-and-match variants
-                crc32c::crc32c_combine(raw1, raw2, len2),
-                !crc32c::crc32c_combine(crc1, crc2, len2),
-            ];
-            for (i, &v) in variants.iter().enumerate() {
-                assert_ne!(v, expected, "variant {} unexpectedly matched", i + 1);
+    #[test]
+    fn test_any_u8_lut() {
+        check(super::any_u8_lut16);
+        check(super::any_u8_lut32);
+        check(super::any_u8_lut64);
+    }
+}
+
+#[cfg(test)]
+mod index_lut_tests {
+    fn check(f: unsafe extern "C" fn(*const u8, usize, *const u8) -> usize) {
+        let mut table = [0u8; 256];
+        table[b',' as usize] = 1;
+        table[b';' as usize] = 1;
+        for len in [1usize, 15, 16, 17, 63, 64, 65, 200] {
+            let data = vec![b'a'; len];
+            assert_eq!(unsafe { f(data.as_ptr(), len, table.as_ptr()) }, len, "len={len}");
+            for pos in 0..len {
+                let mut d = data.clone();
+                d[pos] = b';';
+                if pos + 1 < len {
+                    d[len - 1] = b',';
+                }
+                assert_eq!(unsafe { f(d.as_ptr(), len, table.as_ptr()) }, pos, "len={len} pos={pos}");
             }
         }
     }
+
+    #[test]
+    fn test_index_lut() {
+        check(super::index_lut16);
+        check(super::index_lut32);
+        check(super::index_lut64);
+    }
+}
+
+#[cfg(test)]
+mod batch_tests {
+    fn descs(items: &[&[u8]]) -> Vec<usize> {
+        // Same shape as Go slice headers: ptr, len, cap.
+        items.iter().flat_map(|s| [s.as_ptr() as usize, s.len(), s.len()]).collect()
+    }
+
+    #[test]
+    fn test_is_ascii_batch() {
+        let long = vec![b'a'; 100];
+        let mut bad = long.clone();
+        bad[99] = 0x80;
+        let items: [&[u8]; 5] = [b"", b"abc", "héllo".as_bytes(), &long, &bad];
+        let d = descs(&items);
+        let mut out = [9u8; 5];
+        unsafe { super::is_ascii_batch(d.as_ptr(), 5, 3, out.as_mut_ptr()) };
+        assert_eq!(out, [1, 1, 0, 1, 0]);
+    }
+
+    #[test]
+    fn test_validate_tag_batch() {
+        let long_ok: Vec<u8> = b"env:prod-".iter().cycle().take(150).copied().collect();
+        let mut long_dunder = long_ok.clone();
+        long_dunder[40] = b'_';
+        long_dunder[41] = b'_';
+        let mut long_dunder_tail = long_ok.clone();
+        long_dunder_tail[146] = b'_';
+        long_dunder_tail[147] = b'_';
+        let cases: [(&[u8], u8); 12] = [
+            (b"", 0),
+            (b"a", 1),
+            (b":", 1),
+            (b"1abc", 0),
+            (b"_abc", 0),
+            (b"abc_", 0),
+            (b"a_b_c", 1),
+            (b"a__b", 0),
+            (b"Env:prod", 0),
+            (&long_ok, 1),
+            (&long_dunder, 0),
+            (&long_dunder_tail, 0),
+        ];
+        let items: Vec<&[u8]> = cases.iter().map(|c| c.0).collect();
+        // String headers: ptr, len.
+        let d: Vec<usize> = items.iter().flat_map(|s| [s.as_ptr() as usize, s.len()]).collect();
+        let mut out = vec![9u8; items.len()];
+        unsafe { super::validate_tag_batch(d.as_ptr(), items.len(), 2, 1, 200, out.as_mut_ptr()) };
+        let want: Vec<u8> = cases.iter().map(|c| c.1).collect();
+        assert_eq!(out, want);
+
+        // The length limit applies too.
+        unsafe { super::validate_tag_batch(d.as_ptr(), items.len(), 2, 1, 100, out.as_mut_ptr()) };
+        assert_eq!(out[9], 0);
+    }
 }
@@ -7,6 +7,19 @@ set -euo pipefail
 readonly TOOLCHAIN="${1:-nightly}"
 readonly MANIFEST="$(dirname "$0")/../rust/Cargo.toml"
 
+# Record the compiler in the commit that updates the archives.
+echo "Building with $(rustc +"$TOOLCHAIN" --version)"
+
+# Kernels run on the goroutine stack behind NOSPLIT trampolines, so refuse to
+# ship one whose frame is too large.  Frame sizes are only recorded for ELF,
+# so check linux builds of both architectures.
+readonly FRAMES_DIR="$(cd "$(dirname "$MANIFEST")" && pwd)/target/frames"
+for target in x86_64-unknown-linux-gnu aarch64-unknown-linux-gnu; do
+  rustup target add "$target" --toolchain "$TOOLCHAIN" >/dev/null 2>&1 || true
+  RUSTFLAGS="-Z emit-stack-sizes" cargo +"$TOOLCHAIN" rustc --manifest-path "$MANIFEST" --release --lib --crate-type staticlib --target "$target" --target-dir "$FRAMES_DIR"
+  (cd "$(dirname "$0")/../internal/ffi" && go run ../../scripts/check_frames "$FRAMES_DIR/$target/release/libsimba.a")
+done
+
 for target in x86_64-apple-darwin aarch64-apple-darwin; do
   rustup target add "$target" --toolchain "$TOOLCHAIN" >/dev/null 2>&1 || true
   cargo +"$TOOLCHAIN" rustc --manifest-path "$MANIFEST" --release --lib --target "$target" -- -C relocation-model=pic
@@ -16,5 +29,38 @@ for target in x86_64-apple-darwin aarch64-apple-darwin; do
     goarch=arm64
   fi
   cp "$(dirname "$MANIFEST")/target/$target/release/libsimba.a" "$(dirname "$0")/../internal/ffi/libsimba_darwin_${goarch}.syso"
+  (cd "$(dirname "$0")/../internal/ffi" && go run ../../scripts/check_archive "libsimba_darwin_${goarch}.syso")
   echo "Generated libsimba_darwin_${goarch}.syso"
-done 
\ No newline at end of file
+done
+
+# Linux riscv64 (opt-in: SIMBA_RISCV64=1).  Only the staticlib is built, so no
+# cross linker is needed; the trampolines come from syso_riscv64.s.
+if [[ "${SIMBA_RISCV64:-0}" == 1 ]]; then
+  target=riscv64gc-unknown-linux-gnu
+  rustup target add "$target" --toolchain "$TOOLCHAIN" >/dev/null 2>&1 || true
+  cargo +"$TOOLCHAIN" rustc --manifest-path "$MANIFEST" --release --lib --crate-type staticlib --target "$target" -- -C relocation-model=pic
+  cp "$(dirname "$MANIFEST")/target/$target/release/libsimba.a" "$(dirname "$0")/../internal/ffi/libsimba_linux_riscv64.syso"
+  echo "Generated libsimba_linux_riscv64.syso"
+fi
+
+# Windows amd64 (opt-in: SIMBA_WINDOWS=1).  The GNU target produces COFF
+# objects the Go linker can consume; the trampolines come from
+# syso_windows_amd64.s, which follows the Win64 calling convention.
+if [[ "${SIMBA_WINDOWS:-0}" == 1 ]]; then
+  target=x86_64-pc-windows-gnu
+  rustup target add "$target" --toolchain "$TOOLCHAIN" >/dev/null 2>&1 || true
+  cargo +"$TOOLCHAIN" rustc --manifest-path "$MANIFEST" --release --lib --crate-type staticlib --target "$target"
+  cp "$(dirname "$MANIFEST")/target/$target/release/libsimba.a" "$(dirname "$0")/../internal/ffi/libsimba_windows_amd64.syso"
+  echo "Generated libsimba_windows_amd64.syso"
+fi
+
+# Linux 386 (opt-in: SIMBA_386=1).  The trampolines in syso_386.s pass
+# arguments on the stack per the i386 cdecl ABI.  Go's internal linker cannot
+# resolve the GOT references i686 PIC code makes, so build it non-PIC.
+if [[ "${SIMBA_386:-0}" == 1 ]]; then
+  target=i686-unknown-linux-gnu
+  rustup target add "$target" --toolchain "$TOOLCHAIN" >/dev/null 2>&1 || true
+  cargo +"$TOOLCHAIN" rustc --manifest-path "$MANIFEST" --release --lib --crate-type staticlib --target "$target" -- -C relocation-model=static
+  cp "$(dirname "$MANIFEST")/target/$target/release/libsimba.a" "$(dirname "$0")/../internal/ffi/libsimba_linux_386.syso"
+  echo "Generated libsimba_linux_386.syso"
+fi
@@ -0,0 +1,107 @@
+// check_archive rejects a darwin .syso archive that does not define every
+// kernel the trampolines call.
+//
+// An archive that was not rebuilt after a kernel was added would otherwise
+// only be caught as an undefined symbol when linking on its platform.
+// build_syso.sh runs it on each archive it copies; run it by hand from
+// internal/ffi so the kernel list can be read from kernels_gen.go:
+//
+//	(cd internal/ffi && go run ../../scripts/check_archive libsimba_darwin_*.syso)
+package main
+
+import (
+	"bytes"
+	"debug/macho"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		log.Fatal("usage: check_archive <libsimba_darwin_ARCH.syso>...")
+	}
+	kernels := readKernels("kernels_gen.go")
+	var failed bool
+	for _, path := range os.Args[1:] {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defined := machoArchiveSymbols(path, data)
+		var missing []string
+		for _, k := range kernels {
+			// Mach-O prefixes C symbols with an underscore.
+			if !defined["_"+k] {
+				missing = append(missing, k)
+			}
+		}
+		if len(missing) > 0 {
+			log.Printf("%s lacks %d of %d kernels: %v", path, len(missing), len(kernels), missing)
+			failed = true
+			continue
+		}
+		fmt.Printf("%s defines all %d kernels\n", path, len(kernels))
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// machoArchiveSymbols returns the external symbols defined by the Mach-O
+// members of the BSD ar archive data.
+func machoArchiveSymbols(path string, data []byte) map[string]bool {
+	const magic, hdrLen = "!<arch>\n", 60
+	if !bytes.HasPrefix(data, []byte(magic)) {
+		log.Fatalf("%s: not an ar archive", path)
+	}
+	defined := make(map[string]bool)
+	for off := len(magic); off+hdrLen <= len(data); {
+		hdr := data[off : off+hdrLen]
+		size, err := strconv.Atoi(strings.TrimSpace(string(hdr[48:58])))
+		if err != nil {
+			log.Fatalf("%s: bad member size at offset %d: %v", path, off, err)
+		}
+		body := data[off+hdrLen : off+hdrLen+size]
+		// BSD long names ("#1/<len>") are stored at the start of the body.
+		if name := strings.TrimSpace(string(hdr[:16])); strings.HasPrefix(name, "#1/") {
+			n, err := strconv.Atoi(name[3:])
+			if err != nil {
+				log.Fatalf("%s: bad member name at offset %d: %v", path, off, err)
+			}
+			body = body[n:]
+		}
+		if f, err := macho.NewFile(bytes.NewReader(body)); err == nil && f.Symtab != nil {
+			for _, s := range f.Symtab.Syms {
+				if s.Type&0x01 != 0 && s.Sect != 0 { // N_EXT, defined in a section
+					defined[s.Name] = true
+				}
+			}
+		}
+		off += hdrLen + size + size%2
+	}
+	return defined
+}
+
+// readKernels returns the kernel names listed in kernels_gen.go.
+func readKernels(path string) []string {
+	f, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var names []string
+	ast.Inspect(f, func(n ast.Node) bool {
+		if lit, ok := n.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			if s, err := strconv.Unquote(lit.Value); err == nil {
+				names = append(names, s)
+			}
+		}
+		return true
+	})
+	return names
+}
@@ -0,0 +1,201 @@
+// check_frames rejects kernels whose native stack use does not fit on a
+// goroutine stack.
+//
+// The trampolines are NOSPLIT with a zero-sized frame, so a kernel runs on
+// whatever is left of the goroutine stack below the Go caller: the linker
+// only guarantees 800 bytes for a NOSPLIT chain.  Every exported kernel must
+// therefore stay within maxFrame bytes, including the frames of anything it
+// calls.
+//
+// The archive must be an ELF static library built with
+// -Z emit-stack-sizes, which records each function's frame in a
+// .stack_sizes section; Mach-O has no equivalent, so build_syso.sh checks a
+// linux build of the same crate.  Run from internal/ffi so the kernel list
+// can be read from kernels_gen.go:
+//
+//	(cd internal/ffi && go run ../../scripts/check_frames libsimba.a)
+package main
+
+import (
+	"bytes"
+	"debug/elf"
+	"encoding/binary"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxFrame is the stack budget of one kernel call, in bytes.  It leaves
+// room within the linker's 800-byte NOSPLIT limit for the trampoline's own
+// spill area and the Go caller's outgoing arguments.
+const maxFrame = 512
+
+// function is one code section of the archive: its own frame and the
+// symbols it references, which include everything it calls.
+type function struct {
+	frame  int
+	sized  bool // a .stack_sizes entry was found
+	callee []string
+}
+
+func main() {
+	if len(os.Args) != 2 {
+		log.Fatal("usage: check_frames <libsimba.a>")
+	}
+	data, err := os.ReadFile(os.Args[1])
+	if err != nil {
+		log.Fatal(err)
+	}
+	funcs := make(map[string]*function) // by symbol name
+	for _, member := range archiveMembers(data) {
+		f, err := elf.NewFile(bytes.NewReader(member))
+		if err != nil {
+			continue // symbol table or non-ELF member
+		}
+		readObject(f, funcs)
+		f.Close()
+	}
+
+	kernels := readKernels("kernels_gen.go")
+	var failed bool
+	for _, k := range kernels {
+		fn, ok := funcs[k]
+		if !ok {
+			log.Printf("%s: not defined in %s", k, os.Args[1])
+			failed = true
+			continue
+		}
+		if !fn.sized {
+			log.Printf("%s: no .stack_sizes entry; build with -Z emit-stack-sizes", k)
+			failed = true
+			continue
+		}
+		if d := depth(funcs, k, map[string]bool{}); d > maxFrame {
+			log.Printf("%s: uses %d bytes of stack, limit %d", k, d, maxFrame)
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+	fmt.Printf("checked %d kernels: all within %d bytes of stack\n", len(kernels), maxFrame)
+}
+
+// depth returns the worst-case stack use of name: its frame, its return
+// address and the deepest callee.  Functions without a .stack_sizes entry
+// (precompiled core and compiler_builtins code such as memcpy) count as
+// their return address only.  Recursion is not expected and is cut off.
+func depth(funcs map[string]*function, name string, active map[string]bool) int {
+	fn, ok := funcs[name]
+	if !ok || active[name] {
+		return 8
+	}
+	active[name] = true
+	deepest := 0
+	for _, c := range fn.callee {
+		deepest = max(deepest, depth(funcs, c, active))
+	}
+	delete(active, name)
+	return 8 + fn.frame + deepest
+}
+
+// readObject adds the functions of one relocatable ELF object to funcs.
+// With function sections every function has its own .text section, whose
+// .stack_sizes entry (address, ULEB128 size) is linked to it and whose
+// relocations name its callees.
+func readObject(f *elf.File, funcs map[string]*function) {
+	syms, err := f.Symbols()
+	if err != nil {
+		return
+	}
+	// bySection maps a section index to the functions defined in it.
+	bySection := make(map[int][]string)
+	for _, s := range syms {
+		if elf.ST_TYPE(s.Info) == elf.STT_FUNC && s.Section > elf.SHN_UNDEF && s.Section < elf.SHN_LORESERVE {
+			bySection[int(s.Section)] = append(bySection[int(s.Section)], s.Name)
+			if funcs[s.Name] == nil {
+				funcs[s.Name] = &function{}
+			}
+		}
+	}
+	for _, sec := range f.Sections {
+		switch {
+		case sec.Name == ".stack_sizes":
+			b, err := sec.Data()
+			if err != nil || len(b) < 9 {
+				continue
+			}
+			size, _ := binary.Uvarint(b[8:])
+			for _, name := range bySection[int(sec.Link)] {
+				funcs[name].frame, funcs[name].sized = int(size), true
+			}
+		case sec.Type == elf.SHT_RELA && strings.HasPrefix(sec.Name, ".rela.text"):
+			callees := relocSymbols(f, sec, syms)
+			for _, name := range bySection[int(sec.Info)] {
+				funcs[name].callee = append(funcs[name].callee, callees...)
+			}
+		}
+	}
+}
+
+// relocSymbols returns the names of the symbols referenced by the
+// relocations in sec.
+func relocSymbols(f *elf.File, sec *elf.Section, syms []elf.Symbol) []string {
+	b, err := sec.Data()
+	if err != nil {
+		return nil
+	}
+	var names []string
+	for ; len(b) >= 24; b = b[24:] {
+		info := f.ByteOrder.Uint64(b[8:])
+		// Symbol indices count the null symbol that Symbols omits.
+		if i := int(elf.R_SYM64(info)); i > 0 && i <= len(syms) {
+			names = append(names, syms[i-1].Name)
+		}
+	}
+	return names
+}
+
+// archiveMembers splits an ar archive into its members' contents.
+func archiveMembers(data []byte) [][]byte {
+	const magic, hdrLen = "!<arch>\n", 60
+	if !bytes.HasPrefix(data, []byte(magic)) {
+		log.Fatal("not an ar archive")
+	}
+	var members [][]byte
+	for off := len(magic); off+hdrLen <= len(data); {
+		hdr := data[off : off+hdrLen]
+		size, err := strconv.Atoi(strings.TrimSpace(string(hdr[48:58])))
+		if err != nil {
+			log.Fatalf("bad member size at offset %d: %v", off, err)
+		}
+		members = append(members, data[off+hdrLen:off+hdrLen+size])
+		off += hdrLen + size + size%2
+	}
+	return members
+}
+
+// readKernels returns the kernel names listed in kernels_gen.go.
+func readKernels(path string) []string {
+	f, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var names []string
+	ast.Inspect(f, func(n ast.Node) bool {
+		if lit, ok := n.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			if s, err := strconv.Unquote(lit.Value); err == nil {
+				names = append(names, s)
+			}
+		}
+		return true
+	})
+	sort.Strings(names)
+	return names
+}
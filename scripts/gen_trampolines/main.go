@@ -8,6 +8,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 )
 
@@ -15,33 +16,53 @@ type FuncInfo struct {
 	Name   string
 	Params []string // type names
 	Result string   // empty if void
+	Arches []string // from the //simba:trampoline tag
 }
 
+// arches lists the architectures gen_trampolines can emit stubs for, in
+// output order.
+var arches = []string{"amd64", "arm64", "riscv64", "386"}
+
 var typeSize = map[string]int{
-	"*byte":   8,
-	"uintptr": 8,
 	"uint32":  4,
 	"uint8":   1,
 	"uint64":  8,
 	"int64":   8,
 	"float64": 8,
-	"*uint16": 8,
-	"*uint32": 8,
-	"*uint64": 8,
 	"float32": 4,
 }
 
-func sizeOf(t string) int {
+// ptrSize is the width of pointers and uintptr on arch.
+func ptrSize(arch string) int {
+	if arch == "386" {
+		return 4
+	}
+	return 8
+}
+
+func sizeOf(arch, t string) int {
+	if t == "uintptr" || strings.HasPrefix(t, "*") {
+		return ptrSize(arch)
+	}
 	if sz, ok := typeSize[t]; ok {
 		return sz
 	}
-	if strings.HasPrefix(t, "*") {
-		return 8 // any pointer size
-	}
 	log.Fatalf("unsupported type in trampoline: %s", t)
 	return 0
 }
 
+// alignOf is the alignment of t in a Go ABI0 argument frame: its own size,
+// capped at the pointer size, so 64-bit values are only 4-byte aligned on
+// 386.
+func alignOf(arch, t string) int {
+	return min(sizeOf(arch, t), ptrSize(arch))
+}
+
+// alignUp rounds n up to a multiple of align.
+func alignUp(n, align int) int {
+	return (n + align - 1) / align * align
+}
+
 func main() {
 	fset := token.NewFileSet()
 	pkgDir := filepath.Join("..", "..", "internal", "ffi")
@@ -57,17 +78,22 @@ func main() {
 				if !ok || fd.Doc == nil {
 					continue
 				}
-				var hasTag bool
+				var tag []string
 				for _, c := range fd.Doc.List {
 					if strings.HasPrefix(c.Text, "//simba:trampoline") {
-						hasTag = true
+						tag = strings.Fields(strings.TrimPrefix(c.Text, "//simba:trampoline"))
 						break
 					}
 				}
-				if !hasTag {
+				if tag == nil {
 					continue
 				}
-				info := FuncInfo{Name: fd.Name.Name}
+				for _, a := range tag {
+					if !contains(arches, a) {
+						log.Fatalf("%s: unsupported trampoline arch %q", fd.Name.Name, a)
+					}
+				}
+				info := FuncInfo{Name: fd.Name.Name, Arches: tag}
 				// params
 				paramIndex := 0
 				for _, p := range fd.Type.Params.List {
@@ -96,8 +122,156 @@ func main() {
 		return
 	}
 
-	generateArch("amd64", funcs)
-	generateArch("arm64", funcs)
+	for _, v := range []variant{plain, traced} {
+		for _, arch := range arches {
+			generateArch(arch, funcsFor(arch, funcs), v)
+		}
+		// Windows uses the Win64 C ABI rather than SysV, so amd64
+		// trampolines get a second, windows-only variant.
+		generateArch("windows_amd64", funcsFor("amd64", funcs), v)
+	}
+	for _, arch := range []string{"amd64", "arm64"} {
+		generateArch(arch, funcsFor(arch, funcs), reftramp)
+	}
+	generateTraceShim(funcs)
+	generateNoFFIShim(funcs)
+	generateKernelList(funcs)
+}
+
+// variant selects which build of the trampolines generateArch writes.
+type variant int
+
+const (
+	// plain trampolines call the Rust kernels.
+	plain variant = iota
+	// traced trampolines are renamed for the simba_trace Go shim.
+	traced
+	// reftramp trampolines call the Go reference through C-ABI shims.
+	reftramp
+)
+
+// generateTraceShim writes trace_gen.go for simba_trace builds: each _raw
+// prototype becomes a Go function that reports the call to traceCall (with
+// the first pointer and first uintptr argument as address and length) and
+// then enters the renamed trampoline.
+func generateTraceShim(funcs []FuncInfo) {
+	var b strings.Builder
+	b.WriteString("// Code generated by gen_trampolines; DO NOT EDIT.\n\n")
+	b.WriteString("//go:build simba_trace && !simba_noffi && !simba_reftramp\n\n")
+	b.WriteString("package ffi\n\n")
+	b.WriteString("import \"unsafe\"\n")
+	for _, fn := range funcs {
+		var params, args []string
+		ptr, length := "0", "0"
+		for _, pair := range fn.Params {
+			name, typ := split(pair)
+			params = append(params, name+" "+typ)
+			args = append(args, name)
+			if ptr == "0" && strings.HasPrefix(typ, "*") {
+				ptr = "uintptr(unsafe.Pointer(" + name + "))"
+			}
+			if length == "0" && typ == "uintptr" {
+				length = name
+			}
+		}
+		sig := "(" + strings.Join(params, ", ") + ")"
+		if fn.Result != "" {
+			sig += " " + fn.Result
+		}
+		call := tracedName(fn.Name) + "(" + strings.Join(args, ", ") + ")"
+		if fn.Result != "" {
+			call = "return " + call
+		}
+		fmt.Fprintf(&b, "\n//go:noescape\nfunc %s%s\n\n", tracedName(fn.Name), sig)
+		fmt.Fprintf(&b, "func %s%s {\n", fn.Name, sig)
+		fmt.Fprintf(&b, "\ttraceCall(%q, %s, %s)\n", strings.TrimSuffix(fn.Name, "_raw"), ptr, length)
+		fmt.Fprintf(&b, "\t%s\n}\n", call)
+	}
+
+	const path = "trace_gen.go"
+	if err := os.WriteFile(path, []byte(b.String()), 0644); err != nil {
+		log.Fatalf("write %s: %v", path, err)
+	}
+	fmt.Printf("generated %s with %d shims\n", path, len(funcs))
+}
+
+// generateNoFFIShim writes noffi_gen.go for simba_noffi builds: each _raw
+// prototype becomes a direct call to the Go reference implementation.
+func generateNoFFIShim(funcs []FuncInfo) {
+	var b strings.Builder
+	b.WriteString("// Code generated by gen_trampolines; DO NOT EDIT.\n\n")
+	b.WriteString("//go:build simba_noffi\n\n")
+	b.WriteString("package ffi\n")
+	for _, fn := range funcs {
+		var params, args []string
+		for _, pair := range fn.Params {
+			name, typ := split(pair)
+			params = append(params, name+" "+typ)
+			args = append(args, name)
+		}
+		sig := "(" + strings.Join(params, ", ") + ")"
+		if fn.Result != "" {
+			sig += " " + fn.Result
+		}
+		call := refName(fn.Name) + "(" + strings.Join(args, ", ") + ")"
+		if fn.Result != "" {
+			call = "return " + call
+		}
+		fmt.Fprintf(&b, "\nfunc %s%s {\n\t%s\n}\n", fn.Name, sig, call)
+	}
+
+	const path = "noffi_gen.go"
+	if err := os.WriteFile(path, []byte(b.String()), 0644); err != nil {
+		log.Fatalf("write %s: %v", path, err)
+	}
+	fmt.Printf("generated %s with %d shims\n", path, len(funcs))
+}
+
+// generateKernelList writes kernels_gen.go, the sorted list of Rust symbols
+// the trampolines call.  The syso archive is linked statically, so every
+// symbol in the list is guaranteed to be present in the final binary.
+func generateKernelList(funcs []FuncInfo) {
+	names := make([]string, 0, len(funcs))
+	for _, fn := range funcs {
+		names = append(names, strings.TrimSuffix(fn.Name, "_raw"))
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString("// Code generated by gen_trampolines; DO NOT EDIT.\n\n")
+	b.WriteString("package ffi\n\n")
+	b.WriteString("// kernels lists the Rust symbols called through trampolines.\n")
+	b.WriteString("var kernels = []string{\n")
+	for _, name := range names {
+		fmt.Fprintf(&b, "\t%q,\n", name)
+	}
+	b.WriteString("}\n")
+
+	const path = "kernels_gen.go"
+	if err := os.WriteFile(path, []byte(b.String()), 0644); err != nil {
+		log.Fatalf("write %s: %v", path, err)
+	}
+	fmt.Printf("generated %s with %d kernels\n", path, len(names))
+}
+
+// funcsFor returns the trampolines whose tag lists arch.
+func funcsFor(arch string, funcs []FuncInfo) []FuncInfo {
+	var out []FuncInfo
+	for _, fn := range funcs {
+		if contains(fn.Arches, arch) {
+			out = append(out, fn)
+		}
+	}
+	return out
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
 }
 
 func exprToString(e ast.Expr) string {
@@ -106,52 +280,97 @@ func exprToString(e ast.Expr) string {
 		return "*" + exprToString(v.X)
 	case *ast.Ident:
 		return v.Name
+	case *ast.ArrayType:
+		return "[" + exprToString(v.Len) + "]" + exprToString(v.Elt)
+	case *ast.BasicLit:
+		return v.Value
 	default:
 		return fmt.Sprintf("%T", e)
 	}
 }
 
-func generateArch(arch string, funcs []FuncInfo) {
+// refName is the Go reference implementation of a kernel, in noffi.go.
+func refName(name string) string {
+	return "ref_" + strings.TrimSuffix(name, "_raw")
+}
+
+// shimName is the C-ABI entry point that simba_reftramp trampolines call in
+// place of the Rust symbol.
+func shimName(name string) string {
+	return "simba_ref_" + strings.TrimSuffix(name, "_raw")
+}
+
+// tracedName is the assembly symbol a trampoline gets in simba_trace builds,
+// where the _raw name belongs to the Go tracing shim instead.
+func tracedName(name string) string {
+	return strings.TrimSuffix(name, "_raw") + "_traced"
+}
+
+// generateArch writes the trampolines for target, which is a GOARCH or
+// "windows_amd64" for the Win64 variant of the amd64 stubs.  The reftramp
+// variant is only generated for amd64 (SysV) and arm64.
+func generateArch(target string, funcs []FuncInfo, v variant) {
+	arch := strings.TrimPrefix(target, "windows_")
+	win := arch != target
+
+	constraint := []string{arch}
+	switch {
+	case win:
+		constraint = []string{"windows", arch}
+	case arch == "amd64", arch == "386":
+		constraint = append(constraint, "!windows")
+	}
+	switch v {
+	case plain:
+		constraint = append(constraint, "!simba_trace", "!simba_noffi", "!simba_reftramp")
+	case traced:
+		constraint = append(constraint, "simba_trace", "!simba_noffi", "!simba_reftramp")
+	case reftramp:
+		constraint = append(constraint, "simba_reftramp", "!simba_noffi")
+	}
+
 	var b strings.Builder
 	b.WriteString("// Code generated by gen_trampolines; DO NOT EDIT.\n")
-	fmt.Fprintf(&b, "//go:build %s\n// +build %s\n\n", arch, arch)
+	fmt.Fprintf(&b, "//go:build %s\n// +build %s\n\n", strings.Join(constraint, " && "), strings.Join(constraint, ","))
+	if v == reftramp {
+		// Go code runs beneath these trampolines, so the GC may scan their
+		// frames: the spill area needs a (pointer-free) locals map.
+		b.WriteString("#include \"funcdata.h\"\n")
+	}
 	b.WriteString("#include \"textflag.h\"\n\n")
 
 	regOrder := map[string][]string{
 		"amd64": {"DI", "SI", "DX", "CX", "R8", "R9"},
 		"arm64": {"R0", "R1", "R2", "R3", "R4", "R5", "R6", "R7"},
+		// RISC-V psABI integer argument registers a0–a7.
+		"riscv64": {"A0", "A1", "A2", "A3", "A4", "A5", "A6", "A7"},
 	}[arch]
+	// spillBase is where the first stack argument goes relative to SP at
+	// the CALL.  Win64 passes only four arguments in registers and makes
+	// the caller reserve 32 bytes of shadow space below the stack ones.
+	spillBase := 0
+	if win {
+		regOrder = []string{"CX", "DX", "R8", "R9"}
+		spillBase = 32
+	}
 
 	for _, fn := range funcs {
+		symbol := fn.Name
+		if v == traced {
+			symbol = tracedName(fn.Name)
+		}
 		frame := 0
 		for _, pair := range fn.Params {
 			_, typ := split(pair)
-			sz := sizeOf(typ)
-			align := sz
-			if align > 8 {
-				align = 8
-			}
-			if frame%align != 0 {
-				frame += align - (frame % align)
-			}
-			frame += sz
+			frame = alignUp(frame, alignOf(arch, typ)) + sizeOf(arch, typ)
 		}
 		if fn.Result != "" {
-			if fn.Result != "" {
-				_, typ := split(fn.Result)
-				sz := sizeOf(typ)
-				align := sz
-				if align > 8 {
-					align = 8
-				}
-				if frame%align != 0 {
-					frame += align - (frame % align)
-				}
-				frame += sz
-			}
+			// Results start at the next pointer-aligned offset after the
+			// arguments, whatever their own size.
+			frame = alignUp(frame, ptrSize(arch)) + sizeOf(arch, fn.Result)
 		}
 		// comment line
-		fmt.Fprintf(&b, "// func %s(", fn.Name)
+		fmt.Fprintf(&b, "// func %s(", symbol)
 		// param list comment
 		fmt.Fprintf(&b, ")")
 		if fn.Result != "" {
@@ -159,21 +378,30 @@ func generateArch(arch string, funcs []FuncInfo) {
 		}
 		b.WriteString("\n")
 
-		fmt.Fprintf(&b, "TEXT ·%s(SB), NOSPLIT, $0-%d\n", fn.Name, frame)
+		// On amd64 SysV the stack arguments go in the trampoline's own
+		// frame.  The assembler allocates it and keeps the FP offsets
+		// below pointing at the Go arguments, which a bare SUBQ from SP
+		// would not.
+		locals := 0
+		if arch == "amd64" && !win && len(fn.Params) > len(regOrder) {
+			locals = (len(fn.Params) - len(regOrder)) * 8
+		}
+		fmt.Fprintf(&b, "TEXT ·%s(SB), NOSPLIT, $%d-%d\n", symbol, locals, frame)
+		if v == reftramp {
+			b.WriteString("    NO_LOCAL_POINTERS\n")
+		}
+		if arch == "386" {
+			write386(&b, fn)
+			continue
+		}
 		// move params
 		offset := 0
 		for i, pair := range fn.Params {
 			name, typ := split(pair)
-			sz := sizeOf(typ)
+			sz := sizeOf(arch, typ)
 
 			// ensure offset respects alignment of this type
-			align := sz
-			if align > 8 {
-				align = 8
-			}
-			if offset%align != 0 {
-				offset += align - (offset % align)
-			}
+			offset = alignUp(offset, alignOf(arch, typ))
 
 			// Choose the outgoing register for this parameter. For AMD64 we
 			// have 6 integer argument registers (DI,SI,DX,CX,R8,R9); on arm64
@@ -200,6 +428,21 @@ func generateArch(arch string, funcs []FuncInfo) {
 				if reg != "" {
 					b.WriteString(fmt.Sprintf("    %s %s+%d(FP), %s\n", inst, name, offset, reg))
 				}
+			} else if arch == "riscv64" {
+				// riscv64: MOV=64-bit, MOVWU=32-bit, MOVBU=8-bit, all
+				// zero-extending into the full register.
+				var inst string
+				switch typ {
+				case "uint32", "float32":
+					inst = "MOVWU"
+				case "uint8":
+					inst = "MOVBU"
+				default:
+					inst = "MOV"
+				}
+				if reg != "" {
+					b.WriteString(fmt.Sprintf("    %s %s+%d(FP), %s\n", inst, name, offset, reg))
+				}
 			} else { // arm64 uses MOVD/MOVW for params
 				// arm64 equivalents: MOVD=64-bit, MOVW=32-bit, MOVBU=8-bit.
 				var inst string
@@ -224,20 +467,31 @@ func generateArch(arch string, funcs []FuncInfo) {
 		}
 		// -------- Spill handling -------------------------------------------
 		// For parameters that did not fit in the register set we reserve a
-		// contiguous stack area (spillBytes = extra*8).  We copy each arg
+		// contiguous stack area (spillBytes = spillBase+extra*8).  We copy each arg
 		// from the Go ABI frame into this scratch space before the CALL so
 		// that Rust can read it using the platform’s standard stack layout.
-		spillBytes := extra * 8
+		spillBytes := spillBase + extra*8
+		if (arch == "riscv64" || win) && spillBytes%16 != 0 {
+			// The RISC-V psABI and Win64 keep sp 16-byte aligned at calls.
+			spillBytes += 8
+		}
 		if spillBytes > 0 {
-			if arch == "amd64" {
-				b.WriteString(fmt.Sprintf("    SUBQ $%d, SP\n", spillBytes))
-			} else { // arm64 uses SUB SP, SP, #bytes
+			if win {
+				// Go only guarantees 8-byte alignment, so, as on 386, SP is
+				// saved in SI (callee-saved under Win64), lowered and rounded
+				// down to 16.  The spilled arguments are then read relative
+				// to SI, past the return address, since FP offsets no
+				// longer apply once SP has moved by an unknown amount.
+				b.WriteString("    MOVQ SP, SI\n")
+				b.WriteString(fmt.Sprintf("    LEAQ -%d(SP), SP\n", spillBytes))
+				b.WriteString("    ANDQ $~15, SP\n")
+			} else if locals == 0 { // arm64 uses SUB SP, SP, #bytes
 				b.WriteString(fmt.Sprintf("    SUB $%d, SP\n", spillBytes))
 			}
 			for j := 0; j < extra; j++ {
 				paramIndex := len(regOrder) + j
 				name, typ := split(fn.Params[paramIndex])
-				off := paramOffset(fn.Params, paramIndex)
+				off := paramOffset(arch, fn.Params, paramIndex)
 				// Pick load/store width for the spilled argument.  We reuse
 				// the same MOV* mnemonics as above so both sides agree on
 				// width and sign-extension.
@@ -251,12 +505,27 @@ func generateArch(arch string, funcs []FuncInfo) {
 					default:
 						instLoad, instStore = "MOVQ", "MOVQ"
 					}
-					b.WriteString(fmt.Sprintf("    %s %s+%d(FP), AX\n", instLoad, name, off))
+					if win {
+						b.WriteString(fmt.Sprintf("    %s %d(SI), AX\n", instLoad, 8+off))
+					} else {
+						b.WriteString(fmt.Sprintf("    %s %s+%d(FP), AX\n", instLoad, name, off))
+					}
 					if instStore == "MOVB" {
-						b.WriteString(fmt.Sprintf("    MOVB AL, %d(SP)\n", j*8))
+						b.WriteString(fmt.Sprintf("    MOVB AL, %d(SP)\n", spillBase+j*8))
 					} else {
-						b.WriteString(fmt.Sprintf("    %s AX, %d(SP)\n", instStore, j*8))
+						b.WriteString(fmt.Sprintf("    %s AX, %d(SP)\n", instStore, spillBase+j*8))
 					}
+				} else if arch == "riscv64" {
+					switch typ {
+					case "uint32", "float32":
+						instLoad, instStore = "MOVWU", "MOVW"
+					case "uint8":
+						instLoad, instStore = "MOVBU", "MOVB"
+					default:
+						instLoad, instStore = "MOV", "MOV"
+					}
+					b.WriteString(fmt.Sprintf("    %s %s+%d(FP), T0\n", instLoad, name, off))
+					b.WriteString(fmt.Sprintf("    %s T0, %d(SP)\n", instStore, j*8))
 				} else { // arm64
 					switch typ {
 					case "uint32", "float32":
@@ -271,11 +540,14 @@ func generateArch(arch string, funcs []FuncInfo) {
 				}
 			}
 		}
-		rustName := strings.TrimSuffix(fn.Name, "_raw")
-		b.WriteString(fmt.Sprintf("    CALL %s(SB)\n", rustName))
-		if spillBytes > 0 {
-			if arch == "amd64" {
-				b.WriteString(fmt.Sprintf("    ADDQ $%d, SP\n", spillBytes))
+		callee := strings.TrimSuffix(fn.Name, "_raw")
+		if v == reftramp {
+			callee = shimName(fn.Name)
+		}
+		b.WriteString(fmt.Sprintf("    CALL %s(SB)\n", callee))
+		if spillBytes > 0 && locals == 0 {
+			if win {
+				b.WriteString("    MOVQ SI, SP\n")
 			} else {
 				b.WriteString(fmt.Sprintf("    ADD $%d, SP\n", spillBytes))
 			}
@@ -296,55 +568,152 @@ func generateArch(arch string, funcs []FuncInfo) {
 					inst, destReg = "MOVB", "AL"
 				case "uint32":
 					inst, destReg = "MOVL", "AX"
-				case "uintptr":
+				case "uintptr", "uint64":
 					inst, destReg = "MOVQ", "AX"
+				case "float64":
+					// SysV and Win64 both return floating-point values in XMM0.
+					inst, destReg = "MOVSD", "X0"
 				default:
 					log.Fatalf("unsupported return type %s for amd64", fn.Result)
 				}
 				b.WriteString(fmt.Sprintf("    %s %s, ret+%d(FP)\n", inst, destReg, retOffset))
+			} else if arch == "riscv64" {
+				var inst, srcReg string
+				switch fn.Result {
+				case "uint8":
+					inst, srcReg = "MOVB", "A0"
+				case "uint32":
+					inst, srcReg = "MOVW", "A0"
+				case "uintptr", "uint64":
+					inst, srcReg = "MOV", "A0"
+				case "float64":
+					// The psABI returns floating-point values in fa0.
+					inst, srcReg = "MOVD", "FA0"
+				default:
+					log.Fatalf("unsupported return type %s for riscv64", fn.Result)
+				}
+				b.WriteString(fmt.Sprintf("    %s %s, ret+%d(FP)\n", inst, srcReg, retOffset))
 			} else {
 				// arm64
-				var inst string
+				var inst, srcReg string
 				switch fn.Result {
 				case "uint8":
-					inst = "MOVBU"
+					inst, srcReg = "MOVBU", "R0"
 				case "uint32":
-					inst = "MOVW"
-				case "uintptr":
-					inst = "MOVD"
+					inst, srcReg = "MOVW", "R0"
+				case "uintptr", "uint64":
+					inst, srcReg = "MOVD", "R0"
+				case "float64":
+					// AAPCS64 returns floating-point values in D0 (V0).
+					inst, srcReg = "FMOVD", "F0"
 				default:
 					log.Fatalf("unsupported return type %s for arm64", fn.Result)
 				}
-				b.WriteString(fmt.Sprintf("    %s R0, ret+%d(FP)\n", inst, retOffset))
+				b.WriteString(fmt.Sprintf("    %s %s, ret+%d(FP)\n", inst, srcReg, retOffset))
 			}
 		}
 		b.WriteString("    RET\n\n")
 	}
 
-	path := fmt.Sprintf("syso_%s.s", arch)
+	path := fmt.Sprintf("syso_%s.s", target)
+	switch v {
+	case traced:
+		path = fmt.Sprintf("syso_trace_%s.s", target)
+	case reftramp:
+		for _, fn := range funcs {
+			writeRefShim(&b, arch, fn)
+		}
+		path = fmt.Sprintf("syso_reftramp_%s.s", target)
+	}
 	if err := os.WriteFile(path, []byte(b.String()), 0644); err != nil {
 		log.Fatalf("write %s: %v", path, err)
 	}
 	fmt.Printf("generated %s with %d trampolines\n", path, len(funcs))
 }
 
-func paramOffset(params []string, index int) int {
+// paramOffset returns the Go ABI0 frame offset of params[index] on arch.
+func paramOffset(arch string, params []string, index int) int {
 	offset := 0
-	for i := 0; i < index; i++ {
+	for i := 0; i <= index; i++ {
 		_, typ := split(params[i])
-		sz := sizeOf(typ)
-		align := sz
-		if align > 8 {
-			align = 8
+		offset = alignUp(offset, alignOf(arch, typ))
+		if i == index {
+			break
 		}
-		if offset%align != 0 {
-			offset += align - (offset % align)
-		}
-		offset += sz
+		offset += sizeOf(arch, typ)
 	}
 	return offset
 }
 
+// write386 emits the body of a 386 trampoline.  The i386 SysV (cdecl)
+// convention passes every argument on the stack in 4-byte slots, 64-bit
+// values as two slots low word first, with SP 16-byte aligned at the CALL,
+// and returns integers in AX (64-bit ones in DX:AX) and floating-point values
+// in x87 ST0.
+//
+// The Go stack carries no such alignment, so the entry SP is kept in SI,
+// which the callee preserves, and the argument area is carved out below it
+// with LEAL/ANDL.  The assembler does not track those SP adjustments, so
+// the arguments are read relative to SI (the first sits at 4(SI), above the
+// return address) and SP is restored before the result is stored through
+// FP.
+func write386(b *strings.Builder, fn FuncInfo) {
+	slots := 0
+	for _, pair := range fn.Params {
+		_, typ := split(pair)
+		slots += alignUp(sizeOf("386", typ), 4)
+	}
+
+	b.WriteString("    MOVL SP, SI\n")
+	if slots > 0 {
+		fmt.Fprintf(b, "    LEAL -%d(SP), SP\n", alignUp(slots, 16))
+	}
+	b.WriteString("    ANDL $~15, SP\n")
+	slot := 0
+	for i, pair := range fn.Params {
+		_, typ := split(pair)
+		src := 4 + paramOffset("386", fn.Params, i)
+		switch typ {
+		case "uint8":
+			fmt.Fprintf(b, "    MOVBLZX %d(SI), AX\n", src)
+			fmt.Fprintf(b, "    MOVL AX, %d(SP)\n", slot)
+		case "uint64", "int64", "float64":
+			for half := 0; half < 8; half += 4 {
+				fmt.Fprintf(b, "    MOVL %d(SI), AX\n", src+half)
+				fmt.Fprintf(b, "    MOVL AX, %d(SP)\n", slot+half)
+			}
+		default:
+			fmt.Fprintf(b, "    MOVL %d(SI), AX\n", src)
+			fmt.Fprintf(b, "    MOVL AX, %d(SP)\n", slot)
+		}
+		slot += alignUp(sizeOf("386", typ), 4)
+	}
+	fmt.Fprintf(b, "    CALL %s(SB)\n", strings.TrimSuffix(fn.Name, "_raw"))
+	b.WriteString("    MOVL SI, SP\n")
+
+	if fn.Result != "" {
+		ret := 0
+		if n := len(fn.Params); n > 0 {
+			_, typ := split(fn.Params[n-1])
+			ret = alignUp(paramOffset("386", fn.Params, n-1)+sizeOf("386", typ), 4)
+		}
+		switch fn.Result {
+		case "uint8":
+			fmt.Fprintf(b, "    MOVB AL, ret+%d(FP)\n", ret)
+		case "uint32", "uintptr":
+			fmt.Fprintf(b, "    MOVL AX, ret+%d(FP)\n", ret)
+		case "uint64":
+			fmt.Fprintf(b, "    MOVL AX, ret_lo+%d(FP)\n", ret)
+			fmt.Fprintf(b, "    MOVL DX, ret_hi+%d(FP)\n", ret+4)
+		case "float64":
+			fmt.Fprintf(b, "    FMOVDP F0, ret+%d(FP)\n", ret)
+		default:
+			log.Fatalf("unsupported return type %s for 386", fn.Result)
+		}
+	}
+	b.WriteString("    RET\n\n")
+}
+
 func split(pair string) (name string, typ string) {
 	parts := strings.Split(pair, ":")
 	if len(parts) == 2 {
@@ -353,3 +722,88 @@ func split(pair string) (name string, typ string) {
 	// no name, only type
 	return "", pair
 }
+
+// writeRefShim emits simba_ref_<kernel>, the stand-in for the Rust symbol in
+// simba_reftramp builds.  It takes the kernel's arguments in the C calling
+// convention the trampoline just set up, stores them as an ABI0 frame for
+// the Go reference implementation, calls it and returns its result in the C
+// result register, so the trampoline's register and stack handling is
+// exercised end to end.
+func writeRefShim(b *strings.Builder, arch string, fn FuncInfo) {
+	frame := 0
+	for _, pair := range fn.Params {
+		_, typ := split(pair)
+		frame = alignUp(frame, alignOf(arch, typ)) + sizeOf(arch, typ)
+	}
+	retOffset := alignUp(frame, ptrSize(arch))
+	if fn.Result != "" {
+		frame = retOffset + sizeOf(arch, fn.Result)
+	}
+	frame = alignUp(frame, ptrSize(arch))
+
+	// Outgoing arguments start at 0(SP) on amd64; on arm64 0(RSP) holds
+	// the saved LR and they start at 8(RSP).
+	base, sp, tmp := 0, "SP", "AX"
+	regs := []string{"DI", "SI", "DX", "CX", "R8", "R9"}
+	if arch == "arm64" {
+		base, sp, tmp = 8, "RSP", "R10"
+		regs = []string{"R0", "R1", "R2", "R3", "R4", "R5", "R6", "R7"}
+	}
+	store := func(typ string) (load, st string) {
+		if arch == "arm64" {
+			switch typ {
+			case "uint32", "float32":
+				return "MOVWU", "MOVW"
+			case "uint8":
+				return "MOVBU", "MOVB"
+			}
+			return "MOVD", "MOVD"
+		}
+		switch typ {
+		case "uint32", "float32":
+			return "MOVL", "MOVL"
+		case "uint8":
+			return "MOVBLZX", "MOVB"
+		}
+		return "MOVQ", "MOVQ"
+	}
+
+	fmt.Fprintf(b, "// %s is the C-ABI entry point for %s.\n", shimName(fn.Name), refName(fn.Name))
+	fmt.Fprintf(b, "TEXT %s(SB), NOSPLIT, $%d-0\n", shimName(fn.Name), frame)
+	b.WriteString("    NO_LOCAL_POINTERS\n")
+	for i, pair := range fn.Params {
+		_, typ := split(pair)
+		off := base + paramOffset(arch, fn.Params, i)
+		load, st := store(typ)
+		if i < len(regs) {
+			fmt.Fprintf(b, "    %s %s, %d(%s)\n", st, regs[i], off, sp)
+			continue
+		}
+		if arch == "arm64" {
+			log.Fatalf("%s: more than %d arguments on arm64", fn.Name, len(regs))
+		}
+		// SysV stack arguments sit above the return address, in 8-byte
+		// slots, which the FP pseudo-register addresses.
+		fmt.Fprintf(b, "    %s arg%d+%d(FP), %s\n", load, i, (i-len(regs))*8, tmp)
+		fmt.Fprintf(b, "    %s %s, %d(%s)\n", st, tmp, off, sp)
+	}
+	fmt.Fprintf(b, "    CALL ·%s(SB)\n", refName(fn.Name))
+	if fn.Result != "" {
+		off := base + retOffset
+		var inst, reg string
+		switch {
+		case fn.Result == "float64" && arch == "arm64":
+			inst, reg = "FMOVD", "F0"
+		case fn.Result == "float64":
+			inst, reg = "MOVSD", "X0"
+		case arch == "arm64":
+			inst, _ = store(fn.Result)
+			reg = "R0"
+		default:
+			inst, _ = store(fn.Result)
+			reg = "AX"
+		}
+		fmt.Fprintf(b, "    %s %d(%s), %s\n", inst, off, sp, reg)
+	}
+	b.WriteString("    RET\n\n")
+}
@@ -0,0 +1,147 @@
+package simba
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"runtime"
+	"unsafe"
+
+	"github.com/miretskiy/simba/internal/ffi"
+)
+
+// selfTestBuf is the buffer SelfTest hands to the trampolines.  It is a
+// global so that its address, which the expected hashes are computed from,
+// cannot change between the two sides of a comparison; a stack buffer may
+// move when the goroutine stack grows.
+var selfTestBuf [64]byte
+
+// SelfTest checks, at run time, that this binary's FFI layer passes
+// arguments and results intact and that a handful of kernels compute known
+// answers.  It is the trampoline test suite in a form that needs no test
+// binary, so a user reporting breakage on new hardware can run it from a
+// -selftest flag and attach the error.
+//
+// The trampolines are driven through the sanity kernel with a fixed set of
+// argument combinations, including MaxUint64 and NaN/Inf bit patterns, and
+// any mismatch is broken down field by field with the echo kernel.  Every
+// lane width of the sum, ASCII, count and CRC kernels is then checked against
+// golden values.  The returned error lists every failure, or is nil.
+func SelfTest() error {
+	var errs []error
+	fail := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+	selfTestTrampolines(fail)
+	selfTestKernels(fail)
+	if len(errs) > 0 {
+		return fmt.Errorf("simba: self-test failed (backend %s, %s/%s): %w",
+			Backend(), runtime.GOOS, runtime.GOARCH, errors.Join(errs...))
+	}
+	return nil
+}
+
+// sanityHash mirrors the Rust trampoline_sanity kernel.
+func sanityHash(ptr, length uintptr, v32 uint32, v8 uint8, v64, f64bits uint64, f32bits uint32) uintptr {
+	h := uint64(0xcbf29ce484222325)
+	for _, v := range []uint64{
+		uint64(ptr), uint64(length), uint64(v32), uint64(v8), v64,
+		f64bits &^ (1 << 63), uint64(f32bits &^ (1 << 31)),
+	} {
+		h ^= v * 0x100000001b3
+	}
+	return uintptr(h)
+}
+
+func selfTestTrampolines(fail func(string, ...any)) {
+	nan64 := uint64(0x7ff8_0000_0000_0001) // quiet NaN with a payload
+	nan32 := uint32(0x7fc0_0001)
+	inf32 := math.Float32bits(float32(math.Inf(1)))
+	for i, c := range []struct {
+		ptr    *byte
+		length uintptr
+		v32    uint32
+		v8     uint8
+		v64    uint64
+		f64    uint64
+		f32    uint32
+	}{
+		{nil, 0, 0, 0, 0, 0, 0},
+		{&selfTestBuf[0], uintptr(len(selfTestBuf)), 0x89abcdef, 0xa5, 0x0123456789abcdef, math.Float64bits(-math.Pi), math.Float32bits(1.5)},
+		{&selfTestBuf[63], math.MaxUint32, math.MaxUint32, math.MaxUint8, math.MaxUint64, math.Float64bits(math.Inf(1)), inf32},
+		{&selfTestBuf[1], 1, 1, 1, 0xffffffff_00000000, math.Float64bits(math.Inf(-1)), nan32},
+		{&selfTestBuf[32], 32, 0x80000000, 0x80, 1 << 63, nan64, 1 << 31},
+	} {
+		ptr := uintptr(unsafe.Pointer(c.ptr))
+		want := sanityHash(ptr, c.length, c.v32, c.v8, c.v64, c.f64, c.f32)
+		if got := ffi.TrampolineSanityHash(c.ptr, c.length, c.v32, c.v8, c.v64, c.f64, c.f32); got == want {
+			continue
+		}
+		e := ffi.TrampolineEcho(c.ptr, c.length, c.v32, c.v8, c.v64, c.f64, c.f32)
+		var bad []string
+		for _, f := range []struct {
+			name      string
+			got, want uint64
+		}{
+			{"ptr", uint64(e.Ptr), uint64(ptr)},
+			{"len", uint64(e.Len), uint64(c.length)},
+			{"u32", uint64(e.V32), uint64(c.v32)},
+			{"u8", uint64(e.V8), uint64(c.v8)},
+			{"u64", e.V64, c.v64},
+			{"f64 bits", e.F64Bits, c.f64},
+			{"f32 bits", uint64(e.F32Bits), uint64(c.f32)},
+		} {
+			if f.got != f.want {
+				bad = append(bad, fmt.Sprintf("%s %#x, want %#x", f.name, f.got, f.want))
+			}
+		}
+		fail("trampoline case %d: sanity hash mismatch; echo: %v", i, bad)
+	}
+
+	// Signalling NaNs are left out: the 386 ABI returns float64 through the
+	// x87 stack, which quiets them.
+	for _, want := range []uint64{
+		0, 1 << 63, math.Float64bits(1), math.Float64bits(-math.Pi),
+		math.Float64bits(math.Inf(1)), math.Float64bits(math.MaxFloat64),
+		math.Float64bits(math.SmallestNonzeroFloat64), nan64,
+	} {
+		if got := math.Float64bits(ffi.TrampolineEchoF64(want)); got != want {
+			fail("float64 return: got %#016x, want %#016x", got, want)
+		}
+	}
+}
+
+func selfTestKernels(fail func(string, ...any)) {
+	var seq [256]byte
+	for i := range seq {
+		seq[i] = byte(i)
+	}
+	check := []byte("123456789")
+	fox := []byte("the quick brown fox jumps over the lazy dog")
+
+	for _, c := range []struct {
+		name      string
+		got, want any
+	}{
+		{"SumU8_16", ffi.SumU8_16(seq[:]), uint32(32640)},
+		{"SumU8_32", ffi.SumU8_32(seq[:]), uint32(32640)},
+		{"SumU8_64", ffi.SumU8_64(seq[:]), uint32(32640)},
+		{"SumU32_64", ffi.SumU32_64([]uint32{math.MaxUint32, math.MaxUint32}), uint64(0x1_ffff_fffe)},
+		{"IsASCII16", ffi.IsASCII16(seq[:128]), true},
+		{"IsASCII32", ffi.IsASCII32(seq[:128]), true},
+		{"IsASCII64", ffi.IsASCII64(seq[:128]), true},
+		{"IsASCII16/0x80", ffi.IsASCII16(seq[:129]), false},
+		{"IsASCII32/0x80", ffi.IsASCII32(seq[:129]), false},
+		{"IsASCII64/0x80", ffi.IsASCII64(seq[:129]), false},
+		{"CountByte16", ffi.CountByte16(fox, 'o'), 4},
+		{"CountByte32", ffi.CountByte32(fox, 'o'), 4},
+		{"CountByte64", ffi.CountByte64(fox, 'o'), 4},
+		{"Crc32Update32", ffi.Crc32Update32(check, 0), uint32(0xe3069283)},
+		{"Crc32Update64", ffi.Crc32Update64(check, 0), uint32(0xe3069283)},
+		{"Crc32IEEEUpdate", ffi.Crc32IEEEUpdate(check, 0), uint32(0xcbf43926)},
+	} {
+		if c.got != c.want {
+			fail("%s: got %#v, want %#v", c.name, c.got, c.want)
+		}
+	}
+}
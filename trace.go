@@ -0,0 +1,14 @@
+//go:build simba_trace
+
+package simba
+
+import "github.com/miretskiy/simba/internal/ffi"
+
+// SetTraceSink installs fn to receive every kernel call made by a binary
+// built with `-tags simba_trace`: the kernel symbol, its first pointer
+// argument and its first length argument, reported before the kernel runs.
+// nil restores the default sink, which logs each call to stderr.  Tracing
+// makes every call several times slower; use it only to debug ABI issues.
+func SetTraceSink(fn func(kernel string, ptr, n uintptr)) {
+	ffi.SetTraceSink(fn)
+}